@@ -7,8 +7,10 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/lino-network/lino/exporter"
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/auth"
@@ -24,6 +26,7 @@ import (
 	infra "github.com/lino-network/lino/x/infra"
 	inframodel "github.com/lino-network/lino/x/infra/model"
 	postmodel "github.com/lino-network/lino/x/post/model"
+	proposalmodel "github.com/lino-network/lino/x/proposal/model"
 	rep "github.com/lino-network/lino/x/reputation"
 	val "github.com/lino-network/lino/x/validator"
 	valmodel "github.com/lino-network/lino/x/validator/model"
@@ -46,8 +49,8 @@ const (
 	appName = "LinoBlockchain"
 
 	// state files
-	prevStateFolder     = "prevstates/"
-	currStateFolder     = "currstates/"
+	prevStateFolder     = "prevstates"
+	currStateFolder     = "currstates"
 	accountStateFile    = "account"
 	developerStateFile  = "developer"
 	postStateFile       = "post"
@@ -56,12 +59,17 @@ const (
 	validatorStateFile  = "validator"
 	reputationStateFile = "reputation"
 	voterStateFile      = "voter"
+	proposalStateFile   = "proposal"
+	paramStateFile      = "param"
 )
 
 // default home directories for expected binaries
 var (
 	DefaultCLIHome  = os.ExpandEnv("$HOME/.linocli")
 	DefaultNodeHome = os.ExpandEnv("$HOME/.lino")
+
+	// Version - version of lino recorded in exported state, set at build time.
+	Version = "unknown"
 )
 
 // LinoBlockchain - Extended ABCI application
@@ -99,6 +107,12 @@ type LinoBlockchain struct {
 
 	// start from previous exported state
 	importRequired bool
+
+	// home of previous and current state folders
+	stateHome string
+
+	// format of state bundle, empty means plain files
+	stateBundle string
 }
 
 // NewLinoBlockchain - create a Lino Blockchain instance
@@ -123,6 +137,7 @@ func NewLinoBlockchain(
 		CapKeyProposalStore:   sdk.NewKVStoreKey(types.ProposalKVStoreKey),
 		CapKeyReputationStore: sdk.NewKVStoreKey(types.ReputationKVStoreKey),
 	}
+	lb.stateHome = DefaultNodeHome
 	lb.paramHolder = param.NewParamHolder(lb.CapKeyParamStore)
 	lb.accountManager = acc.NewAccountManager(lb.CapKeyAccountStore, lb.paramHolder)
 	lb.postManager = post.NewPostManager(lb.CapKeyPostStore, lb.paramHolder)
@@ -146,16 +161,17 @@ func NewLinoBlockchain(
 			lb.developerManager, lb.accountManager, &lb.globalManager)).
 		AddRoute(proposal.RouterKey, proposal.NewHandler(
 			lb.accountManager, lb.proposalManager, lb.postManager, &lb.globalManager, lb.voteManager)).
-		AddRoute(infra.RouterKey, infra.NewHandler(lb.infraManager)).
+		AddRoute(infra.RouterKey, infra.NewHandler(
+			lb.infraManager, lb.accountManager, lb.developerManager, &lb.globalManager)).
 		AddRoute(val.RouterKey, val.NewHandler(
 			lb.accountManager, lb.valManager, lb.voteManager, &lb.globalManager))
 
 	lb.QueryRouter().
 		AddRoute(acc.QuerierRoute, acc.NewQuerier(lb.accountManager)).
-		AddRoute(post.QuerierRoute, post.NewQuerier(lb.postManager)).
-		AddRoute(vote.QuerierRoute, vote.NewQuerier(lb.voteManager)).
+		AddRoute(post.QuerierRoute, post.NewQuerier(lb.postManager, &lb.globalManager, lb.reputationManager)).
+		AddRoute(vote.QuerierRoute, vote.NewQuerier(lb.voteManager, &lb.globalManager)).
 		AddRoute(developer.QuerierRoute, developer.NewQuerier(lb.developerManager)).
-		AddRoute(proposal.QuerierRoute, proposal.NewQuerier(lb.proposalManager)).
+		AddRoute(proposal.QuerierRoute, proposal.NewQuerier(lb.proposalManager, lb.voteManager, lb.valManager)).
 		AddRoute(infra.QuerierRoute, infra.NewQuerier(lb.infraManager)).
 		AddRoute(val.QuerierRoute, val.NewQuerier(lb.valManager)).
 		AddRoute(global.QuerierRoute, global.NewQuerier(lb.globalManager)).
@@ -165,7 +181,7 @@ func NewLinoBlockchain(
 	lb.SetInitChainer(lb.initChainer)
 	lb.SetBeginBlocker(lb.beginBlocker)
 	lb.SetEndBlocker(lb.endBlocker)
-	lb.SetAnteHandler(auth.NewAnteHandler(lb.accountManager, lb.globalManager))
+	lb.SetAnteHandler(auth.NewAnteHandler(lb.accountManager, lb.globalManager, lb.proposalManager))
 	// TODO(Cosmos): mounting multiple stores is broken
 	// https://github.com/cosmos/cosmos-sdk/issues/532
 
@@ -220,6 +236,7 @@ func MakeCodec() *wire.Codec {
 	vote.RegisterWire(cdc)
 	val.RegisterWire(cdc)
 	proposal.RegisterWire(cdc)
+	proposalmodel.RegisterWire(cdc)
 	registerEvent(cdc)
 
 	cdc.Seal()
@@ -233,6 +250,8 @@ func registerEvent(cdc *wire.Codec) {
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "lino/eventReturn", nil)
 	cdc.RegisterConcrete(param.ChangeParamEvent{}, "lino/eventCpe", nil)
 	cdc.RegisterConcrete(proposal.DecideProposalEvent{}, "lino/eventDpe", nil)
+	cdc.RegisterConcrete(proposal.ExpireProposalDepositEvent{}, "lino/eventEpde", nil)
+	cdc.RegisterConcrete(post.ExpireDonationEvent{}, "lino/eventEde", nil)
 }
 
 // SetImportRequired - set whether import is required in initchainer.
@@ -241,6 +260,22 @@ func (lb *LinoBlockchain) SetImportRequired(v bool) {
 	lb.importRequired = v
 }
 
+// SetStateHome - set home directory that previous state is imported from
+// and current state is exported to.
+func (lb *LinoBlockchain) SetStateHome(home string) {
+	lb.stateHome = home
+}
+
+// SetStateBundle - set format of the single bundle that state is exported to
+// and imported from, empty means plain files in state folders.
+func (lb *LinoBlockchain) SetStateBundle(format string) error {
+	if err := exporter.CheckBundleFormat(format); err != nil {
+		return err
+	}
+	lb.stateBundle = format
+	return nil
+}
+
 // custom logic for lino blockchain initialization
 func (lb *LinoBlockchain) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	// set init time to zero
@@ -416,7 +451,8 @@ func (lb *LinoBlockchain) toAppInfra(
 	if !lb.accountManager.DoesAccountExist(ctx, types.AccountKey(infra.Name)) {
 		return ErrGenesisFailed("genesis infra account doesn't exist")
 	}
-	if err := lb.infraManager.RegisterInfraProvider(ctx, types.AccountKey(infra.Name)); err != nil {
+	if err := lb.infraManager.RegisterInfraProvider(
+		ctx, types.AccountKey(infra.Name), types.NewCoinFromInt64(0)); err != nil {
 		return err
 	}
 	return nil
@@ -488,6 +524,10 @@ func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event
 				lb.developerManager, lb.voteManager, lb.reputationManager); err != nil {
 				panic(err)
 			}
+		case post.ExpireDonationEvent:
+			if err := e.Execute(ctx, lb.postManager); err != nil {
+				panic(err)
+			}
 		case acc.ReturnCoinEvent:
 			if err := e.Execute(ctx, lb.accountManager); err != nil {
 				panic(err)
@@ -498,6 +538,11 @@ func (lb *LinoBlockchain) executeEvents(ctx sdk.Context, eventList []types.Event
 				lb.postManager, &lb.globalManager); err != nil {
 				panic(err)
 			}
+		case proposal.ExpireProposalDepositEvent:
+			if err := e.Execute(
+				ctx, lb.accountManager, lb.proposalManager, &lb.globalManager); err != nil {
+				panic(err)
+			}
 		case param.ChangeParamEvent:
 			if err := e.Execute(ctx, lb.paramHolder); err != nil {
 				panic(err)
@@ -642,22 +687,31 @@ func (lb *LinoBlockchain) distributeInflationToDeveloper(ctx sdk.Context) {
 		panic(err)
 	}
 
+	// monthly event is executed at the beginning of next month
+	pastMinutes, err := lb.globalManager.GetPastMinutes(ctx)
+	if err != nil {
+		panic(err)
+	}
+	period := pastMinutes/types.MinutesPerMonth - 1
+
 	totalDistributedInflation := types.NewCoinFromInt64(0)
 	for idx, developer := range lst.AllDevelopers {
-		if idx == (len(lst.AllDevelopers) - 1) {
-			lb.accountManager.AddSavingCoin(
-				ctx, developer, inflation.Minus(totalDistributedInflation), "", "", types.DeveloperInflation)
-			break
-		}
 		percentage, err := lb.developerManager.GetConsumptionWeight(ctx, developer)
 		if err != nil {
 			panic(err)
 		}
-		myShareRat := inflation.ToDec().Mul(percentage)
-		myShareCoin := types.DecToCoin(myShareRat)
+		myShareCoin := inflation.Minus(totalDistributedInflation)
+		if idx != (len(lst.AllDevelopers) - 1) {
+			myShareRat := inflation.ToDec().Mul(percentage)
+			myShareCoin = types.DecToCoin(myShareRat)
+		}
 		totalDistributedInflation = totalDistributedInflation.Plus(myShareCoin)
 		lb.accountManager.AddSavingCoin(
 			ctx, developer, myShareCoin, "", "", types.DeveloperInflation)
+		if err := lb.developerManager.RecordConsumptionSnapshot(
+			ctx, developer, period, percentage, myShareCoin); err != nil {
+			panic(err)
+		}
 	}
 
 	if err := lb.developerManager.ClearConsumption(ctx); err != nil {
@@ -686,49 +740,13 @@ func (lb *LinoBlockchain) syncInfoWithVoteManager(ctx sdk.Context) {
 func (lb *LinoBlockchain) ExportAppStateAndValidators() (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
 	ctx := lb.NewContext(true, abci.Header{})
 
-	exportPath := DefaultNodeHome + "/" + currStateFolder
-	err = os.MkdirAll(exportPath, os.ModePerm)
+	genDoc, err := tmtypes.GenesisDocFromFile(filepath.Join(lb.stateHome, "config", "genesis.json"))
 	if err != nil {
-		panic("failed to create export dir due to: " + err.Error())
+		return nil, nil, err
+	}
+	if err := lb.ExportToFiles(ctx, genDoc.ChainID, lb.LastBlockHeight()); err != nil {
+		return nil, nil, err
 	}
-
-	exportToFile := func(filename string, exporter func(sdk.Context) interface{}) {
-		f, err := os.Create(exportPath + filename)
-		if err != nil {
-			panic("failed to create account")
-		}
-		defer f.Close()
-		jsonbytes, err := lb.cdc.MarshalJSON(exporter(ctx))
-		f.Write(jsonbytes)
-		if err != nil {
-			panic("failed to marshal json for " + filename + " due to " + err.Error())
-		}
-		fmt.Printf("export for %s done: %d bytes\n", filename, len(jsonbytes))
-		f.Sync()
-	}
-
-	exportToFile(accountStateFile, func(ctx sdk.Context) interface{} {
-		return lb.accountManager.Export(ctx).ToIR()
-	})
-	exportToFile(developerStateFile, func(ctx sdk.Context) interface{} {
-		return lb.developerManager.Export(ctx).ToIR()
-	})
-	exportToFile(postStateFile, func(ctx sdk.Context) interface{} {
-		return lb.postManager.Export(ctx).ToIR()
-	})
-	exportToFile(globalStateFile, func(ctx sdk.Context) interface{} {
-		return lb.globalManager.Export(ctx).ToIR()
-	})
-	exportToFile(infraStateFile, func(ctx sdk.Context) interface{} {
-		return lb.infraManager.Export(ctx).ToIR()
-	})
-	exportToFile(validatorStateFile, func(ctx sdk.Context) interface{} {
-		return lb.valManager.Export(ctx).ToIR()
-	})
-	exportToFile(voterStateFile, func(ctx sdk.Context) interface{} {
-		return lb.voteManager.Export(ctx).ToIR()
-	})
-	lb.reputationManager.ExportToFile(ctx, exportPath+"reputation")
 
 	genesisState := GenesisState{}
 
@@ -739,71 +757,188 @@ func (lb *LinoBlockchain) ExportAppStateAndValidators() (appState json.RawMessag
 	return appState, validators, nil
 }
 
-// ImportFromFiles Custom logic for state export
+// ExportToFiles - export states into the current state folder under state home,
+// together with a manifest, and pack them into a bundle if required.
+func (lb *LinoBlockchain) ExportToFiles(ctx sdk.Context, chainID string, height int64) error {
+	exportPath := filepath.Join(lb.stateHome, currStateFolder)
+	err := os.MkdirAll(exportPath, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	manifest := exporter.NewAppState(chainID, height, Version)
+	files := make(map[string][]byte)
+	addFile := func(filename string, jsonbytes []byte) error {
+		if err := manifest.AddFile(filename, jsonbytes); err != nil {
+			return err
+		}
+		files[filename] = jsonbytes
+		fmt.Printf("export for %s done: %d bytes\n", filename, len(jsonbytes))
+		return nil
+	}
+	exportToFile := func(filename string, export func(sdk.Context) interface{}) error {
+		jsonbytes, err := lb.cdc.MarshalJSON(export(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to marshal json for %s due to %s", filename, err.Error())
+		}
+		return addFile(filename, jsonbytes)
+	}
+
+	exports := []struct {
+		filename string
+		export   func(sdk.Context) interface{}
+	}{
+		{paramStateFile, func(ctx sdk.Context) interface{} {
+			return lb.paramHolder.Export(ctx).ToIR()
+		}},
+		{accountStateFile, func(ctx sdk.Context) interface{} {
+			return lb.accountManager.Export(ctx).ToIR()
+		}},
+		{developerStateFile, func(ctx sdk.Context) interface{} {
+			return lb.developerManager.Export(ctx).ToIR()
+		}},
+		{postStateFile, func(ctx sdk.Context) interface{} {
+			return lb.postManager.Export(ctx).ToIR()
+		}},
+		{globalStateFile, func(ctx sdk.Context) interface{} {
+			return lb.globalManager.Export(ctx).ToIR()
+		}},
+		{infraStateFile, func(ctx sdk.Context) interface{} {
+			return lb.infraManager.Export(ctx).ToIR()
+		}},
+		{validatorStateFile, func(ctx sdk.Context) interface{} {
+			return lb.valManager.Export(ctx).ToIR()
+		}},
+		{voterStateFile, func(ctx sdk.Context) interface{} {
+			return lb.voteManager.Export(ctx).ToIR()
+		}},
+		{proposalStateFile, func(ctx sdk.Context) interface{} {
+			return lb.proposalManager.Export(ctx).ToIR()
+		}},
+	}
+	for _, e := range exports {
+		if err := exportToFile(e.filename, e.export); err != nil {
+			return err
+		}
+	}
+	// reputation manager only supports exporting to file.
+	repFile := filepath.Join(exportPath, reputationStateFile)
+	if err := lb.reputationManager.ExportToFile(ctx, repFile); err != nil {
+		return err
+	}
+	repBytes, err := ioutil.ReadFile(repFile)
+	if err != nil {
+		return err
+	}
+	if err := addFile(reputationStateFile, repBytes); err != nil {
+		return err
+	}
+
+	if err := exporter.WriteDir(exportPath, manifest, files); err != nil {
+		return err
+	}
+	if lb.stateBundle != "" {
+		bundle := exporter.BundlePath(exportPath, lb.stateBundle)
+		if err := exporter.WriteBundle(bundle, lb.stateBundle, manifest, files); err != nil {
+			return err
+		}
+		fmt.Printf("state bundled into %s\n", bundle)
+	}
+	return nil
+}
+
+// ImportFromFiles Custom logic for state import.
+// The manifest and all state files are verified before the store is touched.
 func (lb *LinoBlockchain) ImportFromFiles(ctx sdk.Context) {
 	check := func(err error) {
 		if err != nil {
 			panic("failed to unmarshal " + err.Error())
 		}
 	}
-	importFromFile := func(filename string, tables interface{}) {
-		// XXX(yumin): does not support customized node home import.
-		f, err := os.Open(DefaultNodeHome + "/" + prevStateFolder + filename)
-		if err != nil {
-			panic("failed to open " + err.Error())
+
+	importPath := filepath.Join(lb.stateHome, prevStateFolder)
+	var manifest *exporter.AppState
+	var files map[string][]byte
+	var err error
+	if lb.stateBundle != "" {
+		manifest, files, err = exporter.ReadBundle(
+			exporter.BundlePath(importPath, lb.stateBundle), lb.stateBundle)
+	} else {
+		manifest, files, err = exporter.ReadDir(importPath)
+	}
+	if err != nil {
+		panic("failed to read previous state: " + err.Error())
+	}
+	if err := manifest.Verify(files); err != nil {
+		panic("failed to verify previous state: " + err.Error())
+	}
+	fmt.Printf("importing state of %s at height %d, exported by version %s\n",
+		manifest.ChainID, manifest.Height, manifest.AppVersion)
+
+	tables := []struct {
+		filename string
+		ir       interface{}
+	}{
+		{paramStateFile, &param.ParamTablesIR{}},
+		{accountStateFile, &accmodel.AccountTablesIR{}},
+		{developerStateFile, &devmodel.DeveloperTablesIR{}},
+		{postStateFile, &postmodel.PostTablesIR{}},
+		{globalStateFile, &globalmodel.GlobalTablesIR{}},
+		{infraStateFile, &inframodel.InfraTablesIR{}},
+		{validatorStateFile, &valmodel.ValidatorTablesIR{}},
+		{voterStateFile, &votemodel.VoterTablesIR{}},
+		{proposalStateFile, &proposalmodel.ProposalTablesIR{}},
+	}
+	for _, tb := range tables {
+		if !manifest.HasFile(tb.filename) {
+			panic(tb.filename + " is not listed in manifest")
 		}
-		defer f.Close()
-		bytes, err := ioutil.ReadAll(f)
-		check(err)
+		check(lb.cdc.UnmarshalJSON(files[tb.filename], tb.ir))
+		fmt.Printf("%s state parsed: %T\n", tb.filename, tb.ir)
+	}
+	if !manifest.HasFile(reputationStateFile) {
+		panic(reputationStateFile + " is not listed in manifest")
+	}
+
+	for _, tb := range tables {
 		// XXX(yumin): ugly, trying found a better way.
-		switch t := tables.(type) {
+		switch t := tb.ir.(type) {
 		case *accmodel.AccountTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
 			lb.accountManager.Import(ctx, t)
 		case *devmodel.DeveloperTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
 			lb.developerManager.Import(ctx, t)
 		case *globalmodel.GlobalTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
 			lb.globalManager.Import(ctx, t)
 		case *inframodel.InfraTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
 			lb.infraManager.Import(ctx, t)
 		case *postmodel.PostTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
 			lb.postManager.Import(ctx, t)
 		case *valmodel.ValidatorTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
 			lb.valManager.Import(ctx, t)
 		case *votemodel.VoterTablesIR:
-			err = lb.cdc.UnmarshalJSON(bytes, t)
-			check(err)
-			fmt.Printf("%s state parsed: %T\n", filename, t)
 			lb.voteManager.Import(ctx, t)
+		case *proposalmodel.ProposalTablesIR:
+			lb.proposalManager.Import(ctx, t)
+		case *param.ParamTablesIR:
+			lb.paramHolder.Import(ctx, t)
 		default:
 			panic(fmt.Sprintf("Unknown import type: %T", t))
 		}
-		fmt.Printf("%s loaded, total %d bytes\n", filename, len(bytes))
+		fmt.Printf("%s loaded, total %d bytes\n", tb.filename, len(files[tb.filename]))
 	}
 
-	importFromFile(accountStateFile, &accmodel.AccountTablesIR{})
-	importFromFile(developerStateFile, &devmodel.DeveloperTablesIR{})
-	importFromFile(postStateFile, &postmodel.PostTablesIR{})
-	importFromFile(globalStateFile, &globalmodel.GlobalTablesIR{})
-	importFromFile(infraStateFile, &inframodel.InfraTablesIR{})
-	importFromFile(validatorStateFile, &valmodel.ValidatorTablesIR{})
-	importFromFile(voterStateFile, &votemodel.VoterTablesIR{})
-	lb.reputationManager.ImportFromFile(ctx, DefaultNodeHome+"/"+prevStateFolder+reputationStateFile)
+	// reputation manager only supports importing from file.
+	repFile, err := ioutil.TempFile("", reputationStateFile)
+	if err != nil {
+		panic("failed to create reputation file: " + err.Error())
+	}
+	defer os.Remove(repFile.Name())
+	_, err = repFile.Write(files[reputationStateFile])
+	repFile.Close()
+	if err != nil {
+		panic("failed to write reputation file: " + err.Error())
+	}
+	if err := lb.reputationManager.ImportFromFile(ctx, repFile.Name()); err != nil {
+		panic("failed to import reputation: " + err.Error())
+	}
 }
@@ -2,7 +2,9 @@ package app
 
 import (
 	"encoding/json"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 	"time"
@@ -16,12 +18,16 @@ import (
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/lino-network/lino/exporter"
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	devModel "github.com/lino-network/lino/x/developer/model"
 	globalModel "github.com/lino-network/lino/x/global/model"
 	infraModel "github.com/lino-network/lino/x/infra/model"
 	"github.com/lino-network/lino/x/post"
+	"github.com/lino-network/lino/x/proposal"
+	proposalmodel "github.com/lino-network/lino/x/proposal/model"
+	votemodel "github.com/lino-network/lino/x/vote/model"
 )
 
 var (
@@ -189,10 +195,15 @@ func TestGenesisFromConfig(t *testing.T) {
 			ContentCreatorAllocation: types.NewDecFromRat(65, 100),
 			DeveloperAllocation:      types.NewDecFromRat(10, 100),
 			ValidatorAllocation:      types.NewDecFromRat(5, 100),
+			TreasuryAllocation:       sdk.ZeroDec(),
 		},
 		param.InfraInternalAllocationParam{
-			StorageAllocation: types.NewDecFromRat(50, 100),
-			CDNAllocation:     types.NewDecFromRat(50, 100),
+			StorageAllocation:                  types.NewDecFromRat(50, 100),
+			CDNAllocation:                      types.NewDecFromRat(50, 100),
+			InfraProviderMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+			InfraProviderCoinReturnIntervalSec: int64(7 * 24 * 3600),
+			InfraProviderCoinReturnTimes:       int64(7),
+			InfraProviderDisputePenalty:        types.NewCoinFromInt64(10000 * types.Decimals),
 		},
 		param.VoteParam{
 			MinStakeIn:                     types.NewCoinFromInt64(1000 * types.Decimals),
@@ -200,6 +211,8 @@ func TestGenesisFromConfig(t *testing.T) {
 			VoterCoinReturnTimes:           int64(7),
 			DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 			DelegatorCoinReturnTimes:       int64(7),
+			RedelegationIntervalSec:        int64(7 * 24 * 3600),
+			MaxRedelegations:               int64(7),
 		},
 		param.ProposalParam{
 			ContentCensorshipDecideSec:  int64(24 * 7 * 3600),
@@ -216,6 +229,29 @@ func TestGenesisFromConfig(t *testing.T) {
 			ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 			ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 			ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+			TreasurySpendDecideSec:  int64(7 * 24 * 3600),
+			TreasurySpendPassRatio:  types.NewDecFromRat(70, 100),
+			TreasurySpendPassVotes:  types.NewCoinFromInt64(1000000 * types.Decimals),
+			TreasurySpendMinDeposit: types.NewCoinFromInt64(100000 * types.Decimals),
+
+			ContentAppealWindowSec:  int64(7 * 24 * 3600),
+			ContentAppealDecideSec:  int64(7 * 24 * 3600),
+			ContentAppealPassRatio:  types.NewDecFromRat(66, 100),
+			ContentAppealPassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+			ContentAppealMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+			AccountFreezeSec:        int64(30 * 24 * 3600),
+			AccountFreezeDecideSec:  int64(7 * 24 * 3600),
+			AccountFreezePassRatio:  types.NewDecFromRat(66, 100),
+			AccountFreezePassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+			AccountFreezeMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+			ProposalVetoRatio: types.NewDecFromRat(334, 1000),
+
+			ProposalDepositSec: int64(7 * 24 * 3600),
+
+			ProposalMinInitialDeposit: types.NewCoinFromInt64(10 * types.Decimals),
 		},
 		param.DeveloperParam{
 			DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
@@ -252,6 +288,13 @@ func TestGenesisFromConfig(t *testing.T) {
 			ReportOrUpvoteIntervalSec: 24 * 3600,
 			PostIntervalSec:           600,
 			MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+			BatchViewIntervalSec:      60,
+			MaxBatchViewCount:         100000,
+			MaxTitleLength:            100,
+			MaxContentLength:          1000,
+			MaxNumOfLinks:             10,
+			DonationRefundWindowSec:   3600,
+			MaxCommentDepth:           8,
 		},
 		param.ReputationParam{
 			BestContentIndexN: 10,
@@ -450,7 +493,8 @@ func TestDistributeInflationToInfraProvider(t *testing.T) {
 			if err != nil {
 				t.Errorf("%s: failed to register account, got err %v", testName, err)
 			}
-			err = lb.infraManager.RegisterInfraProvider(ctx, types.AccountKey("infra"+strconv.Itoa(i)))
+			err = lb.infraManager.RegisterInfraProvider(
+				ctx, types.AccountKey("infra"+strconv.Itoa(i)), types.NewCoinFromInt64(0))
 			if err != nil {
 				t.Errorf("%s: failed to register infra provider, got err %v", testName, err)
 			}
@@ -521,7 +565,7 @@ func TestDistributeInflationToInfraProvider(t *testing.T) {
 		if err != nil {
 			t.Errorf("%s: failed to set past minutes, got err %v", testName, err)
 		}
-		err = lb.infraManager.RegisterInfraProvider(ctx, "Lino")
+		err = lb.infraManager.RegisterInfraProvider(ctx, "Lino", types.NewCoinFromInt64(0))
 		if err != nil {
 			t.Errorf("%s: failed to register infra provider, got err %v", testName, err)
 		}
@@ -856,3 +900,146 @@ func TestGlobalTime(t *testing.T) {
 		assert.Equal(t, cs.expectLastBlockTime, lastBlockTime)
 	}
 }
+
+func TestExportImportProposalAndParam(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	ctx := lb.BaseApp.NewContext(true, abci.Header{})
+	zero := types.NewCoinFromInt64(0)
+
+	// a change param proposal in the middle of voting, with votes from all validators
+	err := lb.paramHolder.UpdateGlobalGrowthRate(ctx, types.NewDecFromRat(5, 100))
+	assert.Nil(t, err)
+	allocation, err := lb.paramHolder.GetGlobalAllocationParam(ctx)
+	assert.Nil(t, err)
+	id, err := lb.proposalManager.AddProposal(
+		ctx, types.AccountKey(user1), lb.proposalManager.CreateChangeParamProposal(ctx, *allocation, "reason"),
+		7*24*3600, zero)
+	assert.Nil(t, err)
+	for i := 0; i < 21; i++ {
+		voter := types.AccountKey("validator" + strconv.Itoa(i))
+		_, err := lb.voteManager.AddVote(ctx, id, voter, types.VoteOptionYes)
+		assert.Nil(t, err)
+	}
+
+	proposalTables := lb.proposalManager.Export(ctx).ToIR()
+	voterTables := lb.voteManager.Export(ctx).ToIR()
+	paramTables := lb.paramHolder.Export(ctx).ToIR()
+	proposalBytes, err := lb.cdc.MarshalJSON(proposalTables)
+	assert.Nil(t, err)
+	voterBytes, err := lb.cdc.MarshalJSON(voterTables)
+	assert.Nil(t, err)
+	paramBytes, err := lb.cdc.MarshalJSON(paramTables)
+	assert.Nil(t, err)
+
+	// import into a new chain
+	lb2 := newLinoBlockchain(t, 21)
+	ctx2 := lb2.BaseApp.NewContext(true, abci.Header{})
+	proposalIR := &proposalmodel.ProposalTablesIR{}
+	assert.Nil(t, lb2.cdc.UnmarshalJSON(proposalBytes, proposalIR))
+	voterIR := &votemodel.VoterTablesIR{}
+	assert.Nil(t, lb2.cdc.UnmarshalJSON(voterBytes, voterIR))
+	paramIR := &param.ParamTablesIR{}
+	assert.Nil(t, lb2.cdc.UnmarshalJSON(paramBytes, paramIR))
+	lb2.paramHolder.Import(ctx2, paramIR)
+	lb2.voteManager.Import(ctx2, voterIR)
+	lb2.proposalManager.Import(ctx2, proposalIR)
+
+	// state exported from the new chain is the same as the one imported
+	proposalBytes2, err := lb2.cdc.MarshalJSON(lb2.proposalManager.Export(ctx2).ToIR())
+	assert.Nil(t, err)
+	assert.Equal(t, string(proposalBytes), string(proposalBytes2))
+	paramBytes2, err := lb2.cdc.MarshalJSON(lb2.paramHolder.Export(ctx2).ToIR())
+	assert.Nil(t, err)
+	assert.Equal(t, string(paramBytes), string(paramBytes2))
+	allocation2, err := lb2.paramHolder.GetGlobalAllocationParam(ctx2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewDecFromRat(5, 100), allocation2.GlobalGrowthRate)
+	nextID, err := lb2.proposalManager.GetNextProposalID(ctx2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ProposalKey("2"), nextID)
+	assert.True(t, lb2.proposalManager.IsOngoingProposal(ctx2, id))
+	votes, err := lb2.voteManager.GetAllVotes(ctx2, id)
+	assert.Nil(t, err)
+	assert.Equal(t, 21, len(votes))
+
+	// proposal is decided on the new chain with votes cast before the upgrade
+	event := proposal.DecideProposalEvent{ProposalType: types.ChangeParam, ProposalID: id}
+	err = event.Execute(
+		ctx2, lb2.voteManager, lb2.valManager, lb2.accountManager, lb2.proposalManager,
+		lb2.postManager, &lb2.globalManager)
+	assert.Nil(t, err)
+	expired := lb2.proposalManager.Export(ctx2).ExpiredProposals
+	assert.Equal(t, 1, len(expired))
+	assert.Equal(t, id, expired[0].ProposalID)
+	assert.Equal(t, types.ProposalPass, expired[0].Proposal.GetProposalInfo().Result)
+}
+
+func TestExportImportWithManifest(t *testing.T) {
+	lb := newLinoBlockchain(t, 21)
+	ctx := lb.BaseApp.NewContext(true, abci.Header{})
+	allocation, err := lb.paramHolder.GetGlobalAllocationParam(ctx)
+	assert.Nil(t, err)
+	id, err := lb.proposalManager.AddProposal(
+		ctx, types.AccountKey(user1), lb.proposalManager.CreateChangeParamProposal(ctx, *allocation, "reason"),
+		7*24*3600, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+
+	home, err := ioutil.TempDir("", "lino-export")
+	assert.Nil(t, err)
+	defer os.RemoveAll(home)
+	lb.SetStateHome(home)
+	err = lb.SetStateBundle("rar")
+	assert.NotNil(t, err)
+	err = lb.SetStateBundle(exporter.BundleTar)
+	assert.Nil(t, err)
+	err = lb.ExportToFiles(ctx, "test-chain", 10)
+	assert.Nil(t, err)
+
+	// both plain files and bundle carry the same manifest
+	manifest, files, err := exporter.ReadDir(filepath.Join(home, currStateFolder))
+	assert.Nil(t, err)
+	assert.Nil(t, manifest.Verify(files))
+	bundle := exporter.BundlePath(filepath.Join(home, currStateFolder), exporter.BundleTar)
+	bundleManifest, bundleFiles, err := exporter.ReadBundle(bundle, exporter.BundleTar)
+	assert.Nil(t, err)
+	assert.Equal(t, manifest, bundleManifest)
+	assert.Equal(t, files, bundleFiles)
+	assert.Equal(t, "test-chain", manifest.ChainID)
+	assert.Equal(t, int64(10), manifest.Height)
+	assert.Equal(t, Version, manifest.AppVersion)
+	assert.Equal(t, 10, len(manifest.Files))
+	for _, f := range manifest.Files {
+		if f.Name == proposalStateFile {
+			// next proposal id and one ongoing proposal
+			assert.Equal(t, 2, f.Records)
+		}
+	}
+
+	// tampered bundle is rejected before store is touched
+	tampered := make(map[string][]byte)
+	for name, content := range files {
+		tampered[name] = content
+	}
+	tampered[proposalStateFile] = []byte(`{"next_proposal_id":{"next_proposal_id":"100"}}`)
+	prevBundle := exporter.BundlePath(filepath.Join(home, prevStateFolder), exporter.BundleTar)
+	err = exporter.WriteBundle(prevBundle, exporter.BundleTar, manifest, tampered)
+	assert.Nil(t, err)
+	lb2 := newLinoBlockchain(t, 21)
+	ctx2 := lb2.BaseApp.NewContext(true, abci.Header{})
+	lb2.SetStateHome(home)
+	err = lb2.SetStateBundle(exporter.BundleTar)
+	assert.Nil(t, err)
+	assert.Panics(t, func() { lb2.ImportFromFiles(ctx2) })
+	nextID, err := lb2.proposalManager.GetNextProposalID(ctx2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ProposalKey("1"), nextID)
+
+	// the original bundle is imported
+	err = os.Rename(bundle, prevBundle)
+	assert.Nil(t, err)
+	assert.NotPanics(t, func() { lb2.ImportFromFiles(ctx2) })
+	nextID, err = lb2.proposalManager.GetNextProposalID(ctx2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ProposalKey("2"), nextID)
+	assert.True(t, lb2.proposalManager.IsOngoingProposal(ctx2, id))
+}
@@ -131,10 +131,15 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ContentCreatorAllocation: types.NewDecFromRat(65, 100),
 				DeveloperAllocation:      types.NewDecFromRat(10, 100),
 				ValidatorAllocation:      types.NewDecFromRat(5, 100),
+				TreasuryAllocation:       sdk.ZeroDec(),
 			},
 			param.InfraInternalAllocationParam{
-				StorageAllocation: types.NewDecFromRat(50, 100),
-				CDNAllocation:     types.NewDecFromRat(50, 100),
+				StorageAllocation:                  types.NewDecFromRat(50, 100),
+				CDNAllocation:                      types.NewDecFromRat(50, 100),
+				InfraProviderMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+				InfraProviderCoinReturnIntervalSec: int64(7 * 24 * 3600),
+				InfraProviderCoinReturnTimes:       int64(7),
+				InfraProviderDisputePenalty:        types.NewCoinFromInt64(10000 * types.Decimals),
 			},
 			param.VoteParam{
 				MinStakeIn:                     types.NewCoinFromInt64(1000 * types.Decimals),
@@ -142,6 +147,8 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				VoterCoinReturnTimes:           int64(7),
 				DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 				DelegatorCoinReturnTimes:       int64(7),
+				RedelegationIntervalSec:        int64(7 * 24 * 3600),
+				MaxRedelegations:               int64(7),
 			},
 			param.ProposalParam{
 				ContentCensorshipDecideSec:  int64(24 * 7 * 3600),
@@ -158,6 +165,29 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 				ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 				ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+				TreasurySpendDecideSec:  int64(7 * 24 * 3600),
+				TreasurySpendPassRatio:  types.NewDecFromRat(70, 100),
+				TreasurySpendPassVotes:  types.NewCoinFromInt64(1000000 * types.Decimals),
+				TreasurySpendMinDeposit: types.NewCoinFromInt64(100000 * types.Decimals),
+
+				ContentAppealWindowSec:  int64(7 * 24 * 3600),
+				ContentAppealDecideSec:  int64(7 * 24 * 3600),
+				ContentAppealPassRatio:  types.NewDecFromRat(66, 100),
+				ContentAppealPassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+				ContentAppealMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+				AccountFreezeSec:        int64(30 * 24 * 3600),
+				AccountFreezeDecideSec:  int64(7 * 24 * 3600),
+				AccountFreezePassRatio:  types.NewDecFromRat(66, 100),
+				AccountFreezePassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+				AccountFreezeMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+				ProposalVetoRatio: types.NewDecFromRat(334, 1000),
+
+				ProposalDepositSec: int64(7 * 24 * 3600),
+
+				ProposalMinInitialDeposit: types.NewCoinFromInt64(10 * types.Decimals),
 			},
 			param.DeveloperParam{
 				DeveloperMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
@@ -194,6 +224,13 @@ func LinoBlockchainGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (appSt
 				ReportOrUpvoteIntervalSec: 24 * 3600,
 				PostIntervalSec:           600,
 				MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+				BatchViewIntervalSec:      60,
+				MaxBatchViewCount:         100000,
+				MaxTitleLength:            100,
+				MaxContentLength:          1000,
+				MaxNumOfLinks:             10,
+				DonationRefundWindowSec:   3600,
+				MaxCommentDepth:           8,
 			},
 			param.ReputationParam{
 				BestContentIndexN: 10,
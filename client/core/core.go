@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -55,9 +56,17 @@ func (ctx CoreContext) QuerySubspace(cdc *wire.Codec, subspace []byte, storeName
 	return
 }
 
+// QueryCustom - query module querier registered under route with the provided path
+func (ctx CoreContext) QueryCustom(route string, path ...string) (res []byte, err error) {
+	return ctx.queryWithPath(fmt.Sprintf("/custom/%s/%s", route, strings.Join(path, "/")), nil)
+}
+
 // Query from Tendermint with the provided storename and path
 func (ctx CoreContext) query(key cmn.HexBytes, storeName, endPath string) (res []byte, err error) {
-	path := fmt.Sprintf("/store/%s/%s", storeName, endPath)
+	return ctx.queryWithPath(fmt.Sprintf("/store/%s/%s", storeName, endPath), key)
+}
+
+func (ctx CoreContext) queryWithPath(path string, key cmn.HexBytes) (res []byte, err error) {
 	node, err := ctx.GetNode()
 	if err != nil {
 		return res, err
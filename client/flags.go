@@ -35,10 +35,14 @@ const (
 	FlagSeconds     = "seconds"
 	FlagPermission  = "permission"
 	FlagGrantAmount = "grant-amount"
+	FlagReceiptMode = "receipt-mode"
 
 	// Infra
-	FlagProvider = "provider"
-	FlagUsage    = "usage"
+	FlagProvider   = "provider"
+	FlagUsage      = "usage"
+	FlagStatements = "statements"
+	FlagApp        = "app"
+	FlagPeriod     = "period"
 
 	// Post
 	FlagDonator                 = "donator"
@@ -48,17 +52,35 @@ const (
 	FlagPostID                  = "post-ID"
 	FlagTitle                   = "title"
 	FlagContent                 = "content"
+	FlagContentHash             = "content-hash"
+	FlagContentURI              = "content-uri"
 	FlagParentAuthor            = "parent-author"
 	FlagParentPostID            = "parent-post-ID"
 	FlagSourceAuthor            = "source-author"
 	FlagSourcePostID            = "source-post-ID"
 	FlagRedistributionSplitRate = "redistribution-split-rate"
+	FlagBeneficiaries           = "beneficiaries"
+	FlagTags                    = "tags"
+	FlagViews                   = "views"
+	FlagDonationID              = "donation-id"
+	FlagAllowReplies            = "allow-replies"
 
 	// Vote
-	FlagVoter      = "voter"
-	FlagProposalID = "proposal-id"
-	FlagResult     = "result"
-	FlagLink       = "link"
+	FlagVoter        = "voter"
+	FlagSrcVoter     = "src-voter"
+	FlagDstVoter     = "dst-voter"
+	FlagProposalID   = "proposal-id"
+	FlagOption       = "option"
+	FlagDepositor    = "depositor"
+	FlagCreator      = "creator"
+	FlagProposalType = "proposal-type"
+	FlagResult       = "result"
+	FlagLink         = "link"
+	FlagAutoCompound = "auto-compound"
+	FlagRecipient    = "recipient"
+	FlagTimes        = "times"
+	FlagInterval     = "interval"
+	FlagReason       = "reason"
 )
 
 // LineBreak can be included in a command list to provide a blank line
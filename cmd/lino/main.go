@@ -16,6 +16,11 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/lino-network/lino/app"
+	"github.com/lino-network/lino/exporter"
+)
+
+const (
+	flagStateBundle = "state-bundle"
 )
 
 // generate Lino application
@@ -24,9 +29,25 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		baseapp.SetPruning(store.NewPruningOptionsFromString(viper.GetString("pruning"))))
 	// after upgrade-1, lino needs to starts
 	app.SetImportRequired(true)
+	app.SetStateHome(viper.GetString(cli.HomeFlag))
+	// bundle format is checked before any command runs
+	if err := app.SetStateBundle(viper.GetString(flagStateBundle)); err != nil {
+		panic(err)
+	}
 	return app
 }
 
+// persistentPreRunEFn - run server pre run and reject unknown state bundle format
+func persistentPreRunEFn(ctx *server.Context) func(*cobra.Command, []string) error {
+	preRunE := server.PersistentPreRunEFn(ctx)
+	return func(cmd *cobra.Command, args []string) error {
+		if err := preRunE(cmd, args); err != nil {
+			return err
+		}
+		return exporter.CheckBundleFormat(viper.GetString(flagStateBundle))
+	}
+}
+
 func main() {
 	cobra.EnableCommandSorting = false
 
@@ -36,9 +57,12 @@ func main() {
 	rootCmd := &cobra.Command{
 		Use:               "lino",
 		Short:             "Lino Blockchain (server)",
-		PersistentPreRunE: server.PersistentPreRunEFn(ctx),
+		PersistentPreRunE: persistentPreRunEFn(ctx),
 	}
 
+	rootCmd.PersistentFlags().String(flagStateBundle, "",
+		"export state into and import previous state from a single bundle, tar or zip")
+
 	rootCmd.AddCommand(app.InitCmd(ctx, cdc))
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
@@ -50,5 +74,9 @@ func main() {
 func exportAppStateAndTMValidators(logger log.Logger, db dbm.DB, traceStore io.Writer,
 	_ int64, _ bool, _ []string) (json.RawMessage, []tmtypes.GenesisValidator, error) {
 	lb := app.NewLinoBlockchain(logger, db, traceStore)
+	lb.SetStateHome(viper.GetString(cli.HomeFlag))
+	if err := lb.SetStateBundle(viper.GetString(flagStateBundle)); err != nil {
+		return nil, nil, err
+	}
 	return lb.ExportAppStateAndValidators()
 }
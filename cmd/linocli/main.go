@@ -66,8 +66,35 @@ func main() {
 	// 	lcd.ServeCommand(cdc),
 	// )
 
+	accountCmd := &cobra.Command{
+		Use:   "account",
+		Short: "Account subcommands",
+	}
+	accountCmd.AddCommand(
+		client.GetCommands(
+			acccmd.GetPendingReturnsCmd(cdc),
+		)...)
+
+	proposalCmd := &cobra.Command{
+		Use:   "proposal",
+		Short: "Proposal subcommands",
+	}
+	proposalCmd.AddCommand(
+		client.GetCommands(
+			proposalcmd.GetProposalListCmd(cdc),
+			proposalcmd.GetProposalTallyCmd(cdc),
+			proposalcmd.GetProposalVotesCmd(cdc),
+			proposalcmd.GetPendingVotesCmd(cdc),
+			proposalcmd.GetTreasuryCmd(cdc),
+			proposalcmd.GetTreasurySpendsCmd(cdc),
+			proposalcmd.GetAccountFreezeCmd(cdc),
+			proposalcmd.GetAccountFreezesCmd(cdc),
+		)...)
+
 	linocliCmd.AddCommand(
 		advancedCmd,
+		accountCmd,
+		proposalCmd,
 		client.LineBreak,
 	)
 
@@ -83,9 +110,14 @@ func main() {
 		client.PostCommands(
 			acccmd.TransferTxCmd(cdc),
 		)...)
+	postCmd := postcmd.PostTxCmd(cdc)
+	postCmd.AddCommand(
+		client.GetCommands(
+			postcmd.VerifyPostCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
-			postcmd.PostTxCmd(cdc),
+			postCmd,
 		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
@@ -94,14 +126,17 @@ func main() {
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			postcmd.DeletePostTxCmd(cdc),
+			postcmd.UpdateAllowRepliesTxCmd(cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			postcmd.ViewTxCmd(cdc),
+			postcmd.BatchViewTxCmd(cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			postcmd.DonateTxCmd(cdc),
+			postcmd.RefundDonationTxCmd(cdc),
 		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
@@ -123,6 +158,10 @@ func main() {
 		client.PostCommands(
 			delegationcmd.WithdrawDelegateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			delegationcmd.RedelegateTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			delegatecmd.GetDelegationCmd(types.VoteKVStoreKey, cdc),
@@ -136,6 +175,14 @@ func main() {
 		client.PostCommands(
 			votecmd.WithdrawVoterTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			votecmd.AutoCompoundTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			votecmd.DefaultVoteTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.GetCommands(
 			votecmd.GetVoterCmd(types.VoteKVStoreKey, cdc),
@@ -149,9 +196,18 @@ func main() {
 		client.GetCommands(
 			proposalcmd.GetExpiredProposalCmd(types.VoteKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			proposalcmd.GetProposalDepositsCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			proposalcmd.VoteProposalTxCmd(cdc),
+			proposalcmd.DepositProposalTxCmd(cdc),
+			proposalcmd.TreasurySpendTxCmd(cdc),
+			proposalcmd.ContentAppealTxCmd(cdc),
+			proposalcmd.AccountFreezeTxCmd(cdc),
+			proposalcmd.AccountUnfreezeTxCmd(cdc),
 		)...)
 
 	linocliCmd.AddCommand(
@@ -163,6 +219,18 @@ func main() {
 		client.PostCommands(
 			infracmd.ProviderReportTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			infracmd.ProviderRegisterTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			infracmd.ProviderRevokeTxCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			infracmd.DisputeUsageReportTxCmd(cdc),
+		)...)
 	linocliCmd.AddCommand(
 		client.PostCommands(
 			developercmd.DeveloperRegisterTxCmd(cdc),
@@ -187,6 +255,10 @@ func main() {
 		client.PostCommands(
 			developercmd.DeveloperUpdateTxCmd(cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.PostCommands(
+			developercmd.DeveloperReceiptModeTxCmd(cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
@@ -208,6 +280,36 @@ func main() {
 		client.GetCommands(
 			postcmd.GetPostsCmd(types.PostKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetBeneficiaryRewardsCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostRevisionsCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostReportOrUpvotesCmd(cdc),
+			postcmd.GetUserReportOrUpvotesCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetPostPendingRewardsCmd(cdc),
+			postcmd.GetAuthorPendingRewardsCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetTagPostsCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetDonationsCmd(cdc),
+		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			postcmd.GetCommentTreeCmd(cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
@@ -217,6 +319,10 @@ func main() {
 		client.GetCommands(
 			infracmd.GetInfraProvidersCmd(types.InfraKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			infracmd.GetUsageReportsCmd(cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
@@ -226,6 +332,10 @@ func main() {
 		client.GetCommands(
 			developercmd.GetDevelopersCmd(types.DeveloperKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			developercmd.GetConsumptionHistoryCmd(cdc),
+		)...)
 
 	linocliCmd.AddCommand(
 		client.GetCommands(
@@ -235,6 +345,10 @@ func main() {
 		client.GetCommands(
 			validatorcmd.GetValidatorCmd(types.ValidatorKVStoreKey, cdc),
 		)...)
+	linocliCmd.AddCommand(
+		client.GetCommands(
+			validatorcmd.GetMissedVotesCmd(cdc),
+		)...)
 
 	// add proxy, version and key info
 	linocliCmd.AddCommand(
@@ -1,33 +1,121 @@
 package exporter
 
-// AppState contains all informations that needs to migrate when blockchain upgrade.
-type AppState struct {
-	// Accounts            []AccountRow     `json:"accounts"`
-	// AccountGrantPubKeys []GrantPubKeyRow `json:"account_grant_pub_keys"`
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
 
-	// Developers           []Developer
-	// DeveloperList        DeveloperList
+// ManifestFile - name of the manifest written next to the exported state files.
+const ManifestFile = "manifest.json"
 
-	// GlobalTimeEventLists []GlobalTimeEventList
-	// GlobalStakeStats     []GlobalStakeStat
-	// GlobalMisc           GlobalMisc
+// AppState - manifest of the state exported when blockchain upgrade.
+// It records where the state comes from and a checksum of every state file,
+// so that the importing chain can reject a partial or tampered export.
+type AppState struct {
+	ChainID    string      `json:"chain_id"`
+	Height     int64       `json:"height"`
+	AppVersion string      `json:"app_version"`
+	Files      []StateFile `json:"files"`
+}
+
+// StateFile - one exported state file.
+type StateFile struct {
+	Name    string `json:"name"`
+	SHA256  string `json:"sha256"`
+	Records int    `json:"records"`
+}
 
-	// InfraProviders       []InfraProvider
-	// InfraProviderList    InfraProviderList
+// NewAppState - return an empty manifest.
+func NewAppState(chainID string, height int64, appVersion string) *AppState {
+	return &AppState{
+		ChainID:    chainID,
+		Height:     height,
+		AppVersion: appVersion,
+		Files:      []StateFile{},
+	}
+}
 
-	// Posts                []Post
-	// PostUsers            []PostUser
-	// PostComments         []PostComment
+// AddFile - record checksum and record count of a state file.
+func (state *AppState) AddFile(name string, content []byte) error {
+	for _, f := range state.Files {
+		if f.Name == name {
+			return fmt.Errorf("duplicate state file %s", name)
+		}
+	}
+	records, err := CountRecords(content)
+	if err != nil {
+		return fmt.Errorf("failed to count records of %s: %s", name, err.Error())
+	}
+	state.Files = append(state.Files, StateFile{
+		Name:    name,
+		SHA256:  checksum(content),
+		Records: records,
+	})
+	return nil
+}
 
-	// proposal is skipped
+// Verify - check that every file listed in manifest is present
+// and matches its checksum and record count.
+func (state AppState) Verify(files map[string][]byte) error {
+	for _, f := range state.Files {
+		content, ok := files[f.Name]
+		if !ok {
+			return fmt.Errorf("state file %s is missing", f.Name)
+		}
+		if sum := checksum(content); sum != f.SHA256 {
+			return fmt.Errorf("state file %s checksum mismatch: expect %s, got %s", f.Name, f.SHA256, sum)
+		}
+		records, err := CountRecords(content)
+		if err != nil {
+			return fmt.Errorf("failed to count records of %s: %s", f.Name, err.Error())
+		}
+		if records != f.Records {
+			return fmt.Errorf("state file %s record count mismatch: expect %d, got %d", f.Name, f.Records, records)
+		}
+	}
+	return nil
+}
 
-	// Voters        []VoterRow         `json:"voters"`
-	// Delegations   []DelegationRow    `json:"delegations"`
-	// ReferenceList ReferenceListTable `json:"reference_list"`
+// HasFile - return true if file is listed in manifest.
+func (state AppState) HasFile(name string) bool {
+	for _, f := range state.Files {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
 
-	// Validators    []Validator
-	// ValidatorList ValidatorList
+// CountRecords - count records of a JSON encoded state file.
+// Every top level table that is a list counts its length, null counts zero,
+// and any other table counts as one record.
+func CountRecords(content []byte) (int, error) {
+	tables := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(content, &tables); err != nil {
+		return 0, err
+	}
+	total := 0
+	for _, raw := range tables {
+		raw = bytes.TrimSpace(raw)
+		switch {
+		case bytes.Equal(raw, []byte("null")):
+		case len(raw) > 0 && raw[0] == '[':
+			rows := []json.RawMessage{}
+			if err := json.Unmarshal(raw, &rows); err != nil {
+				return 0, err
+			}
+			total += len(rows)
+		default:
+			total++
+		}
+	}
+	return total, nil
+}
 
-	// reputaion has pure math model implementation and can be simply marshal to bytes out.
-	// reputation []byte
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
 }
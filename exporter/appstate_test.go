@@ -0,0 +1,106 @@
+package exporter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountRecords(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		content       string
+		expectRecords int
+		expectErr     bool
+	}{
+		{
+			testName:      "lists and single table",
+			content:       `{"accounts":[{"a":1},{"a":2}],"list":{"all":[]},"rows":[]}`,
+			expectRecords: 3,
+		},
+		{
+			testName:      "null table",
+			content:       `{"accounts":null}`,
+			expectRecords: 0,
+		},
+		{
+			testName:  "not json object",
+			content:   `[1, 2]`,
+			expectErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		records, err := CountRecords([]byte(tc.content))
+		if tc.expectErr {
+			assert.NotNil(t, err, tc.testName)
+			continue
+		}
+		assert.Nil(t, err, tc.testName)
+		assert.Equal(t, tc.expectRecords, records, tc.testName)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	files := map[string][]byte{
+		"account": []byte(`{"accounts":[{"a":1}]}`),
+		"post":    []byte(`{"posts":[]}`),
+	}
+	state := NewAppState("test-chain", 10, "v1")
+	assert.Nil(t, state.AddFile("account", files["account"]))
+	assert.Nil(t, state.AddFile("post", files["post"]))
+	assert.NotNil(t, state.AddFile("post", files["post"]))
+	assert.True(t, state.HasFile("account"))
+	assert.False(t, state.HasFile("voter"))
+	assert.Nil(t, state.Verify(files))
+
+	missing := map[string][]byte{"account": files["account"]}
+	assert.NotNil(t, state.Verify(missing))
+
+	tampered := map[string][]byte{
+		"account": []byte(`{"accounts":[{"a":2}]}`),
+		"post":    files["post"],
+	}
+	assert.NotNil(t, state.Verify(tampered))
+}
+
+func TestBundle(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lino-bundle")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	files := map[string][]byte{
+		"account": []byte(`{"accounts":[{"a":1}]}`),
+		"post":    []byte(`{"posts":[]}`),
+	}
+	state := NewAppState("test-chain", 10, "v1")
+	assert.Nil(t, state.AddFile("account", files["account"]))
+	assert.Nil(t, state.AddFile("post", files["post"]))
+
+	folder := filepath.Join(dir, "currstates")
+	assert.Nil(t, WriteDir(folder, state, files))
+	readState, readFiles, err := ReadDir(folder)
+	assert.Nil(t, err)
+	assert.Equal(t, state, readState)
+	assert.Equal(t, files, readFiles)
+
+	for _, format := range []string{BundleTar, BundleZip} {
+		path := BundlePath(folder, format)
+		assert.Nil(t, WriteBundle(path, format, state, files), format)
+		readState, readFiles, err := ReadBundle(path, format)
+		assert.Nil(t, err, format)
+		assert.Equal(t, state, readState, format)
+		assert.Equal(t, files, readFiles, format)
+	}
+
+	assert.NotNil(t, WriteBundle(BundlePath(folder, "rar"), "rar", state, files))
+
+	for _, format := range []string{"", BundleTar, BundleZip} {
+		assert.Nil(t, CheckBundleFormat(format), format)
+	}
+	for _, format := range []string{"rar", "TAR", " zip"} {
+		assert.NotNil(t, CheckBundleFormat(format), format)
+	}
+}
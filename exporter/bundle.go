@@ -0,0 +1,191 @@
+package exporter
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// supported bundle formats.
+const (
+	BundleTar = "tar"
+	BundleZip = "zip"
+)
+
+// CheckBundleFormat - return error if format is neither a supported bundle format nor empty,
+// empty means plain files without a bundle.
+func CheckBundleFormat(format string) error {
+	switch format {
+	case "", BundleTar, BundleZip:
+		return nil
+	}
+	return fmt.Errorf("unknown bundle format: %s", format)
+}
+
+// BundlePath - return the path of bundle with format for a state folder.
+func BundlePath(folder, format string) string {
+	return filepath.Clean(folder) + "." + format
+}
+
+// WriteDir - write state files and manifest into dir.
+func WriteDir(dir string, state *AppState, files map[string][]byte) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	manifest, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	for _, f := range state.Files {
+		if err := ioutil.WriteFile(filepath.Join(dir, f.Name), files[f.Name], 0644); err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(filepath.Join(dir, ManifestFile), manifest, 0644)
+}
+
+// ReadDir - read manifest and state files listed in it from dir.
+func ReadDir(dir string) (*AppState, map[string][]byte, error) {
+	manifest, err := ioutil.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, nil, err
+	}
+	state := &AppState{}
+	if err := json.Unmarshal(manifest, state); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse manifest: %s", err.Error())
+	}
+	files := make(map[string][]byte)
+	for _, f := range state.Files {
+		content, err := ioutil.ReadFile(filepath.Join(dir, f.Name))
+		if err != nil {
+			return nil, nil, err
+		}
+		files[f.Name] = content
+	}
+	return state, files, nil
+}
+
+// WriteBundle - write manifest and state files into a single archive.
+func WriteBundle(path, format string, state *AppState, files map[string][]byte) error {
+	manifest, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch format {
+	case BundleTar:
+		w := tar.NewWriter(f)
+		write := func(name string, content []byte) error {
+			hdr := &tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}
+			if err := w.WriteHeader(hdr); err != nil {
+				return err
+			}
+			_, err := w.Write(content)
+			return err
+		}
+		if err := write(ManifestFile, manifest); err != nil {
+			return err
+		}
+		for _, sf := range state.Files {
+			if err := write(sf.Name, files[sf.Name]); err != nil {
+				return err
+			}
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+	case BundleZip:
+		w := zip.NewWriter(f)
+		write := func(name string, content []byte) error {
+			fw, err := w.Create(name)
+			if err != nil {
+				return err
+			}
+			_, err = fw.Write(content)
+			return err
+		}
+		if err := write(ManifestFile, manifest); err != nil {
+			return err
+		}
+		for _, sf := range state.Files {
+			if err := write(sf.Name, files[sf.Name]); err != nil {
+				return err
+			}
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown bundle format: %s", format)
+	}
+	return f.Sync()
+}
+
+// ReadBundle - read manifest and state files from a single archive.
+func ReadBundle(path, format string) (*AppState, map[string][]byte, error) {
+	entries := make(map[string][]byte)
+	switch format {
+	case BundleTar:
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer f.Close()
+		r := tar.NewReader(f)
+		for {
+			hdr, err := r.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			content, err := ioutil.ReadAll(r)
+			if err != nil {
+				return nil, nil, err
+			}
+			entries[hdr.Name] = content
+		}
+	case BundleZip:
+		r, err := zip.OpenReader(path)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer r.Close()
+		for _, zf := range r.File {
+			rc, err := zf.Open()
+			if err != nil {
+				return nil, nil, err
+			}
+			content, err := ioutil.ReadAll(rc)
+			rc.Close()
+			if err != nil {
+				return nil, nil, err
+			}
+			entries[zf.Name] = content
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown bundle format: %s", format)
+	}
+
+	manifest, ok := entries[ManifestFile]
+	if !ok {
+		return nil, nil, fmt.Errorf("%s not found in %s", ManifestFile, path)
+	}
+	state := &AppState{}
+	if err := json.Unmarshal(manifest, state); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse manifest: %s", err.Error())
+	}
+	delete(entries, ManifestFile)
+	return state, entries, nil
+}
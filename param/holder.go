@@ -52,14 +52,19 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ContentCreatorAllocation: types.NewDecFromRat(65, 100),
 		DeveloperAllocation:      types.NewDecFromRat(10, 100),
 		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		TreasuryAllocation:       sdk.ZeroDec(),
 	}
 	if err := ph.setGlobalAllocationParam(ctx, globalAllocationParam); err != nil {
 		return err
 	}
 
 	infraInternalAllocationParam := &InfraInternalAllocationParam{
-		StorageAllocation: types.NewDecFromRat(50, 100),
-		CDNAllocation:     types.NewDecFromRat(50, 100),
+		StorageAllocation:                  types.NewDecFromRat(50, 100),
+		CDNAllocation:                      types.NewDecFromRat(50, 100),
+		InfraProviderMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+		InfraProviderCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		InfraProviderCoinReturnTimes:       int64(7),
+		InfraProviderDisputePenalty:        types.NewCoinFromInt64(10000 * types.Decimals),
 	}
 	if err := ph.setInfraInternalAllocationParam(ctx, infraInternalAllocationParam); err != nil {
 		return err
@@ -69,6 +74,13 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ReportOrUpvoteIntervalSec: 24 * 3600,
 		PostIntervalSec:           600,
 		MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+		BatchViewIntervalSec:      60,
+		MaxBatchViewCount:         100000,
+		MaxTitleLength:            100,
+		MaxContentLength:          1000,
+		MaxNumOfLinks:             10,
+		DonationRefundWindowSec:   3600,
+		MaxCommentDepth:           8,
 	}
 	if err := ph.setPostParam(ctx, postParam); err != nil {
 		return err
@@ -105,6 +117,8 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationIntervalSec:        int64(7 * 24 * 3600),
+		MaxRedelegations:               int64(7),
 	}
 	if err := ph.setVoteParam(ctx, voteParam); err != nil {
 		return err
@@ -126,6 +140,29 @@ func (ph ParamHolder) InitParam(ctx sdk.Context) error {
 		ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		TreasurySpendDecideSec:  int64(7 * 24 * 3600),
+		TreasurySpendPassRatio:  types.NewDecFromRat(70, 100),
+		TreasurySpendPassVotes:  types.NewCoinFromInt64(1000000 * types.Decimals),
+		TreasurySpendMinDeposit: types.NewCoinFromInt64(100000 * types.Decimals),
+
+		ContentAppealWindowSec:  int64(7 * 24 * 3600),
+		ContentAppealDecideSec:  int64(7 * 24 * 3600),
+		ContentAppealPassRatio:  types.NewDecFromRat(66, 100),
+		ContentAppealPassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		ContentAppealMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+		AccountFreezeSec:        int64(30 * 24 * 3600),
+		AccountFreezeDecideSec:  int64(7 * 24 * 3600),
+		AccountFreezePassRatio:  types.NewDecFromRat(66, 100),
+		AccountFreezePassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		AccountFreezeMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+		ProposalVetoRatio: types.NewDecFromRat(334, 1000),
+
+		ProposalDepositSec: int64(7 * 24 * 3600),
+
+		ProposalMinInitialDeposit: types.NewCoinFromInt64(10 * types.Decimals),
 	}
 	if err := ph.setProposalParam(ctx, proposalParam); err != nil {
 		return err
@@ -237,6 +274,10 @@ func (ph ParamHolder) GetGlobalAllocationParam(
 	if err := ph.cdc.UnmarshalBinaryLengthPrefixed(allocationBytes, allocation); err != nil {
 		return nil, ErrFailedToUnmarshalGlobalAllocationParam(err)
 	}
+	// param stored before treasury was introduced doesn't allocate to treasury
+	if allocation.TreasuryAllocation == (sdk.Dec{}) {
+		allocation.TreasuryAllocation = sdk.ZeroDec()
+	}
 	return allocation, nil
 }
 
@@ -252,6 +293,19 @@ func (ph ParamHolder) GetInfraInternalAllocationParam(
 	if err := ph.cdc.UnmarshalBinaryLengthPrefixed(allocationBytes, allocation); err != nil {
 		return nil, ErrFailedToUnmarshalInfraInternalAllocationParam(err)
 	}
+	// param stored before infra provider registration was introduced
+	if allocation.InfraProviderMinDeposit.IsNil() {
+		allocation.InfraProviderMinDeposit = types.NewCoinFromInt64(1000000 * types.Decimals)
+	}
+	if allocation.InfraProviderCoinReturnIntervalSec == 0 {
+		allocation.InfraProviderCoinReturnIntervalSec = int64(7 * 24 * 3600)
+	}
+	if allocation.InfraProviderCoinReturnTimes == 0 {
+		allocation.InfraProviderCoinReturnTimes = int64(7)
+	}
+	if allocation.InfraProviderDisputePenalty.IsNil() {
+		allocation.InfraProviderDisputePenalty = types.NewCoinFromInt64(10000 * types.Decimals)
+	}
 	return allocation, nil
 }
 
@@ -294,6 +348,10 @@ func (ph ParamHolder) GetVoteParam(ctx sdk.Context) (*VoteParam, sdk.Error) {
 	if err := ph.cdc.UnmarshalBinaryLengthPrefixed(paramBytes, param); err != nil {
 		return nil, ErrFailedToUnmarshalVoteParam(err)
 	}
+	// param stored before redelegation was introduced doesn't have a redelegation limit
+	if param.MaxRedelegations == 0 {
+		param.MaxRedelegations = int64(7)
+	}
 	return param, nil
 }
 
@@ -308,6 +366,64 @@ func (ph ParamHolder) GetProposalParam(ctx sdk.Context) (*ProposalParam, sdk.Err
 	if err := ph.cdc.UnmarshalBinaryLengthPrefixed(paramBytes, param); err != nil {
 		return nil, ErrFailedToUnmarshalProposalParam(err)
 	}
+	// param stored before treasury spend was introduced
+	if param.TreasurySpendDecideSec == 0 {
+		param.TreasurySpendDecideSec = int64(7 * 24 * 3600)
+	}
+	if param.TreasurySpendMinDeposit.IsNil() {
+		param.TreasurySpendMinDeposit = types.NewCoinFromInt64(100000 * types.Decimals)
+	}
+	if param.TreasurySpendPassRatio == (sdk.Dec{}) {
+		param.TreasurySpendPassRatio = types.NewDecFromRat(70, 100)
+	}
+	if param.TreasurySpendPassVotes.IsNil() {
+		param.TreasurySpendPassVotes = types.NewCoinFromInt64(1000000 * types.Decimals)
+	}
+	// param stored before content appeal was introduced
+	if param.ContentAppealWindowSec == 0 {
+		param.ContentAppealWindowSec = int64(7 * 24 * 3600)
+	}
+	if param.ContentAppealDecideSec == 0 {
+		param.ContentAppealDecideSec = int64(7 * 24 * 3600)
+	}
+	if param.ContentAppealMinDeposit.IsNil() {
+		param.ContentAppealMinDeposit = types.NewCoinFromInt64(100 * types.Decimals)
+	}
+	if param.ContentAppealPassRatio == (sdk.Dec{}) {
+		param.ContentAppealPassRatio = types.NewDecFromRat(66, 100)
+	}
+	if param.ContentAppealPassVotes.IsNil() {
+		param.ContentAppealPassVotes = types.NewCoinFromInt64(10000 * types.Decimals)
+	}
+	// param stored before account freeze was introduced
+	if param.AccountFreezeSec == 0 {
+		param.AccountFreezeSec = int64(30 * 24 * 3600)
+	}
+	if param.AccountFreezeDecideSec == 0 {
+		param.AccountFreezeDecideSec = int64(7 * 24 * 3600)
+	}
+	if param.AccountFreezeMinDeposit.IsNil() {
+		param.AccountFreezeMinDeposit = types.NewCoinFromInt64(100 * types.Decimals)
+	}
+	if param.AccountFreezePassRatio == (sdk.Dec{}) {
+		param.AccountFreezePassRatio = types.NewDecFromRat(66, 100)
+	}
+	if param.AccountFreezePassVotes.IsNil() {
+		param.AccountFreezePassVotes = types.NewCoinFromInt64(10000 * types.Decimals)
+	}
+	// param stored before proposal veto was introduced
+	if param.ProposalVetoRatio == (sdk.Dec{}) {
+		param.ProposalVetoRatio = types.NewDecFromRat(334, 1000)
+	}
+	// param stored before deposit period was introduced, without a deposit period
+	// every proposal would expire right after it is created
+	if param.ProposalDepositSec == 0 {
+		param.ProposalDepositSec = int64(7 * 24 * 3600)
+	}
+	// param stored before minimum initial deposit was introduced doesn't require one
+	if param.ProposalMinInitialDeposit.IsNil() {
+		param.ProposalMinInitialDeposit = types.NewCoinFromInt64(0)
+	}
 	return param, nil
 }
 
@@ -520,6 +636,70 @@ func (ph ParamHolder) setReputationParam(ctx sdk.Context, param *ReputationParam
 	return nil
 }
 
+// Export - export all parameters
+func (ph ParamHolder) Export(ctx sdk.Context) *ParamTables {
+	check := func(e error) {
+		if e != nil {
+			panic("[ph] Failed to export: " + e.Error())
+		}
+	}
+	tables := &ParamTables{}
+	globalAllocationParam, err := ph.GetGlobalAllocationParam(ctx)
+	check(err)
+	tables.GlobalAllocationParam = *globalAllocationParam
+	infraInternalAllocationParam, err := ph.GetInfraInternalAllocationParam(ctx)
+	check(err)
+	tables.InfraInternalAllocationParam = *infraInternalAllocationParam
+	postParam, err := ph.GetPostParam(ctx)
+	check(err)
+	tables.PostParam = *postParam
+	developerParam, err := ph.GetDeveloperParam(ctx)
+	check(err)
+	tables.DeveloperParam = *developerParam
+	validatorParam, err := ph.GetValidatorParam(ctx)
+	check(err)
+	tables.ValidatorParam = *validatorParam
+	voteParam, err := ph.GetVoteParam(ctx)
+	check(err)
+	tables.VoteParam = *voteParam
+	proposalParam, err := ph.GetProposalParam(ctx)
+	check(err)
+	tables.ProposalParam = *proposalParam
+	coinDayParam, err := ph.GetCoinDayParam(ctx)
+	check(err)
+	tables.CoinDayParam = *coinDayParam
+	bandwidthParam, err := ph.GetBandwidthParam(ctx)
+	check(err)
+	tables.BandwidthParam = *bandwidthParam
+	accountParam, err := ph.GetAccountParam(ctx)
+	check(err)
+	tables.AccountParam = *accountParam
+	reputationParam, err := ph.GetReputationParam(ctx)
+	check(err)
+	tables.ReputationParam = *reputationParam
+	return tables
+}
+
+// Import - import all parameters, overriding parameters set at genesis
+func (ph ParamHolder) Import(ctx sdk.Context, tb *ParamTablesIR) {
+	err := ph.InitParamFromConfig(
+		ctx,
+		tb.GlobalAllocationParam,
+		tb.InfraInternalAllocationParam,
+		tb.PostParam,
+		tb.DeveloperParam,
+		tb.ValidatorParam,
+		tb.VoteParam,
+		tb.ProposalParam,
+		tb.CoinDayParam,
+		tb.BandwidthParam,
+		tb.AccountParam,
+		tb.ReputationParam)
+	if err != nil {
+		panic("[ph] Failed to import: " + err.Error())
+	}
+}
+
 // GetPostParamKey - "post param substore"
 func GetPostParamKey() []byte {
 	return postParamSubStore
@@ -35,6 +35,7 @@ func TestGlobalAllocationParam(t *testing.T) {
 		InfraAllocation:          types.NewDecFromRat(1, 100),
 		DeveloperAllocation:      types.NewDecFromRat(1, 100),
 		ValidatorAllocation:      types.NewDecFromRat(97, 100),
+		TreasuryAllocation:       sdk.ZeroDec(),
 	}
 	err := ph.setGlobalAllocationParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -44,12 +45,50 @@ func TestGlobalAllocationParam(t *testing.T) {
 	assert.Equal(t, parameter, *resultPtr, "Global allocation param should be equal")
 }
 
+func TestGlobalAllocationParamStoredBeforeTreasury(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	// layout of global allocation param before treasury allocation was added
+	type oldGlobalAllocationParam struct {
+		GlobalGrowthRate         sdk.Dec `json:"global_growth_rate"`
+		InfraAllocation          sdk.Dec `json:"infra_allocation"`
+		ContentCreatorAllocation sdk.Dec `json:"content_creator_allocation"`
+		DeveloperAllocation      sdk.Dec `json:"developer_allocation"`
+		ValidatorAllocation      sdk.Dec `json:"validator_allocation"`
+	}
+	oldParam := oldGlobalAllocationParam{
+		GlobalGrowthRate:         types.NewDecFromRat(98, 1000),
+		ContentCreatorAllocation: types.NewDecFromRat(1, 100),
+		InfraAllocation:          types.NewDecFromRat(1, 100),
+		DeveloperAllocation:      types.NewDecFromRat(1, 100),
+		ValidatorAllocation:      types.NewDecFromRat(97, 100),
+	}
+	paramBytes, err := ph.cdc.MarshalBinaryLengthPrefixed(oldParam)
+	assert.Nil(t, err)
+	ctx.KVStore(TestKVStoreKey).Set(GetAllocationParamKey(), paramBytes)
+
+	resultPtr, sdkErr := ph.GetGlobalAllocationParam(ctx)
+	assert.Nil(t, sdkErr)
+	assert.Equal(t, GlobalAllocationParam{
+		GlobalGrowthRate:         oldParam.GlobalGrowthRate,
+		ContentCreatorAllocation: oldParam.ContentCreatorAllocation,
+		InfraAllocation:          oldParam.InfraAllocation,
+		DeveloperAllocation:      oldParam.DeveloperAllocation,
+		ValidatorAllocation:      oldParam.ValidatorAllocation,
+		TreasuryAllocation:       sdk.ZeroDec(),
+	}, *resultPtr)
+}
+
 func TestInfraInternalAllocationParam(t *testing.T) {
 	ph := NewParamHolder(TestKVStoreKey)
 	ctx := getContext()
 	parameter := InfraInternalAllocationParam{
-		StorageAllocation: types.NewDecFromRat(50, 100),
-		CDNAllocation:     types.NewDecFromRat(50, 100),
+		StorageAllocation:                  types.NewDecFromRat(50, 100),
+		CDNAllocation:                      types.NewDecFromRat(50, 100),
+		InfraProviderMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+		InfraProviderCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		InfraProviderCoinReturnTimes:       int64(7),
+		InfraProviderDisputePenalty:        types.NewCoinFromInt64(10000 * types.Decimals),
 	}
 	err := ph.setInfraInternalAllocationParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -59,6 +98,34 @@ func TestInfraInternalAllocationParam(t *testing.T) {
 	assert.Equal(t, parameter, *resultPtr, "Infra internal allocation param should be equal")
 }
 
+func TestInfraInternalAllocationParamStoredBeforeProvider(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	// layout of infra internal allocation param before infra provider was added
+	type oldInfraInternalAllocationParam struct {
+		StorageAllocation sdk.Dec `json:"storage_allocation"`
+		CDNAllocation     sdk.Dec `json:"CDN_allocation"`
+	}
+	oldParam := oldInfraInternalAllocationParam{
+		StorageAllocation: types.NewDecFromRat(50, 100),
+		CDNAllocation:     types.NewDecFromRat(50, 100),
+	}
+	paramBytes, err := ph.cdc.MarshalBinaryLengthPrefixed(oldParam)
+	assert.Nil(t, err)
+	ctx.KVStore(TestKVStoreKey).Set(GetInfraInternalAllocationParamKey(), paramBytes)
+
+	resultPtr, sdkErr := ph.GetInfraInternalAllocationParam(ctx)
+	assert.Nil(t, sdkErr)
+	assert.Equal(t, InfraInternalAllocationParam{
+		StorageAllocation:                  oldParam.StorageAllocation,
+		CDNAllocation:                      oldParam.CDNAllocation,
+		InfraProviderMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+		InfraProviderCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		InfraProviderCoinReturnTimes:       int64(7),
+		InfraProviderDisputePenalty:        types.NewCoinFromInt64(10000 * types.Decimals),
+	}, *resultPtr)
+}
+
 func TestDeveloperParam(t *testing.T) {
 	ph := NewParamHolder(TestKVStoreKey)
 	ctx := getContext()
@@ -107,6 +174,8 @@ func TestVoteParam(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationIntervalSec:        int64(7 * 24 * 3600),
+		MaxRedelegations:               int64(7),
 	}
 	err := ph.setVoteParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -116,6 +185,41 @@ func TestVoteParam(t *testing.T) {
 	assert.Equal(t, parameter, *resultPtr, "Voter param should be equal")
 }
 
+func TestVoteParamStoredBeforeRedelegation(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	// layout of vote param before redelegation was added
+	type oldVoteParam struct {
+		MinStakeIn                     types.Coin `json:"min_stake_in"`
+		VoterCoinReturnIntervalSec     int64      `json:"voter_coin_return_interval_second"`
+		VoterCoinReturnTimes           int64      `json:"voter_coin_return_times"`
+		DelegatorCoinReturnIntervalSec int64      `json:"delegator_coin_return_interval_second"`
+		DelegatorCoinReturnTimes       int64      `json:"delegator_coin_return_times"`
+	}
+	oldParam := oldVoteParam{
+		MinStakeIn:                     types.NewCoinFromInt64(1000 * types.Decimals),
+		VoterCoinReturnIntervalSec:     int64(7 * 24 * 3600),
+		VoterCoinReturnTimes:           int64(7),
+		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		DelegatorCoinReturnTimes:       int64(7),
+	}
+	paramBytes, err := ph.cdc.MarshalBinaryLengthPrefixed(oldParam)
+	assert.Nil(t, err)
+	ctx.KVStore(TestKVStoreKey).Set(GetVoteParamKey(), paramBytes)
+
+	resultPtr, sdkErr := ph.GetVoteParam(ctx)
+	assert.Nil(t, sdkErr)
+	assert.Equal(t, VoteParam{
+		MinStakeIn:                     oldParam.MinStakeIn,
+		VoterCoinReturnIntervalSec:     oldParam.VoterCoinReturnIntervalSec,
+		VoterCoinReturnTimes:           oldParam.VoterCoinReturnTimes,
+		DelegatorCoinReturnIntervalSec: oldParam.DelegatorCoinReturnIntervalSec,
+		DelegatorCoinReturnTimes:       oldParam.DelegatorCoinReturnTimes,
+		RedelegationIntervalSec:        0,
+		MaxRedelegations:               int64(7),
+	}, *resultPtr)
+}
+
 func TestProposalParam(t *testing.T) {
 	ph := NewParamHolder(TestKVStoreKey)
 	ctx := getContext()
@@ -135,6 +239,29 @@ func TestProposalParam(t *testing.T) {
 		ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		TreasurySpendDecideSec:  int64(7 * 24 * 3600),
+		TreasurySpendPassRatio:  types.NewDecFromRat(70, 100),
+		TreasurySpendPassVotes:  types.NewCoinFromInt64(1000000 * types.Decimals),
+		TreasurySpendMinDeposit: types.NewCoinFromInt64(100000 * types.Decimals),
+
+		ContentAppealWindowSec:  int64(7 * 24 * 3600),
+		ContentAppealDecideSec:  int64(7 * 24 * 3600),
+		ContentAppealPassRatio:  types.NewDecFromRat(66, 100),
+		ContentAppealPassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		ContentAppealMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+		AccountFreezeSec:        int64(30 * 24 * 3600),
+		AccountFreezeDecideSec:  int64(7 * 24 * 3600),
+		AccountFreezePassRatio:  types.NewDecFromRat(66, 100),
+		AccountFreezePassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		AccountFreezeMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+		ProposalVetoRatio: types.NewDecFromRat(334, 1000),
+
+		ProposalDepositSec: int64(7 * 24 * 3600),
+
+		ProposalMinInitialDeposit: types.NewCoinFromInt64(10 * types.Decimals),
 	}
 	err := ph.setProposalParam(ctx, &parameter)
 	assert.Nil(t, err)
@@ -144,6 +271,75 @@ func TestProposalParam(t *testing.T) {
 	assert.Equal(t, parameter, *resultPtr, "Proposal param should be equal")
 }
 
+// proposalParamBeforeUpgrade - layout of proposal param before governance upgrade
+type proposalParamBeforeUpgrade struct {
+	ContentCensorshipDecideSec  int64      `json:"content_censorship_decide_second"`
+	ContentCensorshipMinDeposit types.Coin `json:"content_censorship_min_deposit"`
+	ContentCensorshipPassRatio  sdk.Dec    `json:"content_censorship_pass_ratio"`
+	ContentCensorshipPassVotes  types.Coin `json:"content_censorship_pass_votes"`
+	ChangeParamDecideSec        int64      `json:"change_param_decide_second"`
+	ChangeParamExecutionSec     int64      `json:"change_param_execution_second"`
+	ChangeParamMinDeposit       types.Coin `json:"change_param_min_deposit"`
+	ChangeParamPassRatio        sdk.Dec    `json:"change_param_pass_ratio"`
+	ChangeParamPassVotes        types.Coin `json:"change_param_pass_votes"`
+	ProtocolUpgradeDecideSec    int64      `json:"protocol_upgrade_decide_second"`
+	ProtocolUpgradeMinDeposit   types.Coin `json:"protocol_upgrade_min_deposit"`
+	ProtocolUpgradePassRatio    sdk.Dec    `json:"protocol_upgrade_pass_ratio"`
+	ProtocolUpgradePassVotes    types.Coin `json:"protocol_upgrade_pass_votes"`
+}
+
+// setProposalParamBeforeUpgrade - store proposal param in the layout before governance upgrade
+func setProposalParamBeforeUpgrade(ctx sdk.Context, ph ParamHolder) error {
+	oldParam := proposalParamBeforeUpgrade{
+		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
+		ContentCensorshipPassRatio:  types.NewDecFromRat(50, 100),
+		ContentCensorshipPassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		ContentCensorshipMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+		ChangeParamExecutionSec:     int64(24 * 3600),
+		ChangeParamDecideSec:        int64(7 * 24 * 3600),
+		ChangeParamPassRatio:        types.NewDecFromRat(70, 100),
+		ChangeParamPassVotes:        types.NewCoinFromInt64(1000000 * types.Decimals),
+		ChangeParamMinDeposit:       types.NewCoinFromInt64(100000 * types.Decimals),
+		ProtocolUpgradeDecideSec:    int64(7 * 24 * 3600),
+		ProtocolUpgradePassRatio:    types.NewDecFromRat(80, 100),
+		ProtocolUpgradePassVotes:    types.NewCoinFromInt64(10000000 * types.Decimals),
+		ProtocolUpgradeMinDeposit:   types.NewCoinFromInt64(1000000 * types.Decimals),
+	}
+	paramBytes, err := ph.cdc.MarshalBinaryLengthPrefixed(oldParam)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(ph.key).Set(GetProposalParamKey(), paramBytes)
+	return nil
+}
+
+func TestProposalParamStoredBeforeUpgrade(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	assert.Nil(t, setProposalParamBeforeUpgrade(ctx, ph))
+
+	param, err := ph.GetProposalParam(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7*24*3600), param.ProtocolUpgradeDecideSec)
+	assert.Equal(t, int64(7*24*3600), param.TreasurySpendDecideSec)
+	assert.Equal(t, types.NewCoinFromInt64(100000*types.Decimals), param.TreasurySpendMinDeposit)
+	assert.Equal(t, types.NewDecFromRat(70, 100), param.TreasurySpendPassRatio)
+	assert.Equal(t, types.NewCoinFromInt64(1000000*types.Decimals), param.TreasurySpendPassVotes)
+	assert.Equal(t, int64(7*24*3600), param.ContentAppealWindowSec)
+	assert.Equal(t, int64(7*24*3600), param.ContentAppealDecideSec)
+	assert.Equal(t, types.NewCoinFromInt64(100*types.Decimals), param.ContentAppealMinDeposit)
+	assert.Equal(t, types.NewDecFromRat(66, 100), param.ContentAppealPassRatio)
+	assert.Equal(t, types.NewCoinFromInt64(10000*types.Decimals), param.ContentAppealPassVotes)
+	assert.Equal(t, int64(30*24*3600), param.AccountFreezeSec)
+	assert.Equal(t, int64(7*24*3600), param.AccountFreezeDecideSec)
+	assert.Equal(t, types.NewCoinFromInt64(100*types.Decimals), param.AccountFreezeMinDeposit)
+	assert.Equal(t, types.NewDecFromRat(66, 100), param.AccountFreezePassRatio)
+	assert.Equal(t, types.NewCoinFromInt64(10000*types.Decimals), param.AccountFreezePassVotes)
+	assert.Equal(t, types.NewDecFromRat(334, 1000), param.ProposalVetoRatio)
+	assert.Equal(t, int64(7*24*3600), param.ProposalDepositSec)
+	assert.True(t, param.ProposalMinInitialDeposit.IsZero())
+}
+
 func TestCoinDayParam(t *testing.T) {
 	ph := NewParamHolder(TestKVStoreKey)
 	ctx := getContext()
@@ -203,11 +399,16 @@ func TestInitParam(t *testing.T) {
 		ContentCreatorAllocation: types.NewDecFromRat(65, 100),
 		DeveloperAllocation:      types.NewDecFromRat(10, 100),
 		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		TreasuryAllocation:       sdk.ZeroDec(),
 	}
 
 	infraInternalAllocationParam := InfraInternalAllocationParam{
-		StorageAllocation: types.NewDecFromRat(50, 100),
-		CDNAllocation:     types.NewDecFromRat(50, 100),
+		StorageAllocation:                  types.NewDecFromRat(50, 100),
+		CDNAllocation:                      types.NewDecFromRat(50, 100),
+		InfraProviderMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+		InfraProviderCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		InfraProviderCoinReturnTimes:       int64(7),
+		InfraProviderDisputePenalty:        types.NewCoinFromInt64(10000 * types.Decimals),
 	}
 
 	developerParam := DeveloperParam{
@@ -235,6 +436,8 @@ func TestInitParam(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationIntervalSec:        int64(7 * 24 * 3600),
+		MaxRedelegations:               int64(7),
 	}
 	proposalParam := ProposalParam{
 		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
@@ -252,6 +455,29 @@ func TestInitParam(t *testing.T) {
 		ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		TreasurySpendDecideSec:  int64(7 * 24 * 3600),
+		TreasurySpendPassRatio:  types.NewDecFromRat(70, 100),
+		TreasurySpendPassVotes:  types.NewCoinFromInt64(1000000 * types.Decimals),
+		TreasurySpendMinDeposit: types.NewCoinFromInt64(100000 * types.Decimals),
+
+		ContentAppealWindowSec:  int64(7 * 24 * 3600),
+		ContentAppealDecideSec:  int64(7 * 24 * 3600),
+		ContentAppealPassRatio:  types.NewDecFromRat(66, 100),
+		ContentAppealPassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		ContentAppealMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+		AccountFreezeSec:        int64(30 * 24 * 3600),
+		AccountFreezeDecideSec:  int64(7 * 24 * 3600),
+		AccountFreezePassRatio:  types.NewDecFromRat(66, 100),
+		AccountFreezePassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		AccountFreezeMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+		ProposalVetoRatio: types.NewDecFromRat(334, 1000),
+
+		ProposalDepositSec: int64(7 * 24 * 3600),
+
+		ProposalMinInitialDeposit: types.NewCoinFromInt64(10 * types.Decimals),
 	}
 
 	coinDayParam := CoinDayParam{
@@ -272,6 +498,13 @@ func TestInitParam(t *testing.T) {
 		ReportOrUpvoteIntervalSec: int64(24 * 3600),
 		PostIntervalSec:           int64(600),
 		MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+		BatchViewIntervalSec:      int64(60),
+		MaxBatchViewCount:         int64(100000),
+		MaxTitleLength:            int64(100),
+		MaxContentLength:          int64(1000),
+		MaxNumOfLinks:             int64(10),
+		DonationRefundWindowSec:   int64(3600),
+		MaxCommentDepth:           int64(8),
 	}
 	checkStorage(t, ctx, ph, globalAllocationParam, infraInternalAllocationParam,
 		developerParam, validatorParam, voteParam,
@@ -287,11 +520,16 @@ func TestInitParamFromConfig(t *testing.T) {
 		ContentCreatorAllocation: types.NewDecFromRat(65, 100),
 		DeveloperAllocation:      types.NewDecFromRat(10, 100),
 		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		TreasuryAllocation:       sdk.ZeroDec(),
 	}
 
 	infraInternalAllocationParam := InfraInternalAllocationParam{
-		StorageAllocation: types.NewDecFromRat(50, 100),
-		CDNAllocation:     types.NewDecFromRat(50, 100),
+		StorageAllocation:                  types.NewDecFromRat(50, 100),
+		CDNAllocation:                      types.NewDecFromRat(50, 100),
+		InfraProviderMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+		InfraProviderCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		InfraProviderCoinReturnTimes:       int64(7),
+		InfraProviderDisputePenalty:        types.NewCoinFromInt64(10000 * types.Decimals),
 	}
 
 	developerParam := DeveloperParam{
@@ -319,6 +557,8 @@ func TestInitParamFromConfig(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationIntervalSec:        int64(7 * 24 * 3600),
+		MaxRedelegations:               int64(7),
 	}
 	proposalParam := ProposalParam{
 		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
@@ -336,6 +576,29 @@ func TestInitParamFromConfig(t *testing.T) {
 		ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		TreasurySpendDecideSec:  int64(7 * 24 * 3600),
+		TreasurySpendPassRatio:  types.NewDecFromRat(70, 100),
+		TreasurySpendPassVotes:  types.NewCoinFromInt64(1000000 * types.Decimals),
+		TreasurySpendMinDeposit: types.NewCoinFromInt64(100000 * types.Decimals),
+
+		ContentAppealWindowSec:  int64(7 * 24 * 3600),
+		ContentAppealDecideSec:  int64(7 * 24 * 3600),
+		ContentAppealPassRatio:  types.NewDecFromRat(66, 100),
+		ContentAppealPassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		ContentAppealMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+		AccountFreezeSec:        int64(30 * 24 * 3600),
+		AccountFreezeDecideSec:  int64(7 * 24 * 3600),
+		AccountFreezePassRatio:  types.NewDecFromRat(66, 100),
+		AccountFreezePassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		AccountFreezeMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+		ProposalVetoRatio: types.NewDecFromRat(334, 1000),
+
+		ProposalDepositSec: int64(7 * 24 * 3600),
+
+		ProposalMinInitialDeposit: types.NewCoinFromInt64(10 * types.Decimals),
 	}
 
 	coinDayParam := CoinDayParam{
@@ -356,6 +619,13 @@ func TestInitParamFromConfig(t *testing.T) {
 		ReportOrUpvoteIntervalSec: int64(24 * 3600),
 		PostIntervalSec:           int64(600),
 		MaxReportReputation:       types.NewCoinFromInt64(100 * types.Decimals),
+		BatchViewIntervalSec:      int64(60),
+		MaxBatchViewCount:         int64(100000),
+		MaxTitleLength:            int64(100),
+		MaxContentLength:          int64(1000),
+		MaxNumOfLinks:             int64(10),
+		DonationRefundWindowSec:   int64(3600),
+		MaxCommentDepth:           int64(8),
 	}
 	repParam := ReputationParam{
 		BestContentIndexN: 10,
@@ -467,3 +737,25 @@ func TestUpdateGlobalGrowthRate(t *testing.T) {
 		assert.Equal(t, globalParam.GlobalGrowthRate, tc.expectGrowthRate)
 	}
 }
+
+func TestExportImport(t *testing.T) {
+	ph := NewParamHolder(TestKVStoreKey)
+	ctx := getContext()
+	err := ph.InitParam(ctx)
+	assert.Nil(t, err)
+	err = ph.UpdateGlobalGrowthRate(ctx, types.NewDecFromRat(5, 100))
+	assert.Nil(t, err)
+
+	tables := ph.Export(ctx).ToIR()
+
+	ctx2 := getContext()
+	ph.Import(ctx2, tables)
+	expected, err := ph.cdc.MarshalJSON(ph.Export(ctx).ToIR())
+	assert.Nil(t, err)
+	actual, err := ph.cdc.MarshalJSON(ph.Export(ctx2).ToIR())
+	assert.Nil(t, err)
+	assert.Equal(t, expected, actual)
+	globalParam, err := ph.GetGlobalAllocationParam(ctx2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewDecFromRat(5, 100), globalParam.GlobalGrowthRate)
+}
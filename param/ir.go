@@ -0,0 +1,4 @@
+package param
+
+// ParamTablesIR - same
+type ParamTablesIR = ParamTables
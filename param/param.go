@@ -14,20 +14,30 @@ type Parameter interface{}
 // ContentCreatorAllocation - percentage for all content creator related allocation
 // DeveloperAllocation - percentage of inflation for developers
 // ValidatorAllocation - percentage of inflation for validators
+// TreasuryAllocation - percentage of inflation for community treasury
 type GlobalAllocationParam struct {
 	GlobalGrowthRate         sdk.Dec `json:"global_growth_rate"`
 	InfraAllocation          sdk.Dec `json:"infra_allocation"`
 	ContentCreatorAllocation sdk.Dec `json:"content_creator_allocation"`
 	DeveloperAllocation      sdk.Dec `json:"developer_allocation"`
 	ValidatorAllocation      sdk.Dec `json:"validator_allocation"`
+	TreasuryAllocation       sdk.Dec `json:"treasury_allocation"`
 }
 
 // InfraInternalAllocationParam - infra internal allocation parameters
 // StorageAllocation - percentage for storage provider (not in use now)
 // CDNAllocation - percentage for CDN provider (not in use now)
+// InfraProviderMinDeposit - minimum deposit to become an infra provider
+// InfraProviderCoinReturnIntervalSec - when revoke, coin return to infra provider by coin return event
+// InfraProviderCoinReturnTimes - when revoke, coin return to infra provider by coin return event
+// InfraProviderDisputePenalty - coin slashed from provider deposit when an app disputes a usage report
 type InfraInternalAllocationParam struct {
-	StorageAllocation sdk.Dec `json:"storage_allocation"`
-	CDNAllocation     sdk.Dec `json:"CDN_allocation"`
+	StorageAllocation                  sdk.Dec    `json:"storage_allocation"`
+	CDNAllocation                      sdk.Dec    `json:"CDN_allocation"`
+	InfraProviderMinDeposit            types.Coin `json:"infra_provider_min_deposit"`
+	InfraProviderCoinReturnIntervalSec int64      `json:"infra_provider_coin_return_interval_second"`
+	InfraProviderCoinReturnTimes       int64      `json:"infra_provider_coin_return_times"`
+	InfraProviderDisputePenalty        types.Coin `json:"infra_provider_dispute_penalty"`
 }
 
 // VoteParam - vote paramters
@@ -36,12 +46,16 @@ type InfraInternalAllocationParam struct {
 // VoterCoinReturnTimes - when withdraw or revoke, the deposit return to voter by return event
 // DelegatorCoinReturnIntervalSec - when withdraw or revoke, the deposit return to delegator by return event
 // DelegatorCoinReturnTimes - when withdraw or revoke, the deposit return to delegator by return event
+// RedelegationIntervalSec - redelegated power can't be redelegated again until this period passed
+// MaxRedelegations - maximum number of in-flight redelegations per delegator
 type VoteParam struct {
 	MinStakeIn                     types.Coin `json:"min_stake_in"`
 	VoterCoinReturnIntervalSec     int64      `json:"voter_coin_return_interval_second"`
 	VoterCoinReturnTimes           int64      `json:"voter_coin_return_times"`
 	DelegatorCoinReturnIntervalSec int64      `json:"delegator_coin_return_interval_second"`
 	DelegatorCoinReturnTimes       int64      `json:"delegator_coin_return_times"`
+	RedelegationIntervalSec        int64      `json:"redelegation_interval_second"`
+	MaxRedelegations               int64      `json:"max_redelegations"`
 }
 
 // ProposalParam - proposal parameters
@@ -58,6 +72,23 @@ type VoteParam struct {
 // ProtocolUpgradeMinDeposit - minimum deposit to propose protocol upgrade proposal
 // ProtocolUpgradePassRatio - upvote and downvote ratio for protocol upgrade proposal
 // ProtocolUpgradePassVotes - minimum voting power required to pass protocol upgrade proposal
+// TreasurySpendDecideSec - seconds after treasury spend proposal created till expired
+// TreasurySpendMinDeposit - minimum deposit to propose treasury spend proposal
+// TreasurySpendPassRatio - upvote and downvote ratio for treasury spend proposal
+// TreasurySpendPassVotes - minimum voting power required to pass treasury spend proposal
+// ContentAppealWindowSec - seconds after content censorship passed till its author can't appeal
+// ContentAppealDecideSec - seconds after content appeal proposal created till expired
+// ContentAppealMinDeposit - minimum deposit to propose content appeal proposal
+// ContentAppealPassRatio - upvote and downvote ratio for content appeal proposal
+// ContentAppealPassVotes - minimum voting power required to pass content appeal proposal
+// AccountFreezeSec - seconds an account is frozen after account freeze proposal passed
+// AccountFreezeDecideSec - seconds after account freeze or unfreeze proposal created till expired
+// AccountFreezeMinDeposit - minimum deposit to propose account freeze or unfreeze proposal
+// AccountFreezePassRatio - upvote and downvote ratio for account freeze or unfreeze proposal
+// AccountFreezePassVotes - minimum voting power required to pass account freeze or unfreeze proposal
+// ProposalVetoRatio - veto ratio of all votes above which proposal is vetoed and its deposit is burned
+// ProposalDepositSec - seconds after proposal created till its deposit period ends
+// ProposalMinInitialDeposit - minimum deposit creator puts in when proposal is created
 type ProposalParam struct {
 	ContentCensorshipDecideSec  int64      `json:"content_censorship_decide_second"`
 	ContentCensorshipMinDeposit types.Coin `json:"content_censorship_min_deposit"`
@@ -72,6 +103,23 @@ type ProposalParam struct {
 	ProtocolUpgradeMinDeposit   types.Coin `json:"protocol_upgrade_min_deposit"`
 	ProtocolUpgradePassRatio    sdk.Dec    `json:"protocol_upgrade_pass_ratio"`
 	ProtocolUpgradePassVotes    types.Coin `json:"protocol_upgrade_pass_votes"`
+	TreasurySpendDecideSec      int64      `json:"treasury_spend_decide_second"`
+	TreasurySpendMinDeposit     types.Coin `json:"treasury_spend_min_deposit"`
+	TreasurySpendPassRatio      sdk.Dec    `json:"treasury_spend_pass_ratio"`
+	TreasurySpendPassVotes      types.Coin `json:"treasury_spend_pass_votes"`
+	ContentAppealWindowSec      int64      `json:"content_appeal_window_second"`
+	ContentAppealDecideSec      int64      `json:"content_appeal_decide_second"`
+	ContentAppealMinDeposit     types.Coin `json:"content_appeal_min_deposit"`
+	ContentAppealPassRatio      sdk.Dec    `json:"content_appeal_pass_ratio"`
+	ContentAppealPassVotes      types.Coin `json:"content_appeal_pass_votes"`
+	AccountFreezeSec            int64      `json:"account_freeze_second"`
+	AccountFreezeDecideSec      int64      `json:"account_freeze_decide_second"`
+	AccountFreezeMinDeposit     types.Coin `json:"account_freeze_min_deposit"`
+	AccountFreezePassRatio      sdk.Dec    `json:"account_freeze_pass_ratio"`
+	AccountFreezePassVotes      types.Coin `json:"account_freeze_pass_votes"`
+	ProposalVetoRatio           sdk.Dec    `json:"proposal_veto_ratio"`
+	ProposalDepositSec          int64      `json:"proposal_deposit_second"`
+	ProposalMinInitialDeposit   types.Coin `json:"proposal_min_initial_deposit"`
 }
 
 // DeveloperParam - developer parameters
@@ -140,10 +188,24 @@ type AccountParam struct {
 // PostParam - post parameters
 // ReportOrUpvoteIntervalSec - report interval second
 // PostIntervalSec - post interval second
+// BatchViewIntervalSec - minimum interval second between batch views from an app
+// MaxBatchViewCount - maximum total view count of a batch view
+// MaxTitleLength - maximum number of characters of post title, zero means no limit
+// MaxContentLength - maximum number of characters of inline post content, zero means no limit
+// MaxNumOfLinks - maximum number of links per post, zero means no limit
+// DonationRefundWindowSec - seconds after donation the donor or app can refund it within the day of donation, zero disables refund
+// MaxCommentDepth - maximum depth of a comment thread, comment to a root post is at depth 1, zero means no limit
 type PostParam struct {
 	ReportOrUpvoteIntervalSec int64      `json:"report_or_upvote_interval_second"`
 	PostIntervalSec           int64      `json:"post_interval_sec"`
 	MaxReportReputation       types.Coin `json:"max_report_reputation"`
+	BatchViewIntervalSec      int64      `json:"batch_view_interval_second"`
+	MaxBatchViewCount         int64      `json:"max_batch_view_count"`
+	MaxTitleLength            int64      `json:"max_title_length"`
+	MaxContentLength          int64      `json:"max_content_length"`
+	MaxNumOfLinks             int64      `json:"max_num_of_links"`
+	DonationRefundWindowSec   int64      `json:"donation_refund_window_second"`
+	MaxCommentDepth           int64      `json:"max_comment_depth"`
 }
 
 // BestContentIndexN - hard cap of how many content can be indexed every round.
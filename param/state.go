@@ -0,0 +1,21 @@
+package param
+
+// ParamTables - state of parameters, no pk
+type ParamTables struct {
+	GlobalAllocationParam        GlobalAllocationParam        `json:"global_allocation_param"`
+	InfraInternalAllocationParam InfraInternalAllocationParam `json:"infra_internal_allocation_param"`
+	PostParam                    PostParam                    `json:"post_param"`
+	DeveloperParam               DeveloperParam               `json:"developer_param"`
+	ValidatorParam               ValidatorParam               `json:"validator_param"`
+	VoteParam                    VoteParam                    `json:"vote_param"`
+	ProposalParam                ProposalParam                `json:"proposal_param"`
+	CoinDayParam                 CoinDayParam                 `json:"coin_day_param"`
+	BandwidthParam               BandwidthParam               `json:"bandwidth_param"`
+	AccountParam                 AccountParam                 `json:"account_param"`
+	ReputationParam              ReputationParam              `json:"reputation_param"`
+}
+
+// ToIR - same
+func (p ParamTables) ToIR() ParamTablesIR {
+	return p
+}
@@ -60,19 +60,24 @@ func TestForceValidatorVote(t *testing.T) {
 		ContentCreatorAllocation: types.NewDecFromRat(1, 100),
 		DeveloperAllocation:      types.NewDecFromRat(1, 100),
 		ValidatorAllocation:      types.NewDecFromRat(97, 100),
+		TreasuryAllocation:       types.NewDecFromRat(0, 100),
 	}
 
 	changeAllocationMsg := proposal.NewChangeGlobalAllocationParamMsg(accountName, desc, "")
 	test.SignCheckDeliver(t, lb, changeAllocationMsg, 2, true, accountTransactionPriv, baseTime)
 
+	// voting starts once the minimum deposit is met on top of the initial deposit
+	depositProposalMsg := proposal.NewDepositProposalMsg(accountName, int64(1), "99990")
+	test.SignCheckDeliver(t, lb, depositProposalMsg, 3, true, accountTransactionPriv, baseTime)
+
 	accBalance := totalCoin.Minus(depositCoin).Minus(depositCoin).Minus(types.NewCoinFromInt64(1 * types.Decimals))
 	test.CheckBalance(t, accountName, lb, accBalance.Minus(test.ChangeParamMinDeposit))
 	test.CheckBalance(t, accountName2, lb, accBalance)
 
 	test.SimulateOneBlock(lb, baseTime)
 	// let validator 1 vote and validator 2 not vote.
-	voteProposalMsg := proposal.NewVoteProposalMsg(accountName, int64(1), true)
-	test.SignCheckDeliver(t, lb, voteProposalMsg, 3, true, accountTransactionPriv, baseTime)
+	voteProposalMsg := proposal.NewVoteProposalMsg(accountName, int64(1), types.VoteOptionYes)
+	test.SignCheckDeliver(t, lb, voteProposalMsg, 4, true, accountTransactionPriv, baseTime)
 
 	test.SimulateOneBlock(lb, baseTime+test.ProposalDecideSec+1)
 	test.SimulateOneBlock(lb, baseTime+(test.ProposalDecideSec+test.ParamChangeExecutionSec)+2)
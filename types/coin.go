@@ -91,6 +91,12 @@ func (coin Coin) String() string {
 	return fmt.Sprintf("coin:%v", coin.Amount)
 }
 
+// IsNil - returns true if amount is not set,
+// like a coin field decoded from data stored before the field existed
+func (coin Coin) IsNil() bool {
+	return coin.Amount == sdk.Int{}
+}
+
 // IsZero - returns if this represents no money
 func (coin Coin) IsZero() bool {
 	return coin.Amount.Sign() == 0
@@ -319,6 +319,37 @@ func TestIsPositiveCoin(t *testing.T) {
 	}
 }
 
+func TestIsNilCoin(t *testing.T) {
+	testCases := []struct {
+		testName     string
+		inputOne     Coin
+		expectResult bool
+	}{
+		{
+			testName:     "unset coin is nil",
+			inputOne:     Coin{},
+			expectResult: true,
+		},
+		{
+			testName:     "0 is not nil",
+			inputOne:     NewCoinFromInt64(0),
+			expectResult: false,
+		},
+		{
+			testName:     "bigInt128 is not nil",
+			inputOne:     bigCoin,
+			expectResult: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		res := tc.inputOne.IsNil()
+		if res != tc.expectResult {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, res, tc.expectResult)
+		}
+	}
+}
+
 func TestIsNotNegativeCoin(t *testing.T) {
 	testCases := []struct {
 		testName     string
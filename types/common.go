@@ -21,6 +21,9 @@ type ProposalResult int
 // indicates proposal type
 type ProposalType int
 
+// indicates the option of a vote to a proposal
+type VoteOption int
+
 // indicates donation type
 type DonationType int
 
@@ -41,6 +44,12 @@ type IDToURLMapping struct {
 	URL        string `json:"url"`
 }
 
+// Beneficiary - account shares post income by weight, weights of a post sum to one
+type Beneficiary struct {
+	Username AccountKey `json:"username"`
+	Weight   string     `json:"weight"`
+}
+
 // PenaltyList - get validator who doesn't vote for proposal
 type PenaltyList struct {
 	PenaltyList []AccountKey `json:"penalty_list"`
@@ -27,14 +27,27 @@ const (
 	AppAndPreAuthorizationPermission = Permission(6)
 
 	// Different proposal result
-	ProposalNotPass = ProposalResult(0)
-	ProposalPass    = ProposalResult(1)
-	ProposalRevoked = ProposalResult(2)
+	ProposalNotPass       = ProposalResult(0)
+	ProposalPass          = ProposalResult(1)
+	ProposalRevoked       = ProposalResult(2)
+	ProposalVetoed        = ProposalResult(3)
+	ProposalDepositNotMet = ProposalResult(4)
+
+	// Different vote options
+	VoteOptionNone       = VoteOption(0)
+	VoteOptionYes        = VoteOption(1)
+	VoteOptionNo         = VoteOption(2)
+	VoteOptionAbstain    = VoteOption(3)
+	VoteOptionNoWithVeto = VoteOption(4)
 
 	// Different proposal types
 	ChangeParam       = ProposalType(0)
 	ContentCensorship = ProposalType(1)
 	ProtocolUpgrade   = ProposalType(2)
+	TreasurySpend     = ProposalType(3)
+	ContentAppeal     = ProposalType(4)
+	AccountFreeze     = ProposalType(5)
+	AccountUnfreeze   = ProposalType(6)
 
 	// Different donation types
 	DirectDeposit = DonationType(0)
@@ -55,16 +68,19 @@ const (
 	ProposalReturnCoin   = TransferDetailType(11)
 	GenesisCoin          = TransferDetailType(12)
 	ClaimInterest        = TransferDetailType(13)
+	DonationRefundIn     = TransferDetailType(14)
+	TreasuryPayout       = TransferDetailType(15)
 
 	// Different possible outcomes
-	TransferOut      = TransferDetailType(20)
-	DonationOut      = TransferDetailType(21)
-	Delegate         = TransferDetailType(22)
-	VoterDeposit     = TransferDetailType(23)
-	ValidatorDeposit = TransferDetailType(24)
-	DeveloperDeposit = TransferDetailType(25)
-	InfraDeposit     = TransferDetailType(26)
-	ProposalDeposit  = TransferDetailType(27)
+	TransferOut       = TransferDetailType(20)
+	DonationOut       = TransferDetailType(21)
+	Delegate          = TransferDetailType(22)
+	VoterDeposit      = TransferDetailType(23)
+	ValidatorDeposit  = TransferDetailType(24)
+	DeveloperDeposit  = TransferDetailType(25)
+	InfraDeposit      = TransferDetailType(26)
+	ProposalDeposit   = TransferDetailType(27)
+	DonationRefundOut = TransferDetailType(28)
 
 	// punishment type
 	UnknownPunish      = PunishType(0)
@@ -127,6 +143,24 @@ const (
 	// MaximumNumOfLinks - maximum number of links per post
 	MaximumNumOfLinks = 10
 
+	// MaximumLengthOfContentURI - maximum length of storage URI of content-addressed post
+	MaximumLengthOfContentURI = 200
+
+	// MaximumNumOfTags - maximum number of tags per post
+	MaximumNumOfTags = 5
+
+	// MaximumLengthOfTag - maximum length of post tag
+	MaximumLengthOfTag = 32
+
+	// MaximumNumOfBeneficiaries - maximum number of beneficiaries per post
+	MaximumNumOfBeneficiaries = 10
+
+	// MaximumNumOfBatchViews - maximum number of views per batch view msg
+	MaximumNumOfBatchViews = 1000
+
+	// MaximumBatchViewCount - hard cap of total view count of a batch view msg
+	MaximumBatchViewCount = 1000000000
+
 	// MaximumLengthOfDeveloperWebsite - maximum length of developer website
 	MaximumLengthOfDeveloperWebsite = 100
 
@@ -136,9 +170,18 @@ const (
 	// MaximumLengthOfAppMetadata - maximum length of developer App meta data
 	MaximumLengthOfAppMetadata = 1000
 
+	// MaximumNumOfConsumptionReceipts - maximum number of consumption receipts per report
+	MaximumNumOfConsumptionReceipts = 100
+
+	// MaximumNumOfUsageStatements - maximum number of app usage statements per infra report
+	MaximumNumOfUsageStatements = 100
+
 	// MaximumLengthOfProposalReason - maximum length of proposal reason
 	MaximumLengthOfProposalReason = 1000
 
+	// MaximumTreasurySpendTimes - maximum number of payments of a vesting treasury spend
+	MaximumTreasurySpendTimes = 100
+
 	// InitAccountWithFullCoinDayMemo - init account with full coin day memo
 	InitAccountWithFullCoinDayMemo = "open account deposit"
 
@@ -160,6 +203,10 @@ const (
 	// CoinDayRecordIntervalSec - coin day record in the same interval bucket will be merged
 	CoinDayRecordIntervalSec = 1200
 
+	// MaximumDonationRefundWindowSec - hard cap of donation refund window, friction can
+	// only be rolled back within the day of donation, which ends long before its reward event
+	MaximumDonationRefundWindowSec = 24 * 3600
+
 	// TendermintValidatorPower - every validator has const power in tendermint engine.
 	TendermintValidatorPower = 1000
 
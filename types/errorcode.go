@@ -30,6 +30,7 @@ const (
 	CodeWrongNumberOfSigners sdk.CodeType = 153
 	CodeInvalidSequence      sdk.CodeType = 154
 	CodeUnverifiedBytes      sdk.CodeType = 155
+	CodeAccountFrozen        sdk.CodeType = 156
 
 	// ABCI Response Codes
 	CodeGenesisFailed sdk.CodeType = 200
@@ -147,6 +148,34 @@ const (
 	CodeGetSourcePost                        sdk.CodeType = 439
 	CodePostTooOften                         sdk.CodeType = 440
 	CodePostQueryFailed                      sdk.CodeType = 441
+	CodeInvalidBeneficiary                   sdk.CodeType = 442
+	CodeTooManyBeneficiaries                 sdk.CodeType = 443
+	CodeInvalidBeneficiaryWeight             sdk.CodeType = 444
+	CodePostBeneficiaryRewardNotFound        sdk.CodeType = 445
+	CodeFailedToMarshalBeneficiaryReward     sdk.CodeType = 446
+	CodeFailedToUnmarshalBeneficiaryReward   sdk.CodeType = 447
+	CodePostRevisionNotFound                 sdk.CodeType = 448
+	CodeFailedToMarshalPostRevision          sdk.CodeType = 449
+	CodeFailedToUnmarshalPostRevision        sdk.CodeType = 450
+	CodeInvalidBatchView                     sdk.CodeType = 451
+	CodeTooManyBatchViews                    sdk.CodeType = 452
+	CodeBatchViewTooOften                    sdk.CodeType = 453
+	CodeBatchViewCountExceedLimit            sdk.CodeType = 454
+	CodeInvalidContentHash                   sdk.CodeType = 455
+	CodeContentURITooLong                    sdk.CodeType = 456
+	CodeContentAndContentHashConflict        sdk.CodeType = 457
+	CodeInvalidTag                           sdk.CodeType = 458
+	CodeTooManyTags                          sdk.CodeType = 459
+	CodeDonationRefundWindowExpired          sdk.CodeType = 460
+	CodeDonationAlreadyRefunded              sdk.CodeType = 461
+	CodeRefundDonationNotAllowed             sdk.CodeType = 462
+	CodeInvalidDonationID                    sdk.CodeType = 463
+	CodeRepliesNotAllowed                    sdk.CodeType = 464
+	CodeCommentTooDeep                       sdk.CodeType = 465
+	CodeCensoredContentNotFound              sdk.CodeType = 466
+	CodeFailedToMarshalCensoredContent       sdk.CodeType = 467
+	CodeFailedToUnmarshalCensoredContent     sdk.CodeType = 468
+	CodeCommentTreeTooLarge                  sdk.CodeType = 469
 
 	// Lino validator errors reserve 500 ~ 599
 	CodeValidatorNotFound              sdk.CodeType = 500
@@ -158,6 +187,8 @@ const (
 	CodeUnbalancedAccount              sdk.CodeType = 506
 	CodeValidatorPubKeyAlreadyExist    sdk.CodeType = 507
 	CodeValidatorQueryFailed           sdk.CodeType = 508
+	CodeFailedToMarshalMissedVote      sdk.CodeType = 509
+	CodeFailedToUnmarshalMissedVote    sdk.CodeType = 510
 
 	// Lino global errors reserve 600 ~ 699
 	CodeInfraInflationCoinConversion           sdk.CodeType = 600
@@ -188,6 +219,10 @@ const (
 	CodePastDayIsNegative                      sdk.CodeType = 625
 	CodeFailedToParseEventCacheList            sdk.CodeType = 626
 	CodeGlobalQueryFailed                      sdk.CodeType = 627
+	CodeContentRewardEventNotFound             sdk.CodeType = 628
+	CodeUnclaimedFrictionNotEnough             sdk.CodeType = 629
+	CodeTreasuryNotEnough                      sdk.CodeType = 630
+	CodeFrictionDayPassed                      sdk.CodeType = 631
 
 	// Vote errors reserve 700 ~ 799
 	CodeVoterNotFound                  sdk.CodeType = 700
@@ -205,6 +240,14 @@ const (
 	CodeValidatorCannotRevoke          sdk.CodeType = 712
 	CodeVoteAlreadyExist               sdk.CodeType = 713
 	CodeVoteQueryFailed                sdk.CodeType = 714
+	CodeFailedToMarshalRedelegations   sdk.CodeType = 715
+	CodeFailedToUnmarshalRedelegations sdk.CodeType = 716
+	CodeRedelegateToSameVoter          sdk.CodeType = 717
+	CodeIllegalRedelegate              sdk.CodeType = 718
+	CodeRedelegationInFlight           sdk.CodeType = 719
+	CodeTooManyRedelegations           sdk.CodeType = 720
+	CodeInvalidDefaultVoteOption       sdk.CodeType = 721
+	CodeNotValidator                   sdk.CodeType = 722
 
 	// Lino infra errors reserve 800 ~ 899
 	CodeInfraProviderNotFound              sdk.CodeType = 800
@@ -215,23 +258,46 @@ const (
 	CodeFailedToUnmarshalInfraProviderList sdk.CodeType = 805
 	CodeInvalidUsage                       sdk.CodeType = 806
 	CodeInfraQueryFailed                   sdk.CodeType = 807
+	CodeInfraProviderAlreadyExist          sdk.CodeType = 808
+	CodeInsufficientInfraProviderDeposit   sdk.CodeType = 809
+	CodeInvalidUsageStatement              sdk.CodeType = 810
+	CodeTooManyUsageStatements             sdk.CodeType = 811
+	CodeInvalidUsagePeriod                 sdk.CodeType = 812
+	CodeInvalidUsageSignature              sdk.CodeType = 813
+	CodeInfraAppNotFound                   sdk.CodeType = 814
+	CodeUsageAlreadyReported               sdk.CodeType = 815
+	CodeUsageReportNotFound                sdk.CodeType = 816
+	CodeUsageReportAlreadyDisputed         sdk.CodeType = 817
+	CodeFailedToMarshalUsageReport         sdk.CodeType = 818
+	CodeFailedToUnmarshalUsageReport       sdk.CodeType = 819
+	CodeUsageReportStillDisputable         sdk.CodeType = 820
 
 	// Lino developer errors reserve 900 ~ 999
-	CodeDeveloperListNotFound          sdk.CodeType = 900
-	CodeFailedToMarshalDeveloper       sdk.CodeType = 901
-	CodeFailedToMarshalDeveloperList   sdk.CodeType = 902
-	CodeFailedToUnmarshalDeveloper     sdk.CodeType = 903
-	CodeFailedToUnmarshalDeveloperList sdk.CodeType = 904
-	CodeDeveloperAlreadyExist          sdk.CodeType = 905
-	CodeInsufficientDeveloperDeposit   sdk.CodeType = 906
-	CodeInvalidAuthorizedApp           sdk.CodeType = 907
-	CodeInvalidValidityPeriod          sdk.CodeType = 908
-	CodeGrantPermissionTooHigh         sdk.CodeType = 909
-	CodeInvalidWebsite                 sdk.CodeType = 910
-	CodeInvalidDescription             sdk.CodeType = 911
-	CodeInvalidAppMetadata             sdk.CodeType = 912
-	CodeInvalidGrantPermission         sdk.CodeType = 913
-	CodeDeveloperQueryFailed           sdk.CodeType = 914
+	CodeDeveloperListNotFound                sdk.CodeType = 900
+	CodeFailedToMarshalDeveloper             sdk.CodeType = 901
+	CodeFailedToMarshalDeveloperList         sdk.CodeType = 902
+	CodeFailedToUnmarshalDeveloper           sdk.CodeType = 903
+	CodeFailedToUnmarshalDeveloperList       sdk.CodeType = 904
+	CodeDeveloperAlreadyExist                sdk.CodeType = 905
+	CodeInsufficientDeveloperDeposit         sdk.CodeType = 906
+	CodeInvalidAuthorizedApp                 sdk.CodeType = 907
+	CodeInvalidValidityPeriod                sdk.CodeType = 908
+	CodeGrantPermissionTooHigh               sdk.CodeType = 909
+	CodeInvalidWebsite                       sdk.CodeType = 910
+	CodeInvalidDescription                   sdk.CodeType = 911
+	CodeInvalidAppMetadata                   sdk.CodeType = 912
+	CodeInvalidGrantPermission               sdk.CodeType = 913
+	CodeDeveloperQueryFailed                 sdk.CodeType = 914
+	CodeFailedToMarshalConsumptionSnapshot   sdk.CodeType = 915
+	CodeFailedToUnmarshalConsumptionSnapshot sdk.CodeType = 916
+	CodeFailedToMarshalConsumptionReceipt    sdk.CodeType = 917
+	CodeFailedToUnmarshalConsumptionReceipt  sdk.CodeType = 918
+	CodeReceiptModeDisabled                  sdk.CodeType = 919
+	CodeInvalidConsumptionReceipt            sdk.CodeType = 920
+	CodeConsumptionReceiptAlreadyReported    sdk.CodeType = 921
+	CodeInvalidReceiptPeriod                 sdk.CodeType = 922
+	CodeInvalidReceiptSignature              sdk.CodeType = 923
+	CodeTooManyConsumptionReceipts           sdk.CodeType = 924
 
 	// Param errors reserve 1000 ~ 1099
 	CodeParamHolderGenesisError                       sdk.CodeType = 1000
@@ -294,6 +360,24 @@ const (
 	CodeIllegalParameter                sdk.CodeType = 1116
 	CodeReasonTooLong                   sdk.CodeType = 1117
 	CodeProposalQueryFailed             sdk.CodeType = 1118
+	CodeInvalidRevision                 sdk.CodeType = 1119
+	CodeCensorshipRevisionNotFound      sdk.CodeType = 1120
+	CodeInvalidVoteOption               sdk.CodeType = 1121
+	CodeNotDepositingProposal           sdk.CodeType = 1122
+	CodeFailedToMarshalDeposit          sdk.CodeType = 1123
+	CodeFailedToUnmarshalDeposit        sdk.CodeType = 1124
+	CodeInvalidSpendSchedule            sdk.CodeType = 1125
+	CodeFailedToMarshalTreasurySpend    sdk.CodeType = 1126
+	CodeFailedToUnmarshalTreasurySpend  sdk.CodeType = 1127
+	CodeCensorshipNotPassed             sdk.CodeType = 1128
+	CodeAppealWindowExpired             sdk.CodeType = 1129
+	CodeAppealNotAllowed                sdk.CodeType = 1130
+	CodeAppealAlreadyExist              sdk.CodeType = 1131
+	CodeFailedToMarshalContentAppeal    sdk.CodeType = 1132
+	CodeFailedToUnmarshalContentAppeal  sdk.CodeType = 1133
+	CodeAccountNotFrozen                sdk.CodeType = 1134
+	CodeFailedToMarshalAccountFreeze    sdk.CodeType = 1135
+	CodeFailedToUnmarshalAccountFreeze  sdk.CodeType = 1136
 
 	// reputation errors reserve 1200 ~ 1299
 	CodeReputationQueryFailed sdk.CodeType = 1200
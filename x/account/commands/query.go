@@ -6,6 +6,7 @@ import (
 
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	acc "github.com/lino-network/lino/x/account"
 	"github.com/lino-network/lino/x/account/model"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -55,6 +56,35 @@ func GetAccountsCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetPendingReturnsCmd returns all pending coin returns of the given username
+func GetPendingReturnsCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-returns <username>",
+		Short: "Query pending coin returns and their payout schedule",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 1 || len(args[0]) == 0 {
+				return errors.New("You must provide a username")
+			}
+
+			res, err := ctx.QueryCustom(
+				acc.QuerierRoute, acc.QueryAccountPendingReturns, args[0])
+			if err != nil {
+				return err
+			}
+			pendingReturns := []model.PendingReturn{}
+			if err := cdc.UnmarshalJSON(res, &pendingReturns); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(pendingReturns); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -32,11 +32,7 @@ func CreateCoinReturnEvents(
 	ctx sdk.Context, username types.AccountKey, times int64, interval int64, coin types.Coin,
 	returnType types.TransferDetailType) ([]types.Event, sdk.Error) {
 	events := []types.Event{}
-	for i := int64(0); i < times; i++ {
-		pieceRat := coin.ToDec().Quo(sdk.NewDec(times - i))
-		piece := types.DecToCoin(pieceRat)
-		coin = coin.Minus(piece)
-
+	for _, piece := range splitCoinIntoPieces(coin, times) {
 		event := ReturnCoinEvent{
 			Username:   username,
 			Amount:     piece,
@@ -46,3 +42,15 @@ func CreateCoinReturnEvents(
 	}
 	return events, nil
 }
+
+// splitCoinIntoPieces - split coin into times pieces, the sum of pieces equals to coin
+func splitCoinIntoPieces(coin types.Coin, times int64) []types.Coin {
+	pieces := []types.Coin{}
+	for i := int64(0); i < times; i++ {
+		pieceRat := coin.ToDec().Quo(sdk.NewDec(times - i))
+		piece := types.DecToCoin(pieceRat)
+		coin = coin.Minus(piece)
+		pieces = append(pieces, piece)
+	}
+	return pieces
+}
@@ -453,6 +453,45 @@ func (accManager AccountManager) GetFrozenMoneyList(
 	return accountBank.FrozenMoneyList, nil
 }
 
+// GetPendingReturns - get all coin returns which haven't been fully paid out to user
+func (accManager AccountManager) GetPendingReturns(
+	ctx sdk.Context, username types.AccountKey) ([]model.PendingReturn, sdk.Error) {
+	accountBank, err := accManager.storage.GetBankFromAccountKey(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	pendingReturns := []model.PendingReturn{}
+	for _, frozenMoney := range accountBank.FrozenMoneyList {
+		// the i-th installment is returned at StartAt + Interval * (i + 1)
+		paid := frozenMoney.Times
+		if frozenMoney.Interval > 0 {
+			paid = (now - frozenMoney.StartAt) / frozenMoney.Interval
+		}
+		if paid < 0 {
+			paid = 0
+		}
+		if paid >= frozenMoney.Times {
+			continue
+		}
+		pieces := splitCoinIntoPieces(frozenMoney.Amount, frozenMoney.Times)
+		remaining := types.NewCoinFromInt64(0)
+		for _, piece := range pieces[paid:] {
+			remaining = remaining.Plus(piece)
+		}
+		pendingReturns = append(pendingReturns, model.PendingReturn{
+			ReturnType:            frozenMoney.ReturnType,
+			TotalAmount:           frozenMoney.Amount,
+			AmountPerInstallment:  pieces[paid],
+			RemainingAmount:       remaining,
+			TotalInstallments:     frozenMoney.Times,
+			RemainingInstallments: frozenMoney.Times - paid,
+			NextPayoutAt:          frozenMoney.StartAt + frozenMoney.Interval*(paid+1),
+		})
+	}
+	return pendingReturns, nil
+}
+
 // IncreaseSequenceByOne - increase user sequence number by one
 func (accManager AccountManager) IncreaseSequenceByOne(
 	ctx sdk.Context, username types.AccountKey) sdk.Error {
@@ -483,6 +522,22 @@ func (accManager AccountManager) AddDirectDeposit(
 	return nil
 }
 
+// MinusDirectDeposit - when donation is refunded, the direct deposit is removed from
+// total income and original income
+func (accManager AccountManager) MinusDirectDeposit(
+	ctx sdk.Context, username types.AccountKey, directDeposit types.Coin) sdk.Error {
+	reward, err := accManager.storage.GetReward(ctx, username)
+	if err != nil {
+		return err
+	}
+	reward.TotalIncome = reward.TotalIncome.Minus(directDeposit)
+	reward.OriginalIncome = reward.OriginalIncome.Minus(directDeposit)
+	if err := accManager.storage.SetReward(ctx, username, reward); err != nil {
+		return err
+	}
+	return nil
+}
+
 // AddIncomeAndReward - after the evaluate of content value, the original friction
 // will be added to original income and friciton income. The actual inflation will
 // be added to inflation income, total income and unclaim reward
@@ -830,17 +885,18 @@ func (accManager AccountManager) updateTXFromPendingCoinDayQueue(
 // AddFrozenMoney - add frozen money to user's frozen money list
 func (accManager AccountManager) AddFrozenMoney(
 	ctx sdk.Context, username types.AccountKey,
-	amount types.Coin, start, interval, times int64) sdk.Error {
+	amount types.Coin, start, interval, times int64, returnType types.TransferDetailType) sdk.Error {
 	accountBank, err := accManager.storage.GetBankFromAccountKey(ctx, username)
 	if err != nil {
 		return err
 	}
 	accManager.cleanExpiredFrozenMoney(ctx, accountBank)
 	frozenMoney := model.FrozenMoney{
-		Amount:   amount,
-		StartAt:  start,
-		Interval: interval,
-		Times:    times,
+		Amount:     amount,
+		StartAt:    start,
+		Interval:   interval,
+		Times:      times,
+		ReturnType: returnType,
 	}
 
 	accParams, err := accManager.paramHolder.GetAccountParam(ctx)
@@ -2028,7 +2028,8 @@ func TestAddFrozenMoney(t *testing.T) {
 
 	for _, tc := range testCases {
 		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Height: 1, Time: time.Unix(tc.startAt, 0)})
-		err := am.AddFrozenMoney(ctx, user1, tc.frozenAmount, tc.startAt, tc.interval, tc.times)
+		err := am.AddFrozenMoney(
+			ctx, user1, tc.frozenAmount, tc.startAt, tc.interval, tc.times, types.VoteReturnCoin)
 		if err != nil {
 			t.Errorf("%s: failed to add frozen money, got err %v", tc.testName, err)
 		}
@@ -2042,3 +2043,85 @@ func TestAddFrozenMoney(t *testing.T) {
 		}
 	}
 }
+
+func TestGetPendingReturns(t *testing.T) {
+	ctx, am, _ := setupTest(t, 1)
+	user1 := types.AccountKey("user1")
+
+	createTestAccount(ctx, am, string(user1))
+
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Height: 1, Time: time.Unix(1000, 0)})
+	err := am.AddFrozenMoney(
+		ctx, user1, types.NewCoinFromInt64(90), 1000, 10, 3, types.VoteReturnCoin)
+	if err != nil {
+		t.Errorf("TestGetPendingReturns: failed to add frozen money, got err %v", err)
+	}
+
+	testCases := []struct {
+		testName       string
+		blockTime      int64
+		expectedResult []model.PendingReturn
+	}{
+		{
+			testName:  "nothing returned yet",
+			blockTime: 1000,
+			expectedResult: []model.PendingReturn{
+				{
+					ReturnType:            types.VoteReturnCoin,
+					TotalAmount:           types.NewCoinFromInt64(90),
+					AmountPerInstallment:  types.NewCoinFromInt64(30),
+					RemainingAmount:       types.NewCoinFromInt64(90),
+					TotalInstallments:     3,
+					RemainingInstallments: 3,
+					NextPayoutAt:          1010,
+				},
+			},
+		},
+		{
+			testName:  "first installment returned",
+			blockTime: 1015,
+			expectedResult: []model.PendingReturn{
+				{
+					ReturnType:            types.VoteReturnCoin,
+					TotalAmount:           types.NewCoinFromInt64(90),
+					AmountPerInstallment:  types.NewCoinFromInt64(30),
+					RemainingAmount:       types.NewCoinFromInt64(60),
+					TotalInstallments:     3,
+					RemainingInstallments: 2,
+					NextPayoutAt:          1020,
+				},
+			},
+		},
+		{
+			testName:  "last installment pending",
+			blockTime: 1029,
+			expectedResult: []model.PendingReturn{
+				{
+					ReturnType:            types.VoteReturnCoin,
+					TotalAmount:           types.NewCoinFromInt64(90),
+					AmountPerInstallment:  types.NewCoinFromInt64(30),
+					RemainingAmount:       types.NewCoinFromInt64(30),
+					TotalInstallments:     3,
+					RemainingInstallments: 1,
+					NextPayoutAt:          1030,
+				},
+			},
+		},
+		{
+			testName:       "all returned",
+			blockTime:      1030,
+			expectedResult: []model.PendingReturn{},
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Height: 1, Time: time.Unix(tc.blockTime, 0)})
+		pendingReturns, err := am.GetPendingReturns(ctx, user1)
+		if err != nil {
+			t.Errorf("%s: failed to get pending returns, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectedResult, pendingReturns) {
+			t.Errorf("%s: diff pending returns, got %v, want %v", tc.testName, pendingReturns, tc.expectedResult)
+		}
+	}
+}
@@ -26,10 +26,24 @@ type AccountBank struct {
 
 // FrozenMoney - frozen money
 type FrozenMoney struct {
-	Amount   types.Coin `json:"amount"`
-	StartAt  int64      `json:"start_at"`
-	Times    int64      `json:"times"`
-	Interval int64      `json:"interval"`
+	Amount     types.Coin               `json:"amount"`
+	StartAt    int64                    `json:"start_at"`
+	Times      int64                    `json:"times"`
+	Interval   int64                    `json:"interval"`
+	ReturnType types.TransferDetailType `json:"return_type"`
+}
+
+// PendingReturn - frozen money which hasn't been fully returned to user
+// AmountPerInstallment - amount returned at next payout
+// RemainingAmount - total amount not returned yet
+type PendingReturn struct {
+	ReturnType            types.TransferDetailType `json:"return_type"`
+	TotalAmount           types.Coin               `json:"total_amount"`
+	AmountPerInstallment  types.Coin               `json:"amount_per_installment"`
+	RemainingAmount       types.Coin               `json:"remaining_amount"`
+	TotalInstallments     int64                    `json:"total_installments"`
+	RemainingInstallments int64                    `json:"remaining_installments"`
+	NextPayoutAt          int64                    `json:"next_payout_at"`
 }
 
 // PendingCoinDayQueue - stores a list of pending coin day and total number of coin waiting in list
@@ -24,6 +24,7 @@ const (
 	QueryAccountPendingCoinDay  = "pendingCoinDay"
 	QueryAccountGrantPubKeys    = "grantPubKey"
 	QueryAccountAllGrantPubKeys = "allGrantPubKey"
+	QueryAccountPendingReturns  = "pendingReturns"
 )
 
 // creates a querier for account REST endpoints
@@ -46,6 +47,8 @@ func NewQuerier(am AccountManager) sdk.Querier {
 			return queryAccountGrantPubKeys(ctx, cdc, path[1:], req, am)
 		case QueryAccountAllGrantPubKeys:
 			return queryAccountAllGrantPubKeys(ctx, cdc, path[1:], req, am)
+		case QueryAccountPendingReturns:
+			return queryAccountPendingReturns(ctx, cdc, path[1:], req, am)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown account query endpoint")
 		}
@@ -127,6 +130,21 @@ func queryAccountPendingCoinDay(ctx sdk.Context, cdc *wire.Codec, path []string,
 	return res, nil
 }
 
+func queryAccountPendingReturns(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, am AccountManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	pendingReturns, err := am.GetPendingReturns(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(pendingReturns)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
 func queryAccountGrantPubKeys(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, am AccountManager) ([]byte, sdk.Error) {
 	if err := types.CheckPathContentAndMinLength(path, 2); err != nil {
 		return nil, err
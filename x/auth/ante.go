@@ -10,7 +10,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
+	infra "github.com/lino-network/lino/x/infra"
 	post "github.com/lino-network/lino/x/post"
+	proposal "github.com/lino-network/lino/x/proposal"
+	val "github.com/lino-network/lino/x/validator"
+	vote "github.com/lino-network/lino/x/vote"
 )
 
 const (
@@ -30,8 +35,38 @@ func GetMsgDonationAmount(msg types.Msg) types.Coin {
 	return rst
 }
 
+// IsMsgDebitingSaving - return true if @p msg takes coins out of its signer's saving,
+// including the initial deposit taken by every proposal creation.
+func IsMsgDebitingSaving(msg types.Msg) bool {
+	switch msg.(type) {
+	case acc.TransferMsg, acc.RegisterMsg, post.DonateMsg,
+		dev.DeveloperRegisterMsg, infra.ProviderRegisterMsg,
+		vote.StakeInMsg, vote.DelegateMsg, val.ValidatorDepositMsg,
+		proposal.DepositProposalMsg, proposal.ChangeParamMsg, proposal.ProtocolUpgradeMsg,
+		proposal.ContentCensorshipMsg, proposal.TreasurySpendMsg, proposal.ContentAppealMsg,
+		proposal.AccountFreezeMsg, proposal.AccountUnfreezeMsg:
+		return true
+	}
+	return false
+}
+
+// IsMsgBlockedByFreeze - return true if @p msg moves coins out of or changes stake of its signer,
+// which is not allowed while the signer is frozen by an account freeze proposal.
+func IsMsgBlockedByFreeze(msg types.Msg) bool {
+	if IsMsgDebitingSaving(msg) {
+		return true
+	}
+	switch msg.(type) {
+	case vote.StakeOutMsg, vote.DelegatorWithdrawMsg, vote.RedelegateMsg,
+		val.ValidatorWithdrawMsg, val.ValidatorRevokeMsg:
+		return true
+	}
+	return false
+}
+
 // NewAnteHandler - return an AnteHandler
-func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager) sdk.AnteHandler {
+func NewAnteHandler(
+	am acc.AccountManager, gm global.GlobalManager, pm proposal.ProposalManager) sdk.AnteHandler {
 	return func(
 		ctx sdk.Context, tx sdk.Tx, simulate bool,
 	) (_ sdk.Context, _ sdk.Result, abort bool) {
@@ -87,6 +122,16 @@ func NewAnteHandler(am acc.AccountManager, gm global.GlobalManager) sdk.AnteHand
 				if err != nil {
 					return ctx, err.Result(), true
 				}
+				// frozen account can't move its coins or stake
+				if IsMsgBlockedByFreeze(msg) {
+					frozen, err := pm.IsAccountFrozen(ctx, types.AccountKey(msgSigner))
+					if err != nil {
+						return ctx, err.Result(), true
+					}
+					if frozen {
+						return ctx, ErrAccountFrozen(types.AccountKey(msgSigner)).Result(), true
+					}
+				}
 				donationAmount := GetMsgDonationAmount(msg)
 				// enable no-cost-donation starting BlockchainUpgrade1Update1Height
 				if ctx.BlockHeader().Height < types.BlockchainUpgrade1Update1Height ||
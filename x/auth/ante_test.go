@@ -19,8 +19,12 @@ import (
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
 	"github.com/lino-network/lino/x/global"
+	infra "github.com/lino-network/lino/x/infra"
 	post "github.com/lino-network/lino/x/post"
+	proposal "github.com/lino-network/lino/x/proposal"
+	vote "github.com/lino-network/lino/x/vote"
 )
 
 type TestMsg struct {
@@ -87,6 +91,8 @@ type AnteTestSuite struct {
 	ph   param.ParamHolder
 	ctx  sdk.Context
 	ante sdk.AnteHandler
+
+	proposalManager proposal.ProposalManager
 }
 
 func (suite *AnteTestSuite) SetupTest() {
@@ -94,6 +100,7 @@ func (suite *AnteTestSuite) SetupTest() {
 	TestPostKVStoreKey := sdk.NewKVStoreKey("post")
 	TestGlobalKVStoreKey := sdk.NewKVStoreKey("global")
 	TestParamKVStoreKey := sdk.NewKVStoreKey("param")
+	TestProposalKVStoreKey := sdk.NewKVStoreKey("proposal")
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -101,6 +108,7 @@ func (suite *AnteTestSuite) SetupTest() {
 	ms.MountStoreWithDB(TestPostKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(TestGlobalKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(TestParamKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(TestProposalKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 	ctx := sdk.NewContext(
 		ms, abci.Header{ChainID: "Lino", Height: 1, Time: time.Now()}, false, log.NewNopLogger())
@@ -111,7 +119,9 @@ func (suite *AnteTestSuite) SetupTest() {
 	pm := post.NewPostManager(TestPostKVStoreKey, ph)
 	gm := global.NewGlobalManager(TestGlobalKVStoreKey, ph)
 	initGlobalManager(ctx, gm)
-	anteHandler := NewAnteHandler(am, gm)
+	proposalManager := proposal.NewProposalManager(TestProposalKVStoreKey, ph)
+	proposalManager.InitGenesis(ctx)
+	anteHandler := NewAnteHandler(am, gm, proposalManager)
 
 	suite.am = am
 	suite.pm = pm
@@ -119,6 +129,7 @@ func (suite *AnteTestSuite) SetupTest() {
 	suite.ph = ph
 	suite.ctx = ctx
 	suite.ante = anteHandler
+	suite.proposalManager = proposalManager
 }
 
 func (suite *AnteTestSuite) createTestAccount(username string) (secp256k1.PrivKeySecp256k1,
@@ -144,8 +155,8 @@ func (suite *AnteTestSuite) createTestPost(postid string, author types.AccountKe
 	}
 	err := suite.pm.CreatePost(
 		suite.ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
-		msg.ParentAuthor, msg.ParentPostID, msg.Content,
-		msg.Title, sdk.ZeroDec(), msg.Links)
+		msg.ParentAuthor, msg.ParentPostID, msg.Content, msg.Title,
+		msg.ContentHash, msg.ContentURI, sdk.ZeroDec(), msg.Links, msg.Beneficiaries, msg.Tags)
 	suite.Require().Nil(err)
 }
 
@@ -418,6 +429,61 @@ func (suite *AnteTestSuite) TestTPSCapacityDonationAfterUpdate1() {
 	suite.checkInvalidTx(tx, acc.ErrAccountTPSCapacityNotEnough(user1).Result())
 }
 
+func (suite *AnteTestSuite) TestFrozenAccount() {
+	// keys and username
+	_, transaction1, _, user1 := suite.createTestAccount("user1")
+	suite.createTestAccount("user2")
+	suite.createTestPost("post1", "user2")
+
+	err := suite.proposalManager.FreezeAccount(suite.ctx, user1, types.ProposalKey("1"))
+	suite.Nil(err)
+
+	privs := []crypto.PrivKey{transaction1}
+	blockedMsgs := []sdk.Msg{
+		acc.NewTransferMsg("user1", "user2", types.LNO("1"), ""),
+		post.NewDonateMsg("user1", types.LNO("1"), "user2", "post1", "", ""),
+		vote.NewStakeInMsg("user1", types.LNO("1")),
+		acc.NewRegisterMsg(
+			"user1", "newuser", types.LNO("1"),
+			secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()),
+		dev.NewDeveloperRegisterMsg("user1", types.LNO("1"), "", "", ""),
+		infra.NewProviderRegisterMsg("user1", types.LNO("1")),
+		proposal.NewDepositProposalMsg("user1", 1, types.LNO("1")),
+		proposal.NewChangeGlobalAllocationParamMsg("user1", param.GlobalAllocationParam{}, ""),
+		proposal.NewChangeVoteParamMsg("user1", param.VoteParam{}, ""),
+		proposal.NewUpgradeProtocolMsg("user1", "link", ""),
+		proposal.NewDeletePostContentMsg("user1", types.GetPermlink("user2", "post1"), 0, ""),
+		proposal.NewTreasurySpendMsg("user1", "user2", types.LNO("1"), 1, 0, ""),
+		proposal.NewContentAppealMsg("user1", 1, ""),
+		proposal.NewAccountFreezeMsg("user1", "user2", ""),
+		proposal.NewAccountUnfreezeMsg("user1", "user1", ""),
+	}
+	for _, msg := range blockedMsgs {
+		tx := newTestTx(suite.ctx, []sdk.Msg{msg}, privs, []uint64{0})
+		suite.checkInvalidTx(tx, ErrAccountFrozen(user1).Result())
+	}
+
+	// other msgs of frozen account are not blocked
+	tx := newTestTx(suite.ctx, []sdk.Msg{newTestMsg(user1)}, privs, []uint64{0})
+	suite.checkValidTx(tx)
+
+	// freeze ends after freeze period
+	proposalParam, err := suite.ph.GetProposalParam(suite.ctx)
+	suite.Nil(err)
+	suite.ctx = suite.ctx.WithBlockHeader(abci.Header{
+		ChainID: "Lino", Height: 2,
+		Time: suite.ctx.BlockHeader().Time.Add(time.Duration(proposalParam.AccountFreezeSec) * time.Second)})
+	tx = newTestTx(suite.ctx, []sdk.Msg{blockedMsgs[0]}, privs, []uint64{1})
+	suite.checkValidTx(tx)
+
+	// unfrozen account is not blocked
+	err = suite.proposalManager.FreezeAccount(suite.ctx, user1, types.ProposalKey("2"))
+	suite.Nil(err)
+	suite.proposalManager.UnfreezeAccount(suite.ctx, user1)
+	tx = newTestTx(suite.ctx, []sdk.Msg{blockedMsgs[0]}, privs, []uint64{2})
+	suite.checkValidTx(tx)
+}
+
 func TestAnteTestSuite(t *testing.T) {
 	suite.Run(t, &AnteTestSuite{})
 }
@@ -37,3 +37,8 @@ func ErrWrongNumberOfSigners() sdk.Error {
 func ErrUnverifiedBytes(msg string) sdk.Error {
 	return types.NewError(types.CodeUnverifiedBytes, fmt.Sprintf("msg: %v", msg))
 }
+
+// ErrAccountFrozen - error if frozen account signs transfer, donation or stake change
+func ErrAccountFrozen(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeAccountFrozen, fmt.Sprintf("account %v is frozen", username))
+}
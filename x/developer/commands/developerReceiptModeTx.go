@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"fmt"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lino-network/lino/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	developer "github.com/lino-network/lino/x/developer"
+)
+
+// DeveloperReceiptModeTxCmd - turn on or off receipt backed consumption reporting
+func DeveloperReceiptModeTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "developer-receipt-mode",
+		Short: "only count consumption backed by signed user receipts",
+		RunE:  sendDeveloperReceiptModeTx(cdc),
+	}
+	cmd.Flags().String(client.FlagDeveloper, "", "developer name of this transaction")
+	cmd.Flags().Bool(client.FlagReceiptMode, true, "require signed user receipts")
+	return cmd
+}
+
+// send receipt mode transaction to the blockchain
+func sendDeveloperReceiptModeTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		username := viper.GetString(client.FlagDeveloper)
+		msg := developer.NewDeveloperReceiptModeMsg(username, viper.GetBool(client.FlagReceiptMode))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	dev "github.com/lino-network/lino/x/developer"
 	"github.com/lino-network/lino/x/developer/model"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -40,6 +41,35 @@ func GetDevelopersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetConsumptionHistoryCmd - returns monthly consumption snapshots of developer
+func GetConsumptionHistoryCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "developer-consumption-history <developer>",
+		Short: "Query developer monthly consumption and inflation history",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 1 || len(args[0]) == 0 {
+				return errors.New("You must provide a developer name")
+			}
+
+			res, err := ctx.QueryCustom(
+				dev.QuerierRoute, dev.QueryConsumptionHistory, args[0])
+			if err != nil {
+				return err
+			}
+			snapshots := []model.ConsumptionSnapshot{}
+			if err := cdc.UnmarshalJSON(res, &snapshots); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(snapshots); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -71,3 +71,33 @@ func ErrInvalidGrantPermission() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeDeveloperQueryFailed, fmt.Sprintf("query developer store failed"))
 }
+
+// ErrReceiptModeDisabled - error if developer reports receipts without receipt mode
+func ErrReceiptModeDisabled() sdk.Error {
+	return types.NewError(types.CodeReceiptModeDisabled, fmt.Sprintf("receipt mode is disabled"))
+}
+
+// ErrInvalidConsumptionReceipt - error if consumption receipt is invalid
+func ErrInvalidConsumptionReceipt() sdk.Error {
+	return types.NewError(types.CodeInvalidConsumptionReceipt, fmt.Sprintf("invalid consumption receipt"))
+}
+
+// ErrConsumptionReceiptAlreadyReported - error if consumption receipt has been reported
+func ErrConsumptionReceiptAlreadyReported(username types.AccountKey, nonce int64) sdk.Error {
+	return types.NewError(types.CodeConsumptionReceiptAlreadyReported, fmt.Sprintf("receipt %v of user %v already reported", nonce, username))
+}
+
+// ErrInvalidReceiptPeriod - error if consumption receipt is not for current period
+func ErrInvalidReceiptPeriod(period int64) sdk.Error {
+	return types.NewError(types.CodeInvalidReceiptPeriod, fmt.Sprintf("receipt period %v is not current period", period))
+}
+
+// ErrInvalidReceiptSignature - error if consumption receipt is not signed by user
+func ErrInvalidReceiptSignature(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeInvalidReceiptSignature, fmt.Sprintf("invalid receipt signature of user %v", username))
+}
+
+// ErrTooManyConsumptionReceipts - error if too many receipts in one msg
+func ErrTooManyConsumptionReceipts() sdk.Error {
+	return types.NewError(types.CodeTooManyConsumptionReceipts, fmt.Sprintf("too many consumption receipts"))
+}
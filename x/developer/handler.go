@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/developer/model"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acc "github.com/lino-network/lino/x/account"
@@ -27,6 +28,10 @@ func NewHandler(dm DeveloperManager, am acc.AccountManager, gm *global.GlobalMan
 			return handleDeveloperRevokeMsg(ctx, dm, am, gm, msg)
 		case RevokePermissionMsg:
 			return handleRevokePermissionMsg(ctx, dm, am, msg)
+		case DeveloperReceiptModeMsg:
+			return handleDeveloperReceiptModeMsg(ctx, dm, msg)
+		case ReportConsumptionMsg:
+			return handleReportConsumptionMsg(ctx, dm, am, gm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized developer msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -78,6 +83,68 @@ func handleDeveloperUpdateMsg(
 	return sdk.Result{}
 }
 
+func handleDeveloperReceiptModeMsg(
+	ctx sdk.Context, dm DeveloperManager, msg DeveloperReceiptModeMsg) sdk.Result {
+	if !dm.DoesDeveloperExist(ctx, msg.Username) {
+		return ErrDeveloperNotFound().Result()
+	}
+
+	if err := dm.SetReceiptMode(ctx, msg.Username, msg.ReceiptMode); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleReportConsumptionMsg(
+	ctx sdk.Context, dm DeveloperManager, am acc.AccountManager,
+	gm *global.GlobalManager, msg ReportConsumptionMsg) sdk.Result {
+	if !dm.DoesDeveloperExist(ctx, msg.Username) {
+		return ErrDeveloperNotFound().Result()
+	}
+	receiptMode, err := dm.IsReceiptMode(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+	if !receiptMode {
+		return ErrReceiptModeDisabled().Result()
+	}
+
+	// receipts can only be reported for the month which is not distributed yet
+	pastMinutes, err := gm.GetPastMinutes(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	period := pastMinutes / types.MinutesPerMonth
+
+	for _, signed := range msg.Receipts {
+		receipt := signed.Receipt
+		if receipt.Period != period {
+			return ErrInvalidReceiptPeriod(receipt.Period).Result()
+		}
+		// receipt must be signed by user's own key, not key granted to app
+		signer, err := am.CheckSigningPubKeyOwner(
+			ctx, receipt.Username, signed.PubKey, types.AppPermission, types.NewCoinFromInt64(0))
+		if err != nil || signer != receipt.Username {
+			return ErrInvalidReceiptSignature(receipt.Username).Result()
+		}
+		if !signed.PubKey.VerifyBytes(receipt.GetSignBytes(), signed.Signature) {
+			return ErrInvalidReceiptSignature(receipt.Username).Result()
+		}
+		amount, err := types.LinoToCoin(receipt.Amount)
+		if err != nil {
+			return err.Result()
+		}
+		if err := dm.ReportConsumptionReceipt(ctx, msg.Username, period, model.ConsumptionReceipt{
+			Username: receipt.Username,
+			Amount:   amount,
+			Nonce:    receipt.Nonce,
+		}); err != nil {
+			return err.Result()
+		}
+	}
+	return sdk.Result{}
+}
+
 func handleDeveloperRevokeMsg(
 	ctx sdk.Context, dm DeveloperManager, am acc.AccountManager,
 	gm *global.GlobalManager, msg DeveloperRevokeMsg) sdk.Result {
@@ -186,7 +253,7 @@ func returnCoinTo(
 	ctx sdk.Context, name types.AccountKey, gm *global.GlobalManager,
 	am acc.AccountManager, times int64, interval int64, coin types.Coin) sdk.Error {
 	if err := am.AddFrozenMoney(
-		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times); err != nil {
+		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times, types.DeveloperReturnCoin); err != nil {
 		return err
 	}
 
@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	accstore "github.com/lino-network/lino/x/account/model"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 func TestRegistertBasic(t *testing.T) {
@@ -349,3 +350,89 @@ func TestRevokePermissionMsg(t *testing.T) {
 		}
 	}
 }
+
+func TestReportConsumptionMsg(t *testing.T) {
+	ctx, am, dm, gm := setupTest(t, 0)
+	handler := NewHandler(dm, am, &gm)
+	dm.InitGenesis(ctx)
+
+	devParam, _ := dm.paramHolder.GetDeveloperParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	createTestAccount(ctx, am, "developer1", devParam.DeveloperMinDeposit.Plus(minBalance))
+	_, _, user1AppPriv := createTestAccount(ctx, am, "user1", minBalance)
+	_, _, user2AppPriv := createTestAccount(ctx, am, "user2", minBalance)
+	dm.RegisterDeveloper(ctx, "developer1", devParam.DeveloperMinDeposit, "", "", "")
+
+	signReceipt := func(
+		receipt ConsumptionReceipt, priv secp256k1.PrivKeySecp256k1) SignedConsumptionReceipt {
+		sig, _ := priv.Sign(receipt.GetSignBytes())
+		return SignedConsumptionReceipt{
+			Receipt:   receipt,
+			PubKey:    priv.PubKey(),
+			Signature: sig,
+		}
+	}
+	receipt := NewConsumptionReceipt("user1", "developer1", "1", 0, 1)
+	badSigned := signReceipt(receipt, user1AppPriv)
+	badSigned.Signature, _ = user1AppPriv.Sign([]byte("other bytes"))
+
+	testCases := []struct {
+		testName     string
+		msg          sdk.Msg
+		expectResult sdk.Result
+	}{
+		{
+			testName: "receipt mode is not enabled",
+			msg: NewReportConsumptionMsg(
+				"developer1", []SignedConsumptionReceipt{signReceipt(receipt, user1AppPriv)}),
+			expectResult: ErrReceiptModeDisabled().Result(),
+		},
+		{
+			testName:     "enable receipt mode",
+			msg:          NewDeveloperReceiptModeMsg("developer1", true),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName: "signed by other user",
+			msg: NewReportConsumptionMsg(
+				"developer1", []SignedConsumptionReceipt{signReceipt(receipt, user2AppPriv)}),
+			expectResult: ErrInvalidReceiptSignature("user1").Result(),
+		},
+		{
+			testName:     "invalid signature",
+			msg:          NewReportConsumptionMsg("developer1", []SignedConsumptionReceipt{badSigned}),
+			expectResult: ErrInvalidReceiptSignature("user1").Result(),
+		},
+		{
+			testName: "receipt for future period",
+			msg: NewReportConsumptionMsg(
+				"developer1", []SignedConsumptionReceipt{
+					signReceipt(NewConsumptionReceipt("user1", "developer1", "1", 1, 1), user1AppPriv)}),
+			expectResult: ErrInvalidReceiptPeriod(1).Result(),
+		},
+		{
+			testName: "normal case",
+			msg: NewReportConsumptionMsg(
+				"developer1", []SignedConsumptionReceipt{signReceipt(receipt, user1AppPriv)}),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName: "replay receipt",
+			msg: NewReportConsumptionMsg(
+				"developer1", []SignedConsumptionReceipt{signReceipt(receipt, user1AppPriv)}),
+			expectResult: ErrConsumptionReceiptAlreadyReported("user1", 1).Result(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+
+	developer, _ := dm.storage.GetDeveloper(ctx, "developer1")
+	assert.Equal(t, types.NewCoinFromInt64(1*types.Decimals), developer.ReceiptConsumption)
+	receipts, _ := dm.GetConsumptionReceipts(ctx, "developer1", 0)
+	assert.Equal(t, 1, len(receipts))
+}
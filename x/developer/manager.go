@@ -124,6 +124,121 @@ func (dm DeveloperManager) ReportConsumption(
 	return nil
 }
 
+// ReportViews - add post views reported by app to app activity stats
+func (dm DeveloperManager) ReportViews(
+	ctx sdk.Context, username types.AccountKey, views int64) sdk.Error {
+	developer, err := dm.storage.GetDeveloper(ctx, username)
+	if err != nil {
+		return err
+	}
+	developer.AppViewCount += views
+	developer.LastBatchViewAt = ctx.BlockHeader().Time.Unix()
+	if err := dm.storage.SetDeveloper(ctx, username, developer); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetLastBatchViewAt - get last time the app reported batch view
+func (dm DeveloperManager) GetLastBatchViewAt(
+	ctx sdk.Context, username types.AccountKey) (int64, sdk.Error) {
+	developer, err := dm.storage.GetDeveloper(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+	return developer.LastBatchViewAt, nil
+}
+
+// SetReceiptMode - turn on or off receipt backed consumption reporting
+func (dm DeveloperManager) SetReceiptMode(
+	ctx sdk.Context, username types.AccountKey, receiptMode bool) sdk.Error {
+	developer, err := dm.storage.GetDeveloper(ctx, username)
+	if err != nil {
+		return err
+	}
+	developer.ReceiptMode = receiptMode
+	if err := dm.storage.SetDeveloper(ctx, username, developer); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsReceiptMode - check if developer consumption must be backed by user receipts
+func (dm DeveloperManager) IsReceiptMode(ctx sdk.Context, username types.AccountKey) (bool, sdk.Error) {
+	developer, err := dm.storage.GetDeveloper(ctx, username)
+	if err != nil {
+		return false, err
+	}
+	return developer.ReceiptMode, nil
+}
+
+// ReportConsumptionReceipt - record a user signed receipt, each receipt can only be reported once
+func (dm DeveloperManager) ReportConsumptionReceipt(
+	ctx sdk.Context, username types.AccountKey, period int64, receipt model.ConsumptionReceipt) sdk.Error {
+	if dm.storage.DoesConsumptionReceiptExist(ctx, username, period, receipt.Username, receipt.Nonce) {
+		return ErrConsumptionReceiptAlreadyReported(receipt.Username, receipt.Nonce)
+	}
+	developer, err := dm.storage.GetDeveloper(ctx, username)
+	if err != nil {
+		return err
+	}
+	receipt.ReportedAt = ctx.BlockHeader().Time.Unix()
+	if err := dm.storage.SetConsumptionReceipt(ctx, username, period, &receipt); err != nil {
+		return err
+	}
+	developer.ReceiptConsumption = developer.ReceiptConsumption.Plus(receipt.Amount)
+	if err := dm.storage.SetDeveloper(ctx, username, developer); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetConsumptionReceipts - get all receipts reported by developer in period
+func (dm DeveloperManager) GetConsumptionReceipts(
+	ctx sdk.Context, username types.AccountKey, period int64) ([]model.ConsumptionReceipt, sdk.Error) {
+	return dm.storage.GetConsumptionReceipts(ctx, username, period)
+}
+
+// RecordConsumptionSnapshot - record developer consumption and inflation of a finished period
+func (dm DeveloperManager) RecordConsumptionSnapshot(
+	ctx sdk.Context, username types.AccountKey, period int64, weight sdk.Dec, inflation types.Coin) sdk.Error {
+	developer, err := dm.storage.GetDeveloper(ctx, username)
+	if err != nil {
+		return err
+	}
+	snapshot := &model.ConsumptionSnapshot{
+		Period:             period,
+		AppConsumption:     developer.AppConsumption,
+		ReceiptConsumption: developer.ReceiptConsumption,
+		ReceiptMode:        developer.ReceiptMode,
+		Weight:             weight,
+		Inflation:          inflation,
+		CreatedAt:          ctx.BlockHeader().Time.Unix(),
+	}
+	if err := dm.storage.SetConsumptionSnapshot(ctx, username, snapshot); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetConsumptionSnapshots - get all consumption snapshots of developer ordered by period
+func (dm DeveloperManager) GetConsumptionSnapshots(
+	ctx sdk.Context, username types.AccountKey) ([]model.ConsumptionSnapshot, sdk.Error) {
+	return dm.storage.GetConsumptionSnapshots(ctx, username)
+}
+
+// getEffectiveConsumption - in receipt mode, only consumption backed by both
+// blockchain record and user receipts is counted
+func getEffectiveConsumption(developer *model.Developer) types.Coin {
+	if !developer.ReceiptMode {
+		return developer.AppConsumption
+	}
+	if developer.ReceiptConsumption.IsGT(developer.AppConsumption) {
+		return developer.AppConsumption
+	}
+	return developer.ReceiptConsumption
+}
+
 // GetConsumptionWeight - given app name, get consumption percentage report by this app
 func (dm DeveloperManager) GetConsumptionWeight(
 	ctx sdk.Context, username types.AccountKey) (sdk.Dec, sdk.Error) {
@@ -140,9 +255,10 @@ func (dm DeveloperManager) GetConsumptionWeight(
 		if err != nil {
 			return sdk.ZeroDec(), err
 		}
-		totalConsumption = totalConsumption.Plus(curDeveloper.AppConsumption)
+		consumption := getEffectiveConsumption(curDeveloper)
+		totalConsumption = totalConsumption.Plus(consumption)
 		if curDeveloper.Username == username {
-			myConsumption = curDeveloper.AppConsumption
+			myConsumption = consumption
 		}
 	}
 	// if not any consumption here, we evenly distribute all inflation
@@ -168,6 +284,7 @@ func (dm DeveloperManager) ClearConsumption(ctx sdk.Context) sdk.Error {
 			return err
 		}
 		curDeveloper.AppConsumption = types.NewCoinFromInt64(0)
+		curDeveloper.ReceiptConsumption = types.NewCoinFromInt64(0)
 		if err := dm.storage.SetDeveloper(ctx, developerName, curDeveloper); err != nil {
 			return err
 		}
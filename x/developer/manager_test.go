@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/developer/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -116,3 +117,86 @@ func TestUpdateDeveloper(t *testing.T) {
 		}
 	}
 }
+
+func TestReceiptModeConsumption(t *testing.T) {
+	ctx, _, dm, _ := setupTest(t, 0)
+	dm.InitGenesis(ctx)
+
+	devParam, _ := dm.paramHolder.GetDeveloperParam(ctx)
+	dm.RegisterDeveloper(ctx, "developer1", devParam.DeveloperMinDeposit, "", "", "")
+	dm.RegisterDeveloper(ctx, "developer2", devParam.DeveloperMinDeposit, "", "", "")
+
+	dm.ReportConsumption(ctx, "developer1", types.NewCoinFromInt64(300))
+	dm.ReportConsumption(ctx, "developer2", types.NewCoinFromInt64(100))
+	err := dm.SetReceiptMode(ctx, "developer1", true)
+	assert.Nil(t, err)
+
+	// no receipt, developer1 consumption doesn't count
+	p1, _ := dm.GetConsumptionWeight(ctx, "developer1")
+	assert.True(t, p1.Equal(sdk.ZeroDec()))
+
+	receipt := model.ConsumptionReceipt{
+		Username: "user1",
+		Amount:   types.NewCoinFromInt64(100),
+		Nonce:    1,
+	}
+	err = dm.ReportConsumptionReceipt(ctx, "developer1", 0, receipt)
+	assert.Nil(t, err)
+	err = dm.ReportConsumptionReceipt(ctx, "developer1", 0, receipt)
+	assert.Equal(t, ErrConsumptionReceiptAlreadyReported("user1", 1), err)
+	p1, _ = dm.GetConsumptionWeight(ctx, "developer1")
+	assert.True(t, p1.Equal(types.NewDecFromRat(1, 2)))
+
+	// receipt consumption is capped by blockchain recorded consumption
+	receipt.Nonce = 2
+	receipt.Amount = types.NewCoinFromInt64(1000)
+	err = dm.ReportConsumptionReceipt(ctx, "developer1", 0, receipt)
+	assert.Nil(t, err)
+	p1, _ = dm.GetConsumptionWeight(ctx, "developer1")
+	assert.True(t, p1.Equal(types.NewDecFromRat(3, 4)))
+
+	receipts, err := dm.GetConsumptionReceipts(ctx, "developer1", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(receipts))
+
+	err = dm.RecordConsumptionSnapshot(ctx, "developer1", 0, p1, types.NewCoinFromInt64(75))
+	assert.Nil(t, err)
+	dm.ClearConsumption(ctx)
+	developer, _ := dm.storage.GetDeveloper(ctx, "developer1")
+	assert.True(t, developer.ReceiptConsumption.IsZero())
+
+	snapshots, err := dm.GetConsumptionSnapshots(ctx, "developer1")
+	assert.Nil(t, err)
+	assert.Equal(t, []model.ConsumptionSnapshot{
+		{
+			Period:             0,
+			AppConsumption:     types.NewCoinFromInt64(300),
+			ReceiptConsumption: types.NewCoinFromInt64(1100),
+			ReceiptMode:        true,
+			Weight:             p1,
+			Inflation:          types.NewCoinFromInt64(75),
+			CreatedAt:          ctx.BlockHeader().Time.Unix(),
+		},
+	}, snapshots)
+}
+
+func TestReportViews(t *testing.T) {
+	ctx, _, dm, _ := setupTest(t, 0)
+	dm.InitGenesis(ctx)
+
+	devParam, _ := dm.paramHolder.GetDeveloperParam(ctx)
+	dm.RegisterDeveloper(ctx, "developer1", devParam.DeveloperMinDeposit, "", "", "")
+
+	err := dm.ReportViews(ctx, "developer1", 100)
+	assert.Nil(t, err)
+	err = dm.ReportViews(ctx, "developer1", 20)
+	assert.Nil(t, err)
+	err = dm.ReportViews(ctx, "developer2", 20)
+	assert.NotNil(t, err)
+
+	developer, _ := dm.storage.GetDeveloper(ctx, "developer1")
+	assert.Equal(t, int64(120), developer.AppViewCount)
+	lastBatchViewAt, err := dm.GetLastBatchViewAt(ctx, "developer1")
+	assert.Nil(t, err)
+	assert.Equal(t, ctx.BlockHeader().Time.Unix(), lastBatchViewAt)
+}
@@ -2,16 +2,45 @@ package model
 
 import (
 	types "github.com/lino-network/lino/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Developer - developer is account with developer deposit, can get developer inflation
+// ReceiptConsumption - consumption backed by signed user receipts in current period
+// ReceiptMode - if set, only consumption backed by user receipts counts for inflation
+// AppViewCount - total post views reported by the app through batch view
+// LastBatchViewAt - last time the app reported batch view
 type Developer struct {
-	Username       types.AccountKey `json:"username"`
-	Deposit        types.Coin       `json:"deposit"`
-	AppConsumption types.Coin       `json:"app_consumption"`
-	Website        string           `json:"web_site"`
-	Description    string           `json:"description"`
-	AppMetaData    string           `json:"app_meta_data"`
+	Username           types.AccountKey `json:"username"`
+	Deposit            types.Coin       `json:"deposit"`
+	AppConsumption     types.Coin       `json:"app_consumption"`
+	Website            string           `json:"web_site"`
+	Description        string           `json:"description"`
+	AppMetaData        string           `json:"app_meta_data"`
+	ReceiptConsumption types.Coin       `json:"receipt_consumption"`
+	ReceiptMode        bool             `json:"receipt_mode"`
+	AppViewCount       int64            `json:"app_view_count"`
+	LastBatchViewAt    int64            `json:"last_batch_view_at"`
+}
+
+// ConsumptionSnapshot - developer consumption and inflation share of a finished month
+type ConsumptionSnapshot struct {
+	Period             int64      `json:"period"`
+	AppConsumption     types.Coin `json:"app_consumption"`
+	ReceiptConsumption types.Coin `json:"receipt_consumption"`
+	ReceiptMode        bool       `json:"receipt_mode"`
+	Weight             sdk.Dec    `json:"weight"`
+	Inflation          types.Coin `json:"inflation"`
+	CreatedAt          int64      `json:"created_at"`
+}
+
+// ConsumptionReceipt - consumption signed by user and reported by developer
+type ConsumptionReceipt struct {
+	Username   types.AccountKey `json:"username"`
+	Amount     types.Coin       `json:"amount"`
+	Nonce      int64            `json:"nonce"`
+	ReportedAt int64            `json:"reported_at"`
 }
 
 // DeveloperList - list of developers
@@ -37,3 +37,23 @@ func ErrFailedToUnmarshalDeveloper(err error) sdk.Error {
 func ErrFailedToUnmarshalDeveloperList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalDeveloperList, fmt.Sprintf("failed to unmarshal developer list: %s", err.Error()))
 }
+
+// ErrFailedToMarshalConsumptionSnapshot - error if marshal consumption snapshot failed
+func ErrFailedToMarshalConsumptionSnapshot(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalConsumptionSnapshot, fmt.Sprintf("failed to marshal consumption snapshot: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalConsumptionSnapshot - error if unmarshal consumption snapshot failed
+func ErrFailedToUnmarshalConsumptionSnapshot(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalConsumptionSnapshot, fmt.Sprintf("failed to unmarshal consumption snapshot: %s", err.Error()))
+}
+
+// ErrFailedToMarshalConsumptionReceipt - error if marshal consumption receipt failed
+func ErrFailedToMarshalConsumptionReceipt(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalConsumptionReceipt, fmt.Sprintf("failed to marshal consumption receipt: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalConsumptionReceipt - error if unmarshal consumption receipt failed
+func ErrFailedToUnmarshalConsumptionReceipt(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalConsumptionReceipt, fmt.Sprintf("failed to unmarshal consumption receipt: %s", err.Error()))
+}
@@ -15,10 +15,25 @@ type DeveloperListTable struct {
 	List DeveloperList `json:"list"`
 }
 
+// ConsumptionSnapshotRow - pk: (Username, Snapshot.Period)
+type ConsumptionSnapshotRow struct {
+	Username types.AccountKey    `json:"username"`
+	Snapshot ConsumptionSnapshot `json:"snapshot"`
+}
+
+// ConsumptionReceiptRow - pk: (Username, Period, Receipt.Username, Receipt.Nonce)
+type ConsumptionReceiptRow struct {
+	Username types.AccountKey   `json:"username"`
+	Period   int64              `json:"period"`
+	Receipt  ConsumptionReceipt `json:"receipt"`
+}
+
 // DeveloperTables is the state of developer storage, organized as a table.
 type DeveloperTables struct {
-	Developers    []DeveloperRow     `json:"developers"`
-	DeveloperList DeveloperListTable `json:"developer_list"`
+	Developers           []DeveloperRow           `json:"developers"`
+	DeveloperList        DeveloperListTable       `json:"developer_list"`
+	ConsumptionSnapshots []ConsumptionSnapshotRow `json:"consumption_snapshots"`
+	ConsumptionReceipts  []ConsumptionReceiptRow  `json:"consumption_receipts"`
 }
 
 // ToIR -
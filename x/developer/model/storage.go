@@ -1,6 +1,10 @@
 package model
 
 import (
+	"sort"
+	"strconv"
+	"strings"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/types"
 
@@ -10,6 +14,9 @@ import (
 var (
 	developerSubstore     = []byte{0x00}
 	developerListSubstore = []byte{0x01}
+
+	consumptionSnapshotSubstore = []byte{0x02}
+	consumptionReceiptSubstore  = []byte{0x03}
 )
 
 // DeveloperStorage - developer storage
@@ -101,6 +108,74 @@ func (ds DeveloperStorage) SetDeveloperList(ctx sdk.Context, lst *DeveloperList)
 	return nil
 }
 
+// SetConsumptionSnapshot - set developer consumption snapshot of a period to KVStore
+func (ds DeveloperStorage) SetConsumptionSnapshot(
+	ctx sdk.Context, accKey types.AccountKey, snapshot *ConsumptionSnapshot) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	snapshotByte, err := ds.cdc.MarshalBinaryLengthPrefixed(*snapshot)
+	if err != nil {
+		return ErrFailedToMarshalConsumptionSnapshot(err)
+	}
+	store.Set(GetConsumptionSnapshotKey(accKey, snapshot.Period), snapshotByte)
+	return nil
+}
+
+// GetConsumptionSnapshots - get all consumption snapshots of a developer, ordered by period
+func (ds DeveloperStorage) GetConsumptionSnapshots(
+	ctx sdk.Context, accKey types.AccountKey) ([]ConsumptionSnapshot, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	itr := sdk.KVStorePrefixIterator(store, GetConsumptionSnapshotPrefix(accKey))
+	defer itr.Close()
+	snapshots := []ConsumptionSnapshot{}
+	for ; itr.Valid(); itr.Next() {
+		snapshot := ConsumptionSnapshot{}
+		if err := ds.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &snapshot); err != nil {
+			return nil, ErrFailedToUnmarshalConsumptionSnapshot(err)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].Period < snapshots[j].Period
+	})
+	return snapshots, nil
+}
+
+// DoesConsumptionReceiptExist - check if user receipt has been reported by developer in period
+func (ds DeveloperStorage) DoesConsumptionReceiptExist(
+	ctx sdk.Context, accKey types.AccountKey, period int64, username types.AccountKey, nonce int64) bool {
+	store := ctx.KVStore(ds.key)
+	return store.Has(GetConsumptionReceiptKey(accKey, period, username, nonce))
+}
+
+// SetConsumptionReceipt - set consumption receipt reported by developer in period to KVStore
+func (ds DeveloperStorage) SetConsumptionReceipt(
+	ctx sdk.Context, accKey types.AccountKey, period int64, receipt *ConsumptionReceipt) sdk.Error {
+	store := ctx.KVStore(ds.key)
+	receiptByte, err := ds.cdc.MarshalBinaryLengthPrefixed(*receipt)
+	if err != nil {
+		return ErrFailedToMarshalConsumptionReceipt(err)
+	}
+	store.Set(GetConsumptionReceiptKey(accKey, period, receipt.Username, receipt.Nonce), receiptByte)
+	return nil
+}
+
+// GetConsumptionReceipts - get all consumption receipts reported by developer in period
+func (ds DeveloperStorage) GetConsumptionReceipts(
+	ctx sdk.Context, accKey types.AccountKey, period int64) ([]ConsumptionReceipt, sdk.Error) {
+	store := ctx.KVStore(ds.key)
+	itr := sdk.KVStorePrefixIterator(store, GetConsumptionReceiptPrefix(accKey, period))
+	defer itr.Close()
+	receipts := []ConsumptionReceipt{}
+	for ; itr.Valid(); itr.Next() {
+		receipt := ConsumptionReceipt{}
+		if err := ds.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &receipt); err != nil {
+			return nil, ErrFailedToUnmarshalConsumptionReceipt(err)
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
 // Export developer storage state
 func (ds DeveloperStorage) Export(ctx sdk.Context) *DeveloperTables {
 	tables := &DeveloperTables{}
@@ -131,6 +206,47 @@ func (ds DeveloperStorage) Export(ctx sdk.Context) *DeveloperTables {
 	tables.DeveloperList = DeveloperListTable{
 		List: *list,
 	}
+	// export table.ConsumptionSnapshots
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, consumptionSnapshotSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			username := strings.Split(string(k[1:]), types.KeySeparator)[0]
+			snapshot := ConsumptionSnapshot{}
+			if err := ds.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &snapshot); err != nil {
+				panic("failed to read consumption snapshot: " + err.Error())
+			}
+			row := ConsumptionSnapshotRow{
+				Username: types.AccountKey(username),
+				Snapshot: snapshot,
+			}
+			tables.ConsumptionSnapshots = append(tables.ConsumptionSnapshots, row)
+		}
+	}()
+	// export table.ConsumptionReceipts
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, consumptionReceiptSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			keys := strings.Split(string(k[1:]), types.KeySeparator)
+			period, err := strconv.ParseInt(keys[1], 10, 64)
+			if err != nil {
+				panic("failed to parse receipt period: " + err.Error())
+			}
+			receipt := ConsumptionReceipt{}
+			if err := ds.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &receipt); err != nil {
+				panic("failed to read consumption receipt: " + err.Error())
+			}
+			row := ConsumptionReceiptRow{
+				Username: types.AccountKey(keys[0]),
+				Period:   period,
+				Receipt:  receipt,
+			}
+			tables.ConsumptionReceipts = append(tables.ConsumptionReceipts, row)
+		}
+	}()
 	return tables
 }
 
@@ -149,6 +265,16 @@ func (ds DeveloperStorage) Import(ctx sdk.Context, tb *DeveloperTablesIR) {
 	// import DeveloperList
 	err := ds.SetDeveloperList(ctx, &tb.DeveloperList.List)
 	check(err)
+	// import table.ConsumptionSnapshots
+	for _, v := range tb.ConsumptionSnapshots {
+		err := ds.SetConsumptionSnapshot(ctx, v.Username, &v.Snapshot)
+		check(err)
+	}
+	// import table.ConsumptionReceipts
+	for _, v := range tb.ConsumptionReceipts {
+		err := ds.SetConsumptionReceipt(ctx, v.Username, v.Period, &v.Receipt)
+		check(err)
+	}
 }
 
 // GetDeveloperKey - "developer substore" + "developer"
@@ -160,3 +286,26 @@ func GetDeveloperKey(accKey types.AccountKey) []byte {
 func GetDeveloperListKey() []byte {
 	return developerListSubstore
 }
+
+// GetConsumptionSnapshotPrefix - "consumption snapshot substore" + "developer" + "/"
+func GetConsumptionSnapshotPrefix(accKey types.AccountKey) []byte {
+	return append(append(consumptionSnapshotSubstore, accKey...), types.KeySeparator...)
+}
+
+// GetConsumptionSnapshotKey - "consumption snapshot substore" + "developer" + "/" + "period"
+func GetConsumptionSnapshotKey(accKey types.AccountKey, period int64) []byte {
+	return append(GetConsumptionSnapshotPrefix(accKey), strconv.FormatInt(period, 10)...)
+}
+
+// GetConsumptionReceiptPrefix - "consumption receipt substore" + "developer" + "/" + "period" + "/"
+func GetConsumptionReceiptPrefix(accKey types.AccountKey, period int64) []byte {
+	prefix := append(append(consumptionReceiptSubstore, accKey...), types.KeySeparator...)
+	return append(append(prefix, strconv.FormatInt(period, 10)...), types.KeySeparator...)
+}
+
+// GetConsumptionReceiptKey - "consumption receipt substore" + "developer" + "/" + "period" + "/" + "user" + "/" + "nonce"
+func GetConsumptionReceiptKey(
+	accKey types.AccountKey, period int64, username types.AccountKey, nonce int64) []byte {
+	key := append(append(GetConsumptionReceiptPrefix(accKey, period), username...), types.KeySeparator...)
+	return append(key, strconv.FormatInt(nonce, 10)...)
+}
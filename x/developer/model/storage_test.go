@@ -19,9 +19,10 @@ var (
 
 func TestDeveloper(t *testing.T) {
 	developer := Developer{
-		Username:       "user1",
-		Deposit:        types.NewCoinFromInt64(100),
-		AppConsumption: types.NewCoinFromInt64(1000),
+		Username:           "user1",
+		Deposit:            types.NewCoinFromInt64(100),
+		AppConsumption:     types.NewCoinFromInt64(1000),
+		ReceiptConsumption: types.NewCoinFromInt64(0),
 	}
 
 	runTest(t, func(env TestEnv) {
@@ -50,6 +51,68 @@ func TestDeveloperList(t *testing.T) {
 
 }
 
+func TestConsumptionSnapshots(t *testing.T) {
+	snapshot1 := ConsumptionSnapshot{
+		Period:             1,
+		AppConsumption:     types.NewCoinFromInt64(1000),
+		ReceiptConsumption: types.NewCoinFromInt64(0),
+		Weight:             sdk.NewDecWithPrec(5, 1),
+		Inflation:          types.NewCoinFromInt64(10),
+		CreatedAt:          100,
+	}
+	snapshot10 := ConsumptionSnapshot{
+		Period:             10,
+		AppConsumption:     types.NewCoinFromInt64(2000),
+		ReceiptConsumption: types.NewCoinFromInt64(1500),
+		ReceiptMode:        true,
+		Weight:             sdk.OneDec(),
+		Inflation:          types.NewCoinFromInt64(20),
+		CreatedAt:          1000,
+	}
+
+	runTest(t, func(env TestEnv) {
+		snapshots, err := env.ds.GetConsumptionSnapshots(env.ctx, "user1")
+		assert.Nil(t, err)
+		assert.Equal(t, []ConsumptionSnapshot{}, snapshots)
+
+		err = env.ds.SetConsumptionSnapshot(env.ctx, "user1", &snapshot10)
+		assert.Nil(t, err)
+		err = env.ds.SetConsumptionSnapshot(env.ctx, "user1", &snapshot1)
+		assert.Nil(t, err)
+		err = env.ds.SetConsumptionSnapshot(env.ctx, "user11", &snapshot1)
+		assert.Nil(t, err)
+
+		snapshots, err = env.ds.GetConsumptionSnapshots(env.ctx, "user1")
+		assert.Nil(t, err)
+		assert.Equal(t, []ConsumptionSnapshot{snapshot1, snapshot10}, snapshots)
+	})
+}
+
+func TestConsumptionReceipts(t *testing.T) {
+	receipt := ConsumptionReceipt{
+		Username:   "user2",
+		Amount:     types.NewCoinFromInt64(100),
+		Nonce:      1,
+		ReportedAt: 100,
+	}
+
+	runTest(t, func(env TestEnv) {
+		assert.False(t, env.ds.DoesConsumptionReceiptExist(env.ctx, "user1", 1, "user2", 1))
+		err := env.ds.SetConsumptionReceipt(env.ctx, "user1", 1, &receipt)
+		assert.Nil(t, err)
+		assert.True(t, env.ds.DoesConsumptionReceiptExist(env.ctx, "user1", 1, "user2", 1))
+		assert.False(t, env.ds.DoesConsumptionReceiptExist(env.ctx, "user1", 2, "user2", 1))
+		assert.False(t, env.ds.DoesConsumptionReceiptExist(env.ctx, "user1", 1, "user2", 2))
+
+		receipts, err := env.ds.GetConsumptionReceipts(env.ctx, "user1", 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []ConsumptionReceipt{receipt}, receipts)
+		receipts, err = env.ds.GetConsumptionReceipts(env.ctx, "user1", 2)
+		assert.Nil(t, err)
+		assert.Equal(t, []ConsumptionReceipt{}, receipts)
+	})
+}
+
 //
 // Test Environment setup
 //
@@ -6,6 +6,7 @@ import (
 	"unicode/utf8"
 
 	"github.com/lino-network/lino/types"
+	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -16,6 +17,8 @@ var _ types.Msg = DeveloperRevokeMsg{}
 var _ types.Msg = GrantPermissionMsg{}
 var _ types.Msg = RevokePermissionMsg{}
 var _ types.Msg = PreAuthorizationMsg{}
+var _ types.Msg = DeveloperReceiptModeMsg{}
+var _ types.Msg = ReportConsumptionMsg{}
 
 // DeveloperRegisterMsg - register developer on blockchain
 type DeveloperRegisterMsg struct {
@@ -63,6 +66,34 @@ type PreAuthorizationMsg struct {
 	Amount            types.LNO        `json:"amount"`
 }
 
+// DeveloperReceiptModeMsg - developer turn on or off receipt backed consumption reporting
+type DeveloperReceiptModeMsg struct {
+	Username    types.AccountKey `json:"username"`
+	ReceiptMode bool             `json:"receipt_mode"`
+}
+
+// ConsumptionReceipt - consumption of user in app during period, signed by user
+type ConsumptionReceipt struct {
+	Username types.AccountKey `json:"username"`
+	App      types.AccountKey `json:"app"`
+	Amount   types.LNO        `json:"amount"`
+	Period   int64            `json:"period"`
+	Nonce    int64            `json:"nonce"`
+}
+
+// SignedConsumptionReceipt - consumption receipt with user signature
+type SignedConsumptionReceipt struct {
+	Receipt   ConsumptionReceipt `json:"receipt"`
+	PubKey    crypto.PubKey      `json:"pub_key"`
+	Signature []byte             `json:"signature"`
+}
+
+// ReportConsumptionMsg - developer report user signed consumption receipts
+type ReportConsumptionMsg struct {
+	Username types.AccountKey           `json:"username"`
+	Receipts []SignedConsumptionReceipt `json:"receipts"`
+}
+
 // DeveloperRegisterMsg Msg Implementations
 func NewDeveloperRegisterMsg(developer string, deposit types.LNO, website string, description string, appMetaData string) DeveloperRegisterMsg {
 	return DeveloperRegisterMsg{
@@ -456,3 +487,148 @@ func (msg PreAuthorizationMsg) GetSigners() []sdk.AccAddress {
 func (msg PreAuthorizationMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// NewDeveloperReceiptModeMsg - new DeveloperReceiptModeMsg
+func NewDeveloperReceiptModeMsg(developer string, receiptMode bool) DeveloperReceiptModeMsg {
+	return DeveloperReceiptModeMsg{
+		Username:    types.AccountKey(developer),
+		ReceiptMode: receiptMode,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg DeveloperReceiptModeMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg DeveloperReceiptModeMsg) Type() string { return "DeveloperReceiptModeMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg DeveloperReceiptModeMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg DeveloperReceiptModeMsg) String() string {
+	return fmt.Sprintf("DeveloperReceiptModeMsg{Username:%v, ReceiptMode:%v}", msg.Username, msg.ReceiptMode)
+}
+
+// GetPermission - implements types.Msg
+func (msg DeveloperReceiptModeMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg DeveloperReceiptModeMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg DeveloperReceiptModeMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg DeveloperReceiptModeMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewConsumptionReceipt - new ConsumptionReceipt
+func NewConsumptionReceipt(
+	user, app string, amount types.LNO, period, nonce int64) ConsumptionReceipt {
+	return ConsumptionReceipt{
+		Username: types.AccountKey(user),
+		App:      types.AccountKey(app),
+		Amount:   amount,
+		Period:   period,
+		Nonce:    nonce,
+	}
+}
+
+// GetSignBytes - bytes signed by user for this receipt
+func (receipt ConsumptionReceipt) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(receipt) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// NewReportConsumptionMsg - new ReportConsumptionMsg
+func NewReportConsumptionMsg(developer string, receipts []SignedConsumptionReceipt) ReportConsumptionMsg {
+	return ReportConsumptionMsg{
+		Username: types.AccountKey(developer),
+		Receipts: receipts,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg ReportConsumptionMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg ReportConsumptionMsg) Type() string { return "ReportConsumptionMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ReportConsumptionMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if len(msg.Receipts) == 0 {
+		return ErrInvalidConsumptionReceipt()
+	}
+	if len(msg.Receipts) > types.MaximumNumOfConsumptionReceipts {
+		return ErrTooManyConsumptionReceipts()
+	}
+	for _, signed := range msg.Receipts {
+		receipt := signed.Receipt
+		if len(receipt.Username) < types.MinimumUsernameLength ||
+			len(receipt.Username) > types.MaximumUsernameLength {
+			return ErrInvalidUsername()
+		}
+		if receipt.App != msg.Username || receipt.Nonce < 0 {
+			return ErrInvalidConsumptionReceipt()
+		}
+		if _, err := types.LinoToCoin(receipt.Amount); err != nil {
+			return err
+		}
+		if signed.PubKey == nil || len(signed.Signature) == 0 {
+			return ErrInvalidReceiptSignature(receipt.Username)
+		}
+	}
+	return nil
+}
+
+func (msg ReportConsumptionMsg) String() string {
+	return fmt.Sprintf("ReportConsumptionMsg{Username:%v, Receipts:%v}", msg.Username, len(msg.Receipts))
+}
+
+// GetPermission - implements types.Msg
+func (msg ReportConsumptionMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ReportConsumptionMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ReportConsumptionMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg ReportConsumptionMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
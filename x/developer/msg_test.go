@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
@@ -388,6 +389,82 @@ func TestPreAuthorizationMsgMsg(t *testing.T) {
 	}
 }
 
+func TestReportConsumptionMsg(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	signReceipt := func(receipt ConsumptionReceipt) SignedConsumptionReceipt {
+		sig, _ := priv.Sign(receipt.GetSignBytes())
+		return SignedConsumptionReceipt{
+			Receipt:   receipt,
+			PubKey:    priv.PubKey(),
+			Signature: sig,
+		}
+	}
+	tooManyReceipts := []SignedConsumptionReceipt{}
+	for i := 0; i <= types.MaximumNumOfConsumptionReceipts; i++ {
+		tooManyReceipts = append(
+			tooManyReceipts, signReceipt(NewConsumptionReceipt("user1", "test", "1", 0, int64(i))))
+	}
+
+	testCases := []struct {
+		testName     string
+		msg          ReportConsumptionMsg
+		expectResult sdk.Error
+	}{
+		{
+			testName: "normal case",
+			msg: NewReportConsumptionMsg("test", []SignedConsumptionReceipt{
+				signReceipt(NewConsumptionReceipt("user1", "test", "1", 0, 1)),
+			}),
+			expectResult: nil,
+		},
+		{
+			testName:     "no receipt",
+			msg:          NewReportConsumptionMsg("test", []SignedConsumptionReceipt{}),
+			expectResult: ErrInvalidConsumptionReceipt(),
+		},
+		{
+			testName:     "too many receipts",
+			msg:          NewReportConsumptionMsg("test", tooManyReceipts),
+			expectResult: ErrTooManyConsumptionReceipts(),
+		},
+		{
+			testName: "receipt for other app",
+			msg: NewReportConsumptionMsg("test", []SignedConsumptionReceipt{
+				signReceipt(NewConsumptionReceipt("user1", "app", "1", 0, 1)),
+			}),
+			expectResult: ErrInvalidConsumptionReceipt(),
+		},
+		{
+			testName: "invalid receipt username",
+			msg: NewReportConsumptionMsg("test", []SignedConsumptionReceipt{
+				signReceipt(NewConsumptionReceipt("us", "test", "1", 0, 1)),
+			}),
+			expectResult: ErrInvalidUsername(),
+		},
+		{
+			testName: "invalid receipt amount",
+			msg: NewReportConsumptionMsg("test", []SignedConsumptionReceipt{
+				signReceipt(NewConsumptionReceipt("user1", "test", "-1", 0, 1)),
+			}),
+			expectResult: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName: "missing signature",
+			msg: NewReportConsumptionMsg("test", []SignedConsumptionReceipt{
+				{Receipt: NewConsumptionReceipt("user1", "test", "1", 0, 1), PubKey: priv.PubKey()},
+			}),
+			expectResult: ErrInvalidReceiptSignature("user1"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName         string
@@ -444,6 +521,16 @@ func TestMsgPermission(t *testing.T) {
 			msg:              NewPreAuthorizationMsg("test", "app", 1000, "1"),
 			expectPermission: types.TransactionPermission,
 		},
+		{
+			testName:         "developer receipt mode msg",
+			msg:              NewDeveloperReceiptModeMsg("test", true),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "report consumption msg",
+			msg:              NewReportConsumptionMsg("test", nil),
+			expectPermission: types.AppPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,6 +1,8 @@
 package developer
 
 import (
+	"strconv"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
@@ -18,8 +20,10 @@ const (
 	// QuerierRoute is the querier route for gov
 	QuerierRoute = ModuleName
 
-	QueryDeveloper     = "dev"
-	QueryDeveloperList = "devList"
+	QueryDeveloper           = "dev"
+	QueryDeveloperList       = "devList"
+	QueryConsumptionHistory  = "consumptionHistory"
+	QueryConsumptionReceipts = "consumptionReceipts"
 )
 
 // creates a querier for developer REST endpoints
@@ -32,6 +36,10 @@ func NewQuerier(dm DeveloperManager) sdk.Querier {
 			return queryDeveloper(ctx, cdc, path[1:], req, dm)
 		case QueryDeveloperList:
 			return queryDeveloperList(ctx, cdc, path[1:], req, dm)
+		case QueryConsumptionHistory:
+			return queryConsumptionHistory(ctx, cdc, path[1:], req, dm)
+		case QueryConsumptionReceipts:
+			return queryConsumptionReceipts(ctx, cdc, path[1:], req, dm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown developer query endpoint")
 		}
@@ -72,3 +80,37 @@ func queryDeveloperList(ctx sdk.Context, cdc *wire.Codec, path []string, req abc
 	}
 	return res, nil
 }
+
+func queryConsumptionHistory(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, dm DeveloperManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	snapshots, err := dm.GetConsumptionSnapshots(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(snapshots)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryConsumptionReceipts(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, dm DeveloperManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 2); err != nil {
+		return nil, err
+	}
+	period, parseErr := strconv.ParseInt(path[1], 10, 64)
+	if parseErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	receipts, err := dm.GetConsumptionReceipts(ctx, types.AccountKey(path[0]), period)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(receipts)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
@@ -12,6 +12,8 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(GrantPermissionMsg{}, "lino/grantPermission", nil)
 	cdc.RegisterConcrete(RevokePermissionMsg{}, "lino/revokePermission", nil)
 	cdc.RegisterConcrete(PreAuthorizationMsg{}, "lino/preAuthorizationPermission", nil)
+	cdc.RegisterConcrete(DeveloperReceiptModeMsg{}, "lino/devReceiptMode", nil)
+	cdc.RegisterConcrete(ReportConsumptionMsg{}, "lino/devReportConsumption", nil)
 }
 
 var msgCdc = wire.New()
@@ -26,3 +26,23 @@ func ErrParseEventCacheList() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeGlobalQueryFailed, fmt.Sprintf("query global store failed"))
 }
+
+// ErrContentRewardEventNotFound - error if content reward event to remove is not pending
+func ErrContentRewardEventNotFound(unixTime int64) sdk.Error {
+	return types.NewError(types.CodeContentRewardEventNotFound, fmt.Sprintf("content reward event not found at %v", unixTime))
+}
+
+// ErrUnclaimedFrictionNotEnough - error if friction to minus has been claimed as interest
+func ErrUnclaimedFrictionNotEnough() sdk.Error {
+	return types.NewError(types.CodeUnclaimedFrictionNotEnough, fmt.Sprintf("unclaimed friction not enough"))
+}
+
+// ErrFrictionDayPassed - error if friction to minus is of a past day, which may have been claimed as interest
+func ErrFrictionDayPassed(pastDay int64) sdk.Error {
+	return types.NewError(types.CodeFrictionDayPassed, fmt.Sprintf("friction of day %v can't be rolled back", pastDay))
+}
+
+// ErrTreasuryNotEnough - error if treasury pool can't cover the spend
+func ErrTreasuryNotEnough() sdk.Error {
+	return types.NewError(types.CodeTreasuryNotEnough, fmt.Sprintf("treasury pool not enough"))
+}
@@ -76,6 +76,11 @@ func (gm *GlobalManager) GetTimeEventListAtTime(ctx sdk.Context, unixTime int64)
 	return eventList
 }
 
+// GetPendingTimeEventLists - get all time event lists not executed yet
+func (gm *GlobalManager) GetPendingTimeEventLists(ctx sdk.Context) ([]model.GlobalTimeEventTimeRow, sdk.Error) {
+	return gm.storage.GetTimeEventLists(ctx)
+}
+
 // GetLastBlockTime - get last block time from KVStore
 func (gm *GlobalManager) GetLastBlockTime(ctx sdk.Context) (int64, sdk.Error) {
 	globalTime, err := gm.storage.GetGlobalTime(ctx)
@@ -200,6 +205,97 @@ func (gm *GlobalManager) AddFrictionAndRegisterContentRewardEvent(
 	return nil
 }
 
+// MinusFrictionAndRemoveContentRewardEvent - remove a pending reward calculation event
+// registered at registeredAt, the first event matched is removed. Friction and evaluate
+// added on register are rolled back, which is only allowed within the day of register.
+func (gm *GlobalManager) MinusFrictionAndRemoveContentRewardEvent(
+	ctx sdk.Context, registeredAt int64, match func(types.Event) bool,
+	friction types.Coin, evaluate types.Coin) sdk.Error {
+	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
+	if err != nil {
+		return err
+	}
+	pastDay, err := gm.GetPastDay(ctx, registeredAt)
+	if err != nil {
+		return err
+	}
+	// once the day is over, lino stake holders can claim its friction as interest,
+	// rolling back part of it would make later claimers pay for earlier ones.
+	currentDay, err := gm.GetPastDay(ctx, ctx.BlockHeader().Time.Unix())
+	if err != nil {
+		return err
+	}
+	if pastDay != currentDay {
+		return ErrFrictionDayPassed(pastDay)
+	}
+	linoStakeStat, err := gm.storage.GetLinoStakeStat(ctx, pastDay)
+	if err != nil {
+		return err
+	}
+	// friction has been paid to lino stake holders as interest can't be rolled back
+	if !linoStakeStat.UnclaimedFriction.IsGTE(friction) ||
+		!linoStakeStat.TotalConsumptionFriction.IsGTE(friction) {
+		return ErrUnclaimedFrictionNotEnough()
+	}
+	if err := gm.removeEventAtTime(
+		ctx, registeredAt+consumptionMeta.ConsumptionFreezingPeriodSec, match); err != nil {
+		return err
+	}
+	consumptionMeta.ConsumptionWindow = consumptionMeta.ConsumptionWindow.Minus(evaluate)
+	linoStakeStat.TotalConsumptionFriction = linoStakeStat.TotalConsumptionFriction.Minus(friction)
+	linoStakeStat.UnclaimedFriction = linoStakeStat.UnclaimedFriction.Minus(friction)
+	if err := gm.storage.SetConsumptionMeta(ctx, consumptionMeta); err != nil {
+		return err
+	}
+	if err := gm.storage.SetLinoStakeStat(ctx, pastDay, linoStakeStat); err != nil {
+		return err
+	}
+	return nil
+}
+
+// removeEventAtTime - remove the first event matched at given time, event registered
+// in the same block is still in the deliver tx cache
+func (gm *GlobalManager) removeEventAtTime(
+	ctx sdk.Context, unixTime int64, match func(types.Event) bool) sdk.Error {
+	eventList, err := gm.storage.GetTimeEventList(ctx, unixTime)
+	if err != nil {
+		return err
+	}
+	if eventList != nil {
+		for i, event := range eventList.Events {
+			if !match(event) {
+				continue
+			}
+			eventList.Events = append(eventList.Events[:i], eventList.Events[i+1:]...)
+			if len(eventList.Events) == 0 {
+				return gm.storage.RemoveTimeEventList(ctx, unixTime)
+			}
+			return gm.storage.SetTimeEventList(ctx, unixTime, eventList)
+		}
+	}
+	// check tx doesn't cache event
+	if ctx.IsCheckTx() {
+		return nil
+	}
+	for i, eventCache := range gm.deliverTxEventCacheList {
+		if eventCache.UnixTime != unixTime {
+			continue
+		}
+		for j, event := range eventCache.EventList {
+			if !match(event) {
+				continue
+			}
+			eventCache.EventList = append(eventCache.EventList[:j], eventCache.EventList[j+1:]...)
+			if len(eventCache.EventList) == 0 {
+				gm.deliverTxEventCacheList = append(
+					gm.deliverTxEventCacheList[:i], gm.deliverTxEventCacheList[i+1:]...)
+			}
+			return nil
+		}
+	}
+	return ErrContentRewardEventNotFound(unixTime)
+}
+
 // AddLinoStakeToStat - add lino power to total lino power at current day
 func (gm *GlobalManager) AddLinoStakeToStat(ctx sdk.Context, linoStake types.Coin) sdk.Error {
 	pastDay, err := gm.GetPastDay(ctx, ctx.BlockHeader().Time.Unix())
@@ -238,6 +334,16 @@ func (gm *GlobalManager) MinusLinoStakeFromStat(ctx sdk.Context, linoStake types
 
 // GetInterestSince - get interest from unix time till now (exclusive)
 func (gm *GlobalManager) GetInterestSince(ctx sdk.Context, unixTime int64, linoStake types.Coin) (types.Coin, sdk.Error) {
+	return gm.getInterestSince(ctx, unixTime, linoStake, true)
+}
+
+// GetPendingInterestSince - get interest from unix time till now (exclusive) without claiming it from stat
+func (gm *GlobalManager) GetPendingInterestSince(ctx sdk.Context, unixTime int64, linoStake types.Coin) (types.Coin, sdk.Error) {
+	return gm.getInterestSince(ctx, unixTime, linoStake, false)
+}
+
+func (gm *GlobalManager) getInterestSince(
+	ctx sdk.Context, unixTime int64, linoStake types.Coin, claim bool) (types.Coin, sdk.Error) {
 	startDay, err := gm.GetPastDay(ctx, unixTime)
 	if err != nil {
 		return types.NewCoinFromInt64(0), err
@@ -259,6 +365,9 @@ func (gm *GlobalManager) GetInterestSince(ctx sdk.Context, unixTime int64, linoS
 			types.DecToCoin(linoStakeStat.UnclaimedFriction.ToDec().Mul(
 				linoStake.ToDec().Quo(linoStakeStat.UnclaimedLinoStake.ToDec())))
 		totalInterest = totalInterest.Plus(interest)
+		if !claim {
+			continue
+		}
 		linoStakeStat.UnclaimedFriction = linoStakeStat.UnclaimedFriction.Minus(interest)
 		linoStakeStat.UnclaimedLinoStake = linoStakeStat.UnclaimedLinoStake.Minus(linoStake)
 		if err := gm.storage.SetLinoStakeStat(ctx, day, linoStakeStat); err != nil {
@@ -311,6 +420,12 @@ func (gm *GlobalManager) RegisterProposalDecideEvent(
 	return nil
 }
 
+// RegisterDonationExpireEvent - register event to expire a donation kept for refund at expireAt
+func (gm *GlobalManager) RegisterDonationExpireEvent(
+	ctx sdk.Context, expireAt int64, event types.Event) sdk.Error {
+	return gm.registerEventAtTime(ctx, expireAt, event)
+}
+
 // RegisterParamChangeEvent - register parameter change event
 func (gm *GlobalManager) RegisterParamChangeEvent(ctx sdk.Context, event types.Event) sdk.Error {
 	if err := gm.registerEventAtTime(ctx,
@@ -365,8 +480,11 @@ func (gm *GlobalManager) DistributeHourlyInflation(ctx sdk.Context) sdk.Error {
 		types.DecToCoin(thisHourInflation.ToDec().Mul(globalAllocation.ValidatorAllocation))
 	infraInflation :=
 		types.DecToCoin(thisHourInflation.ToDec().Mul(globalAllocation.InfraAllocation))
+	treasuryInflation :=
+		types.DecToCoin(thisHourInflation.ToDec().Mul(globalAllocation.TreasuryAllocation))
 	developerInflation :=
-		thisHourInflation.Minus(contentCreatorInflation).Minus(validatorInflation).Minus(infraInflation)
+		thisHourInflation.Minus(contentCreatorInflation).Minus(validatorInflation).
+			Minus(infraInflation).Minus(treasuryInflation)
 	consumptionMeta.ConsumptionRewardPool = consumptionMeta.ConsumptionRewardPool.Plus(contentCreatorInflation)
 
 	if err := gm.storage.SetConsumptionMeta(ctx, consumptionMeta); err != nil {
@@ -377,6 +495,7 @@ func (gm *GlobalManager) DistributeHourlyInflation(ctx sdk.Context) sdk.Error {
 	pool.InfraInflationPool = pool.InfraInflationPool.Plus(infraInflation)
 	pool.ValidatorInflationPool = pool.ValidatorInflationPool.Plus(validatorInflation)
 	pool.DeveloperInflationPool = pool.DeveloperInflationPool.Plus(developerInflation)
+	pool.TreasuryPool = pool.TreasuryPool.Plus(treasuryInflation)
 	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
 		return err
 	}
@@ -429,16 +548,7 @@ func (gm *GlobalManager) GetRewardAndPopFromWindow(
 		return types.NewCoinFromInt64(0), err
 	}
 
-	// consumptionRatio = (this consumption * penalty score) / (total consumption in 7 days window)
-	consumptionRatio := sdk.ZeroDec()
-	if !consumptionMeta.ConsumptionWindow.ToDec().IsZero() {
-		consumptionRatio =
-			evaluate.ToDec().Mul(sdk.OneDec().Sub(penaltyScore)).Quo(
-				consumptionMeta.ConsumptionWindow.ToDec())
-	}
-	// reward = (consumption reward pool) * (consumptionRatio)
-	reward := types.DecToCoin(
-		consumptionMeta.ConsumptionRewardPool.ToDec().Mul(consumptionRatio))
+	reward := getContentReward(consumptionMeta, evaluate, penaltyScore)
 	consumptionMeta.ConsumptionRewardPool = consumptionMeta.ConsumptionRewardPool.Minus(reward)
 	consumptionMeta.ConsumptionWindow = consumptionMeta.ConsumptionWindow.Minus(evaluate)
 	if err := gm.addTotalLinoCoin(ctx, reward); err != nil {
@@ -450,6 +560,55 @@ func (gm *GlobalManager) GetRewardAndPopFromWindow(
 	return reward, nil
 }
 
+// PayLostContentReward - take reward content lost to censorship penalty from
+// consumption reward pool where it was left, capped by the pool
+func (gm *GlobalManager) PayLostContentReward(
+	ctx sdk.Context, reward types.Coin) (types.Coin, sdk.Error) {
+	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if reward.IsGT(consumptionMeta.ConsumptionRewardPool) {
+		reward = consumptionMeta.ConsumptionRewardPool
+	}
+	consumptionMeta.ConsumptionRewardPool = consumptionMeta.ConsumptionRewardPool.Minus(reward)
+	if err := gm.addTotalLinoCoin(ctx, reward); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if err := gm.storage.SetConsumptionMeta(ctx, consumptionMeta); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return reward, nil
+}
+
+// EstimateReward - estimate reward of a consumption based on current consumption window
+// and reward pool, window and pool are not changed
+func (gm *GlobalManager) EstimateReward(
+	ctx sdk.Context, evaluate types.Coin, penaltyScore sdk.Dec) (types.Coin, sdk.Error) {
+	if evaluate.IsZero() {
+		return types.NewCoinFromInt64(0), nil
+	}
+	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return getContentReward(consumptionMeta, evaluate, penaltyScore), nil
+}
+
+func getContentReward(
+	consumptionMeta *model.ConsumptionMeta, evaluate types.Coin, penaltyScore sdk.Dec) types.Coin {
+	// consumptionRatio = (this consumption * penalty score) / (total consumption in 7 days window)
+	consumptionRatio := sdk.ZeroDec()
+	if !consumptionMeta.ConsumptionWindow.ToDec().IsZero() {
+		consumptionRatio =
+			evaluate.ToDec().Mul(sdk.OneDec().Sub(penaltyScore)).Quo(
+				consumptionMeta.ConsumptionWindow.ToDec())
+	}
+	// reward = (consumption reward pool) * (consumptionRatio)
+	return types.DecToCoin(
+		consumptionMeta.ConsumptionRewardPool.ToDec().Mul(consumptionRatio))
+}
+
 // AddConsumption - add consumption to global meta, which is used to compute GDP
 func (gm *GlobalManager) AddConsumption(ctx sdk.Context, coin types.Coin) sdk.Error {
 	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
@@ -464,6 +623,20 @@ func (gm *GlobalManager) AddConsumption(ctx sdk.Context, coin types.Coin) sdk.Er
 	return nil
 }
 
+// MinusConsumption - minus refunded consumption from global meta
+func (gm *GlobalManager) MinusConsumption(ctx sdk.Context, coin types.Coin) sdk.Error {
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	if err != nil {
+		return err
+	}
+	globalMeta.CumulativeConsumption = globalMeta.CumulativeConsumption.Minus(coin)
+
+	if err := gm.storage.SetGlobalMeta(ctx, globalMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
 // AddToDeveloperInflationPool - add coin to developer inflation pool
 func (gm *GlobalManager) AddToDeveloperInflationPool(ctx sdk.Context, coin types.Coin) sdk.Error {
 	inflationPool, err := gm.storage.GetInflationPool(ctx)
@@ -491,6 +664,32 @@ func (gm *GlobalManager) AddToValidatorInflationPool(ctx sdk.Context, coin types
 	return nil
 }
 
+// AddToInfraInflationPool - add coin to infra inflation pool
+func (gm *GlobalManager) AddToInfraInflationPool(ctx sdk.Context, coin types.Coin) sdk.Error {
+	pool, err := gm.storage.GetInflationPool(ctx)
+	if err != nil {
+		return err
+	}
+	pool.InfraInflationPool = pool.InfraInflationPool.Plus(coin)
+	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddToTreasuryPool - add coin to treasury pool
+func (gm *GlobalManager) AddToTreasuryPool(ctx sdk.Context, coin types.Coin) sdk.Error {
+	pool, err := gm.storage.GetInflationPool(ctx)
+	if err != nil {
+		return err
+	}
+	pool.TreasuryPool = pool.TreasuryPool.Plus(coin)
+	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
+		return err
+	}
+	return nil
+}
+
 // GetValidatorHourlyInflation - get validator hourly inflation
 func (gm *GlobalManager) GetValidatorHourlyInflation(ctx sdk.Context) (types.Coin, sdk.Error) {
 	pool, err := gm.storage.GetInflationPool(ctx)
@@ -544,6 +743,34 @@ func (gm *GlobalManager) GetDeveloperMonthlyInflation(ctx sdk.Context) (types.Co
 	return resCoin, nil
 }
 
+// GetTreasuryPool - get coin remaining in treasury pool
+func (gm *GlobalManager) GetTreasuryPool(ctx sdk.Context) (types.Coin, sdk.Error) {
+	pool, err := gm.storage.GetInflationPool(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return pool.TreasuryPool, nil
+}
+
+// WithdrawFromTreasury - take coin out of treasury pool and add it to total lino coin
+func (gm *GlobalManager) WithdrawFromTreasury(ctx sdk.Context, coin types.Coin) sdk.Error {
+	pool, err := gm.storage.GetInflationPool(ctx)
+	if err != nil {
+		return err
+	}
+	if !pool.TreasuryPool.IsGTE(coin) {
+		return ErrTreasuryNotEnough()
+	}
+	pool.TreasuryPool = pool.TreasuryPool.Minus(coin)
+	if err := gm.addTotalLinoCoin(ctx, coin); err != nil {
+		return err
+	}
+	if err := gm.storage.SetInflationPool(ctx, pool); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (gm *GlobalManager) addTotalLinoCoin(ctx sdk.Context, newCoin types.Coin) sdk.Error {
 	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
 	if err != nil {
@@ -557,6 +784,19 @@ func (gm *GlobalManager) addTotalLinoCoin(ctx sdk.Context, newCoin types.Coin) s
 	return nil
 }
 
+// BurnCoin - remove burned coin from total lino coin
+func (gm *GlobalManager) BurnCoin(ctx sdk.Context, coin types.Coin) sdk.Error {
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	if err != nil {
+		return err
+	}
+	globalMeta.TotalLinoCoin = globalMeta.TotalLinoCoin.Minus(coin)
+	if err := gm.storage.SetGlobalMeta(ctx, globalMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
 // UpdateTPS - update current tps based on current block information
 func (gm *GlobalManager) UpdateTPS(ctx sdk.Context) sdk.Error {
 	tps, err := gm.storage.GetTPS(ctx)
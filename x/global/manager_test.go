@@ -237,6 +237,54 @@ func TestAddFrictionAndRegisterContentRewardEvent(t *testing.T) {
 	}
 }
 
+func TestMinusFrictionAndRemoveContentRewardEvent(t *testing.T) {
+	ctx, gm := setupTest(t)
+	baseTime := ctx.BlockHeader().Time.Unix()
+	matchAll := func(event types.Event) bool { return true }
+	matchNone := func(event types.Event) bool { return false }
+	friction := types.NewCoinFromInt64(10)
+	evaluate := types.NewCoinFromInt64(100)
+
+	// two events registered, one is committed and one is still in cache
+	err := gm.AddFrictionAndRegisterContentRewardEvent(ctx, testEvent{}, friction, evaluate)
+	assert.Nil(t, err)
+	err = gm.CommitEventCache(ctx)
+	assert.Nil(t, err)
+	err = gm.AddFrictionAndRegisterContentRewardEvent(ctx, testEvent{}, friction, evaluate)
+	assert.Nil(t, err)
+
+	err = gm.MinusFrictionAndRemoveContentRewardEvent(ctx, baseTime, matchNone, friction, evaluate)
+	assert.Equal(t, ErrContentRewardEventNotFound(baseTime+24*7*3600), err)
+	err = gm.MinusFrictionAndRemoveContentRewardEvent(
+		ctx, baseTime, matchAll, friction.Plus(friction).Plus(friction), evaluate)
+	assert.Equal(t, ErrUnclaimedFrictionNotEnough(), err)
+
+	// friction can't be rolled back once the day is over
+	nextDayCtx := ctx.WithBlockHeader(abci.Header{
+		ChainID: ctx.BlockHeader().ChainID, Time: time.Unix(baseTime+24*3600, 0)})
+	pastDay, err := gm.GetPastDay(ctx, baseTime)
+	assert.Nil(t, err)
+	err = gm.MinusFrictionAndRemoveContentRewardEvent(nextDayCtx, baseTime, matchAll, friction, evaluate)
+	assert.Equal(t, ErrFrictionDayPassed(pastDay), err)
+
+	// committed event is removed first, then the cached one
+	err = gm.MinusFrictionAndRemoveContentRewardEvent(ctx, baseTime, matchAll, friction, evaluate)
+	assert.Nil(t, err)
+	assert.Nil(t, gm.GetTimeEventListAtTime(ctx, baseTime+24*7*3600))
+	assert.Equal(t, 1, len(gm.deliverTxEventCacheList))
+	err = gm.MinusFrictionAndRemoveContentRewardEvent(ctx, baseTime, matchAll, friction, evaluate)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(gm.deliverTxEventCacheList))
+
+	consumptionMeta, err := gm.storage.GetConsumptionMeta(ctx)
+	assert.Nil(t, err)
+	assert.True(t, consumptionMeta.ConsumptionWindow.IsZero())
+	linoStakeStat, err := gm.storage.GetLinoStakeStat(ctx, 0)
+	assert.Nil(t, err)
+	assert.True(t, linoStakeStat.TotalConsumptionFriction.IsZero())
+	assert.True(t, linoStakeStat.UnclaimedFriction.IsZero())
+}
+
 func TestGetRewardAndPopFromWindow(t *testing.T) {
 	ctx, gm := setupTest(t)
 	testCases := []struct {
@@ -363,6 +411,15 @@ func TestGetRewardAndPopFromWindow(t *testing.T) {
 			return
 		}
 
+		// estimation doesn't change window and pool
+		estimated, err := gm.EstimateReward(ctx, tc.evaluate, tc.penaltyScore)
+		if err != nil {
+			t.Errorf("%s: failed to estimate reward, got err %v", tc.testName, err)
+		}
+		if !estimated.IsEqual(tc.expectReward) {
+			t.Errorf("%s: diff estimated reward, got %v, want %v", tc.testName, estimated, tc.expectReward)
+		}
+
 		reward, err := gm.GetRewardAndPopFromWindow(ctx, tc.evaluate, tc.penaltyScore)
 		if err != nil {
 			t.Errorf("%s: failed to get reward and pop from window, got err %v", tc.testName, err)
@@ -452,6 +509,19 @@ func TestTimeEventList(t *testing.T) {
 		}
 	}
 
+	pendingLists, err := gm.GetPendingTimeEventLists(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.GlobalTimeEventTimeRow{
+		{
+			UnixTime:      baseTime,
+			TimeEventList: types.TimeEventList{Events: []types.Event{testEvent{}, testEvent{}}},
+		},
+		{
+			UnixTime:      baseTime + 1,
+			TimeEventList: types.TimeEventList{Events: []types.Event{testEvent{}}},
+		},
+	}, pendingLists)
+
 	rmCases := []struct {
 		testName        string
 		removeAtTime    int64
@@ -587,6 +657,7 @@ func TestDistributeHourlyInflation(t *testing.T) {
 	expectValidatorInflation := types.NewCoinFromInt64(0)
 	expectDeveloperInflation := types.NewCoinFromInt64(0)
 	expectInfraInflation := types.NewCoinFromInt64(0)
+	expectTreasuryInflation := types.NewCoinFromInt64(0)
 
 	globalAllocation, err := gm.paramHolder.GetGlobalAllocationParam(ctx)
 	assert.Nil(t, err)
@@ -616,10 +687,14 @@ func TestDistributeHourlyInflation(t *testing.T) {
 		expectInfraInflation =
 			expectInfraInflation.Plus(
 				types.DecToCoin(hourlyInflation.ToDec().Mul(globalAllocation.InfraAllocation)))
+		expectTreasuryInflation =
+			expectTreasuryInflation.Plus(
+				types.DecToCoin(hourlyInflation.ToDec().Mul(globalAllocation.TreasuryAllocation)))
 		assert.True(t, expectContentCreatorInflation.IsEqual(consumptionMeta.ConsumptionRewardPool))
 		assert.True(t, expectInfraInflation.IsEqual(inflationPool.InfraInflationPool))
 		assert.True(t, expectDeveloperInflation.IsEqual(inflationPool.DeveloperInflationPool))
 		assert.True(t, expectValidatorInflation.IsEqual(inflationPool.ValidatorInflationPool))
+		assert.True(t, expectTreasuryInflation.IsEqual(inflationPool.TreasuryPool))
 	}
 	globalMeta, err = gm.storage.GetGlobalMeta(ctx)
 	assert.Nil(t, err)
@@ -964,6 +1039,29 @@ func TestGetDeveloperMonthlyInflation(t *testing.T) {
 	assert.Equal(t, globalMeta.TotalLinoCoin, types.NewCoinFromInt64(10000*types.Decimals).Plus(totalDeveloperInflation))
 }
 
+func TestWithdrawFromTreasury(t *testing.T) {
+	ctx, gm := setupTest(t)
+	inflationPool := &model.InflationPool{
+		TreasuryPool: types.NewCoinFromInt64(0),
+	}
+	err := gm.storage.SetInflationPool(ctx, inflationPool)
+	assert.Nil(t, err)
+	err = gm.AddToTreasuryPool(ctx, types.NewCoinFromInt64(10000*100))
+	assert.Nil(t, err)
+
+	err = gm.WithdrawFromTreasury(ctx, types.NewCoinFromInt64(10001*100))
+	assert.Equal(t, ErrTreasuryNotEnough(), err)
+
+	err = gm.WithdrawFromTreasury(ctx, types.NewCoinFromInt64(4000*100))
+	assert.Nil(t, err)
+	treasury, err := gm.GetTreasuryPool(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(6000*100), treasury)
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, globalMeta.TotalLinoCoin, types.NewCoinFromInt64(10000*types.Decimals).Plus(types.NewCoinFromInt64(4000*100)))
+}
+
 func TestAddToValidatorInflationPool(t *testing.T) {
 	ctx, gm := setupTest(t)
 	totalValidatorInflation := types.NewCoinFromInt64(0)
@@ -1006,6 +1104,48 @@ func TestAddToValidatorInflationPool(t *testing.T) {
 	}
 }
 
+func TestAddToInfraInflationPool(t *testing.T) {
+	ctx, gm := setupTest(t)
+	totalInfraInflation := types.NewCoinFromInt64(0)
+	inflationPool := &model.InflationPool{
+		InfraInflationPool: totalInfraInflation,
+	}
+	err := gm.storage.SetInflationPool(ctx, inflationPool)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName string
+		coin     types.Coin
+		expect   types.Coin
+	}{
+		{
+			testName: "add 100 inflation",
+			coin:     types.NewCoinFromInt64(100),
+			expect:   types.NewCoinFromInt64(100),
+		},
+		{
+			testName: "add 1 more inflation",
+			coin:     types.NewCoinFromInt64(1),
+			expect:   types.NewCoinFromInt64(101),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := gm.AddToInfraInflationPool(ctx, tc.coin)
+		if err != nil {
+			t.Errorf("%s: failed to add infra inflation pool, got err %v", tc.testName, err)
+		}
+		pool, err := gm.storage.GetInflationPool(ctx)
+		if err != nil {
+			t.Errorf("%s: failed to get inflation pool, got err %v", tc.testName, err)
+		}
+		if !pool.InfraInflationPool.IsEqual(tc.expect) {
+			t.Errorf("%s: diff infra inflation pool, got %v, want %v", tc.testName,
+				pool.InfraInflationPool, tc.expect)
+		}
+	}
+}
+
 func TestAddConsumption(t *testing.T) {
 	ctx, gm := setupTest(t)
 
@@ -1043,6 +1183,49 @@ func TestAddConsumption(t *testing.T) {
 	}
 }
 
+func TestBurnCoin(t *testing.T) {
+	ctx, gm := setupTest(t)
+
+	globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+	if err != nil {
+		t.Errorf("failed to get global meta, got err %v", err)
+	}
+	totalLino := globalMeta.TotalLinoCoin
+
+	testCases := []struct {
+		testName string
+		coin     types.Coin
+		expect   types.Coin
+	}{
+		{
+			testName: "burn 100 coin",
+			coin:     types.NewCoinFromInt64(100),
+			expect:   totalLino.Minus(types.NewCoinFromInt64(100)),
+		},
+		{
+			testName: "burn 1 more coin",
+			coin:     types.NewCoinFromInt64(1),
+			expect:   totalLino.Minus(types.NewCoinFromInt64(101)),
+		},
+	}
+
+	for _, tc := range testCases {
+		err := gm.BurnCoin(ctx, tc.coin)
+		if err != nil {
+			t.Errorf("%s: failed to burn coin, got err %v", tc.testName, err)
+		}
+
+		globalMeta, err := gm.storage.GetGlobalMeta(ctx)
+		if err != nil {
+			t.Errorf("%s: failed to get global meta, got err %v", tc.testName, err)
+		}
+		if !globalMeta.TotalLinoCoin.IsEqual(tc.expect) {
+			t.Errorf("%s: diff total lino coin, got %v, want %v", tc.testName,
+				globalMeta.TotalLinoCoin, tc.expect)
+		}
+	}
+}
+
 func TestChainStartTime(t *testing.T) {
 	ctx, gm := setupTest(t)
 
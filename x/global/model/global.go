@@ -50,10 +50,12 @@ func (t *TPS) ToIR() TPSIR {
 // DistributedContentCreatorInflationPool inflation alrady distributed
 // DeveloperInflationPool inflation pool for developer
 // ValidatorInflationPool inflation pool for validator
+// TreasuryPool community treasury, spent by treasury spend proposal
 type InflationPool struct {
 	InfraInflationPool     types.Coin `json:"infra_inflation_pool"`
 	DeveloperInflationPool types.Coin `json:"developer_inflation_pool"`
 	ValidatorInflationPool types.Coin `json:"validator_inflation_pool"`
+	TreasuryPool           types.Coin `json:"treasury_pool"`
 }
 
 // ConsumptionMeta
@@ -142,6 +142,29 @@ func (gs GlobalStorage) SetTimeEventList(ctx sdk.Context, unixTime int64, lst *t
 	return nil
 }
 
+// GetTimeEventLists - get all time event lists not removed yet, ordered by time
+func (gs GlobalStorage) GetTimeEventLists(ctx sdk.Context) ([]GlobalTimeEventTimeRow, sdk.Error) {
+	store := ctx.KVStore(gs.key)
+	itr := sdk.KVStorePrefixIterator(store, timeEventListSubStore)
+	defer itr.Close()
+	rows := []GlobalTimeEventTimeRow{}
+	for ; itr.Valid(); itr.Next() {
+		unixTime, err := strconv.ParseInt(string(itr.Key()[1:]), 10, 64)
+		if err != nil {
+			return nil, ErrFailedToUnmarshalTimeEventList(err)
+		}
+		lst := new(types.TimeEventList)
+		if err := gs.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), lst); err != nil {
+			return nil, ErrFailedToUnmarshalTimeEventList(err)
+		}
+		rows = append(rows, GlobalTimeEventTimeRow{
+			UnixTime:      unixTime,
+			TimeEventList: *lst,
+		})
+	}
+	return rows, nil
+}
+
 // RemoveTimeEventList - remove time event list at given unix time
 func (gs GlobalStorage) RemoveTimeEventList(ctx sdk.Context, unixTime int64) sdk.Error {
 	store := ctx.KVStore(gs.key)
@@ -210,6 +233,10 @@ func (gs GlobalStorage) GetInflationPool(ctx sdk.Context) (*InflationPool, sdk.E
 	if err := gs.cdc.UnmarshalBinaryLengthPrefixed(inflationPoolBytes, inflationPool); err != nil {
 		return nil, ErrFailedToUnmarshalInflationPool(err)
 	}
+	// pool stored before treasury was introduced has nothing in treasury
+	if inflationPool.TreasuryPool.IsNil() {
+		inflationPool.TreasuryPool = types.NewCoinFromInt64(0)
+	}
 	return inflationPool, nil
 }
 
@@ -73,6 +73,31 @@ func TestGlobalStorageGenesis(t *testing.T) {
 		InfraInflationPool:     types.NewCoinFromInt64(0),
 		DeveloperInflationPool: types.NewCoinFromInt64(0),
 		ValidatorInflationPool: types.NewCoinFromInt64(0),
+		TreasuryPool:           types.NewCoinFromInt64(0),
 	}
 	checkGlobalStorage(t, ctx, gm, globalMeta, consumptionMeta, inflationPool)
 }
+
+func TestInflationPoolStoredBeforeTreasury(t *testing.T) {
+	gs := NewGlobalStorage(TestGlobalKVStoreKey)
+	ctx := getContext()
+	// layout of inflation pool before treasury pool was added
+	type oldInflationPool struct {
+		InfraInflationPool     types.Coin `json:"infra_inflation_pool"`
+		DeveloperInflationPool types.Coin `json:"developer_inflation_pool"`
+		ValidatorInflationPool types.Coin `json:"validator_inflation_pool"`
+	}
+	poolBytes, err := gs.cdc.MarshalBinaryLengthPrefixed(oldInflationPool{
+		InfraInflationPool:     types.NewCoinFromInt64(1),
+		DeveloperInflationPool: types.NewCoinFromInt64(2),
+		ValidatorInflationPool: types.NewCoinFromInt64(3),
+	})
+	assert.Nil(t, err)
+	ctx.KVStore(TestGlobalKVStoreKey).Set(GetInflationPoolKey(), poolBytes)
+
+	pool, sdkErr := gs.GetInflationPool(ctx)
+	assert.Nil(t, sdkErr)
+	assert.True(t, pool.ValidatorInflationPool.IsEqual(types.NewCoinFromInt64(3)))
+	assert.True(t, pool.TreasuryPool.IsZero())
+	assert.True(t, pool.TreasuryPool.Plus(types.NewCoinFromInt64(1)).IsEqual(types.NewCoinFromInt64(1)))
+}
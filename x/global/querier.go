@@ -23,6 +23,7 @@ const (
 	QueryTPS             = "tps"
 	QueryLinoStakeStat   = "linoStakeStat"
 	QueryGlobalTime      = "globalTime"
+	QueryTreasury        = "treasury"
 )
 
 // creates a querier for global REST endpoints
@@ -43,6 +44,8 @@ func NewQuerier(gm GlobalManager) sdk.Querier {
 			return queryGlobalTime(ctx, cdc, path[1:], req, gm)
 		case QueryLinoStakeStat:
 			return queryLinoStakeStat(ctx, cdc, path[1:], req, gm)
+		case QueryTreasury:
+			return queryTreasury(ctx, cdc, path[1:], req, gm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown global query endpoint")
 		}
@@ -146,3 +149,15 @@ func queryLinoStakeStat(ctx sdk.Context, cdc *wire.Codec, path []string, req abc
 	}
 	return res, nil
 }
+
+func queryTreasury(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, gm GlobalManager) ([]byte, sdk.Error) {
+	treasury, err := gm.GetTreasuryPool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(treasury)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	infra "github.com/lino-network/lino/x/infra"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DisputeUsageReportTxCmd - app disputes usage report of infra provider
+func DisputeUsageReportTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute-usage-report",
+		Short: "app disputes usage reported by infra provider",
+		RunE:  sendDisputeUsageReportTx(cdc),
+	}
+	cmd.Flags().String(client.FlagApp, "", "app name of this transaction")
+	cmd.Flags().String(client.FlagProvider, "", "provider of the disputed report")
+	cmd.Flags().Int64(client.FlagPeriod, 0, "month of the disputed report")
+	return cmd
+}
+
+// send dispute usage report transaction to the blockchain
+func sendDisputeUsageReportTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := infra.NewDisputeUsageReportMsg(
+			viper.GetString(client.FlagApp), viper.GetString(client.FlagProvider),
+			viper.GetInt64(client.FlagPeriod))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -0,0 +1,74 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	infra "github.com/lino-network/lino/x/infra"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProviderRegisterTxCmd - register to be infra provider
+func ProviderRegisterTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-register",
+		Short: "infra provider register",
+		RunE:  sendProviderRegisterTx(cdc),
+	}
+	cmd.Flags().String(client.FlagProvider, "", "provider name of this transaction")
+	cmd.Flags().String(client.FlagDeposit, "", "deposit of the registration")
+	return cmd
+}
+
+// send provider register transaction to the blockchain
+func sendProviderRegisterTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		username := viper.GetString(client.FlagProvider)
+		msg := infra.NewProviderRegisterMsg(username, types.LNO(viper.GetString(client.FlagDeposit)))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+// ProviderRevokeTxCmd - revoke infra provider
+func ProviderRevokeTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-revoke",
+		Short: "infra provider revoke",
+		RunE:  sendProviderRevokeTx(cdc),
+	}
+	cmd.Flags().String(client.FlagProvider, "", "provider name of this transaction")
+	return cmd
+}
+
+// send provider revoke transaction to the blockchain
+func sendProviderRevokeTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		username := viper.GetString(client.FlagProvider)
+		msg := infra.NewProviderRevokeMsg(username)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
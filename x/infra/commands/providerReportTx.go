@@ -2,7 +2,7 @@ package commands
 
 import (
 	"fmt"
-	"strconv"
+	"io/ioutil"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -17,11 +17,11 @@ import (
 func ProviderReportTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "provider-report",
-		Short: "provider report usage",
+		Short: "provider report usage co-signed by apps",
 		RunE:  sendProviderReportTx(cdc),
 	}
 	cmd.Flags().String(client.FlagProvider, "", "reporter of this transaction")
-	cmd.Flags().String(client.FlagUsage, "", "usage of the report")
+	cmd.Flags().String(client.FlagStatements, "", "path to JSON file of app signed usage statements")
 	return cmd
 }
 
@@ -30,11 +30,15 @@ func sendProviderReportTx(cdc *wire.Codec) client.CommandTxCallback {
 	return func(cmd *cobra.Command, args []string) error {
 		ctx := client.NewCoreContextFromViper()
 		username := viper.GetString(client.FlagProvider)
-		usage, err := strconv.ParseInt(viper.GetString(client.FlagUsage), 10, 64)
+		bz, err := ioutil.ReadFile(viper.GetString(client.FlagStatements))
 		if err != nil {
 			return err
 		}
-		msg := infra.NewProviderReportMsg(username, usage)
+		statements := []infra.SignedUsageStatement{}
+		if err := cdc.UnmarshalJSON(bz, &statements); err != nil {
+			return err
+		}
+		msg := infra.NewProviderReportMsg(username, statements)
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	infra "github.com/lino-network/lino/x/infra"
 	"github.com/lino-network/lino/x/infra/model"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -40,6 +41,34 @@ func GetInfraProvidersCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetUsageReportsCmd - returns app co-signed usage reports of provider in period
+func GetUsageReportsCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "infra-usage-reports <provider> <period>",
+		Short: "Query usage reports of infra provider in given month",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+				return errors.New("You must provide a infra provider name and period")
+			}
+
+			res, err := ctx.QueryCustom(infra.QuerierRoute, infra.QueryUsageReports, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			reports := []model.UsageReport{}
+			if err := cdc.UnmarshalJSON(res, &reports); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(reports); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -26,3 +26,58 @@ func ErrInvalidUsage() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeInfraQueryFailed, fmt.Sprintf("query infra store failed"))
 }
+
+// ErrAccountNotFound - error if account is not found
+func ErrAccountNotFound() sdk.Error {
+	return types.NewError(types.CodeAccountNotFound, fmt.Sprintf("account not found"))
+}
+
+// ErrInfraProviderAlreadyExist - error if infra provider already exists
+func ErrInfraProviderAlreadyExist(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeInfraProviderAlreadyExist, fmt.Sprintf("infra provider %v already exists", username))
+}
+
+// ErrInsufficientDeposit - error if infra provider deposit is insufficient
+func ErrInsufficientDeposit() sdk.Error {
+	return types.NewError(types.CodeInsufficientInfraProviderDeposit, fmt.Sprintf("infra provider deposit is insufficient"))
+}
+
+// ErrInvalidUsageStatement - error if app usage statement is invalid
+func ErrInvalidUsageStatement() sdk.Error {
+	return types.NewError(types.CodeInvalidUsageStatement, fmt.Sprintf("invalid usage statement"))
+}
+
+// ErrTooManyUsageStatements - error if too many usage statements in one report
+func ErrTooManyUsageStatements() sdk.Error {
+	return types.NewError(types.CodeTooManyUsageStatements, fmt.Sprintf("too many usage statements"))
+}
+
+// ErrInvalidUsagePeriod - error if usage period is not the current month
+func ErrInvalidUsagePeriod(period int64) sdk.Error {
+	return types.NewError(types.CodeInvalidUsagePeriod, fmt.Sprintf("invalid usage period %v", period))
+}
+
+// ErrInvalidUsageSignature - error if usage statement is not signed by the app
+func ErrInvalidUsageSignature(app types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeInvalidUsageSignature, fmt.Sprintf("invalid usage signature from app %v", app))
+}
+
+// ErrAppNotFound - error if app is not a registered developer
+func ErrAppNotFound(app types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeInfraAppNotFound, fmt.Sprintf("app %v is not found", app))
+}
+
+// ErrUsageAlreadyReported - error if app usage is already reported in the period
+func ErrUsageAlreadyReported(app types.AccountKey, period int64) sdk.Error {
+	return types.NewError(types.CodeUsageAlreadyReported, fmt.Sprintf("usage of app %v in period %v is already reported", app, period))
+}
+
+// ErrUsageReportAlreadyDisputed - error if usage report is already disputed
+func ErrUsageReportAlreadyDisputed() sdk.Error {
+	return types.NewError(types.CodeUsageReportAlreadyDisputed, fmt.Sprintf("usage report is already disputed"))
+}
+
+// ErrUsageReportStillDisputable - error if provider revokes while its usage report can still be disputed
+func ErrUsageReportStillDisputable(provider types.AccountKey, period int64) sdk.Error {
+	return types.NewError(types.CodeUsageReportStillDisputable, fmt.Sprintf("usage report of provider %v in period %v can still be disputed", provider, period))
+}
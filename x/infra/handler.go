@@ -4,15 +4,27 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/lino-network/lino/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
+	global "github.com/lino-network/lino/x/global"
 )
 
 // NewHandler - Handle all "infra" type messages.
-func NewHandler(im InfraManager) sdk.Handler {
+func NewHandler(
+	im InfraManager, am acc.AccountManager, dm dev.DeveloperManager, gm *global.GlobalManager) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
 		case ProviderReportMsg:
-			return handleProviderReportMsg(ctx, im, msg)
+			return handleProviderReportMsg(ctx, im, am, dm, gm, msg)
+		case ProviderRegisterMsg:
+			return handleProviderRegisterMsg(ctx, im, am, msg)
+		case ProviderRevokeMsg:
+			return handleProviderRevokeMsg(ctx, im, am, gm, msg)
+		case DisputeUsageReportMsg:
+			return handleDisputeUsageReportMsg(ctx, im, gm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized infra msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -20,13 +32,162 @@ func NewHandler(im InfraManager) sdk.Handler {
 	}
 }
 
-func handleProviderReportMsg(ctx sdk.Context, im InfraManager, msg ProviderReportMsg) sdk.Result {
+func handleProviderReportMsg(
+	ctx sdk.Context, im InfraManager, am acc.AccountManager, dm dev.DeveloperManager,
+	gm *global.GlobalManager, msg ProviderReportMsg) sdk.Result {
+	if !im.DoesInfraProviderExist(ctx, msg.Username) {
+		return ErrProviderNotFound().Result()
+	}
+
+	// usage can only be reported for the month which is not distributed yet
+	period, err := getCurrentPeriod(ctx, gm)
+	if err != nil {
+		return err.Result()
+	}
+
+	for _, signed := range msg.Statements {
+		statement := signed.Statement
+		if statement.Period != period {
+			return ErrInvalidUsagePeriod(statement.Period).Result()
+		}
+		if !dm.DoesDeveloperExist(ctx, statement.App) {
+			return ErrAppNotFound(statement.App).Result()
+		}
+		// statement must be signed by app's own key
+		signer, err := am.CheckSigningPubKeyOwner(
+			ctx, statement.App, signed.PubKey, types.AppPermission, types.NewCoinFromInt64(0))
+		if err != nil || signer != statement.App {
+			return ErrInvalidUsageSignature(statement.App).Result()
+		}
+		if !signed.PubKey.VerifyBytes(statement.GetSignBytes(), signed.Signature) {
+			return ErrInvalidUsageSignature(statement.App).Result()
+		}
+		if err := im.ReportAppUsage(
+			ctx, msg.Username, statement.App, period, statement.Usage); err != nil {
+			return err.Result()
+		}
+	}
+	return sdk.Result{}
+}
+
+func handleProviderRegisterMsg(
+	ctx sdk.Context, im InfraManager, am acc.AccountManager, msg ProviderRegisterMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound().Result()
+	}
+	if im.DoesInfraProviderExist(ctx, msg.Username) {
+		return ErrInfraProviderAlreadyExist(msg.Username).Result()
+	}
+
+	deposit, err := types.LinoToCoin(msg.Deposit)
+	if err != nil {
+		return err.Result()
+	}
+	param, err := im.paramHolder.GetInfraInternalAllocationParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	// check infra provider minimum deposit requirement
+	if !deposit.IsGTE(param.InfraProviderMinDeposit) {
+		return ErrInsufficientDeposit().Result()
+	}
+
+	// withdraw money from provider's bank
+	if err := am.MinusSavingCoin(
+		ctx, msg.Username, deposit, "", "", types.InfraDeposit); err != nil {
+		return err.Result()
+	}
+	if err := im.RegisterInfraProvider(ctx, msg.Username, deposit); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleProviderRevokeMsg(
+	ctx sdk.Context, im InfraManager, am acc.AccountManager,
+	gm *global.GlobalManager, msg ProviderRevokeMsg) sdk.Result {
 	if !im.DoesInfraProviderExist(ctx, msg.Username) {
 		return ErrProviderNotFound().Result()
 	}
 
-	if err := im.ReportUsage(ctx, msg.Username, msg.Usage); err != nil {
+	// deposit must stay slashable until usage reported in current period is paid out
+	period, err := getCurrentPeriod(ctx, gm)
+	if err != nil {
+		return err.Result()
+	}
+	disputable, err := im.HasDisputableUsageReport(ctx, msg.Username, period)
+	if err != nil {
+		return err.Result()
+	}
+	if disputable {
+		return ErrUsageReportStillDisputable(msg.Username, period).Result()
+	}
+
+	coin, err := im.RevokeInfraProvider(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+	if coin.IsZero() {
+		return sdk.Result{}
+	}
+
+	param, err := im.paramHolder.GetInfraInternalAllocationParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	if err := returnCoinTo(
+		ctx, msg.Username, gm, am, param.InfraProviderCoinReturnTimes,
+		param.InfraProviderCoinReturnIntervalSec, coin); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
 }
+
+func handleDisputeUsageReportMsg(
+	ctx sdk.Context, im InfraManager, gm *global.GlobalManager, msg DisputeUsageReportMsg) sdk.Result {
+	// usage can only be disputed before it is paid out
+	period, err := getCurrentPeriod(ctx, gm)
+	if err != nil {
+		return err.Result()
+	}
+	if msg.Period != period {
+		return ErrInvalidUsagePeriod(msg.Period).Result()
+	}
+
+	penalty, err := im.DisputeUsageReport(ctx, msg.Provider, msg.App, msg.Period)
+	if err != nil {
+		return err.Result()
+	}
+	// slashed deposit goes back to infra inflation pool
+	if err := gm.AddToInfraInflationPool(ctx, penalty); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func getCurrentPeriod(ctx sdk.Context, gm *global.GlobalManager) (int64, sdk.Error) {
+	pastMinutes, err := gm.GetPastMinutes(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return pastMinutes / types.MinutesPerMonth, nil
+}
+
+func returnCoinTo(
+	ctx sdk.Context, name types.AccountKey, gm *global.GlobalManager,
+	am acc.AccountManager, times int64, interval int64, coin types.Coin) sdk.Error {
+	if err := am.AddFrozenMoney(
+		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times, types.InfraReturnCoin); err != nil {
+		return err
+	}
+
+	events, err := acc.CreateCoinReturnEvents(ctx, name, times, interval, coin, types.InfraReturnCoin)
+	if err != nil {
+		return err
+	}
+
+	if err := gm.RegisterCoinReturnEvent(ctx, events, times, interval); err != nil {
+		return err
+	}
+	return nil
+}
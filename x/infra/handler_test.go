@@ -1,32 +1,271 @@
 package infra
 
 import (
+	"strconv"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
+func signStatement(statement UsageStatement, priv secp256k1.PrivKeySecp256k1) SignedUsageStatement {
+	sig, _ := priv.Sign(statement.GetSignBytes())
+	return SignedUsageStatement{
+		Statement: statement,
+		PubKey:    priv.PubKey(),
+		Signature: sig,
+	}
+}
+
 func TestReportBasic(t *testing.T) {
-	ctx, im := setupTest(t, 0)
-	handler := NewHandler(im)
+	ctx, im, am, dm, gm := setupTest(t, 0)
+	handler := NewHandler(im, am, dm, &gm)
 	im.InitGenesis(ctx)
+	dm.InitGenesis(ctx)
+
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	devParam, _ := im.paramHolder.GetDeveloperParam(ctx)
+	createTestAccount(ctx, am, "user1", minBalance)
+	app1Priv := createTestAccount(ctx, am, "app1", minBalance)
+	app2Priv := createTestAccount(ctx, am, "app2", minBalance)
+	dm.RegisterDeveloper(ctx, "app1", devParam.DeveloperMinDeposit, "", "", "")
 
 	user1 := types.AccountKey("user1")
 	usage := int64(100)
-	im.RegisterInfraProvider(ctx, user1)
+	im.RegisterInfraProvider(ctx, user1, types.NewCoinFromInt64(0))
+	statement := NewUsageStatement("user1", "app1", usage, 0)
+	badSigned := signStatement(statement, app1Priv)
+	badSigned.Signature, _ = app1Priv.Sign([]byte("other bytes"))
 
-	// infra provider does not exist
-	msg1 := NewProviderReportMsg("qwdqwdqw", usage)
-	res := handler(ctx, msg1)
-	assert.Equal(t, ErrProviderNotFound().Result(), res)
+	testCases := []struct {
+		testName     string
+		msg          ProviderReportMsg
+		expectResult sdk.Result
+	}{
+		{
+			testName: "infra provider does not exist",
+			msg: NewProviderReportMsg("qwdqwdqw", []SignedUsageStatement{
+				signStatement(NewUsageStatement("qwdqwdqw", "app1", usage, 0), app1Priv)}),
+			expectResult: ErrProviderNotFound().Result(),
+		},
+		{
+			testName: "app is not developer",
+			msg: NewProviderReportMsg("user1", []SignedUsageStatement{
+				signStatement(NewUsageStatement("user1", "app2", usage, 0), app2Priv)}),
+			expectResult: ErrAppNotFound("app2").Result(),
+		},
+		{
+			testName:     "statement signed by other key",
+			msg:          NewProviderReportMsg("user1", []SignedUsageStatement{signStatement(statement, app2Priv)}),
+			expectResult: ErrInvalidUsageSignature("app1").Result(),
+		},
+		{
+			testName:     "invalid signature",
+			msg:          NewProviderReportMsg("user1", []SignedUsageStatement{badSigned}),
+			expectResult: ErrInvalidUsageSignature("app1").Result(),
+		},
+		{
+			testName: "statement for other period",
+			msg: NewProviderReportMsg("user1", []SignedUsageStatement{
+				signStatement(NewUsageStatement("user1", "app1", usage, 1), app1Priv)}),
+			expectResult: ErrInvalidUsagePeriod(1).Result(),
+		},
+		{
+			testName:     "normal case",
+			msg:          NewProviderReportMsg("user1", []SignedUsageStatement{signStatement(statement, app1Priv)}),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "replay statement",
+			msg:          NewProviderReportMsg("user1", []SignedUsageStatement{signStatement(statement, app1Priv)}),
+			expectResult: ErrUsageAlreadyReported("app1", 0).Result(),
+		},
+	}
 
-	msg2 := NewProviderReportMsg("user1", usage)
-	res2 := handler(ctx, msg2)
-	assert.Equal(t, sdk.Result{}, res2)
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
 
 	provider, _ := im.storage.GetInfraProvider(ctx, user1)
 	assert.Equal(t, usage, provider.Usage)
+}
+
+func TestProviderRegisterAndRevoke(t *testing.T) {
+	ctx, im, am, dm, gm := setupTest(t, 0)
+	handler := NewHandler(im, am, dm, &gm)
+	im.InitGenesis(ctx)
+	param, _ := im.paramHolder.GetInfraInternalAllocationParam(ctx)
+
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	createTestAccount(ctx, am, "user1", param.InfraProviderMinDeposit.Plus(minBalance))
+	createTestAccount(ctx, am, "user2", param.InfraProviderMinDeposit)
+	minDeposit, _ := param.InfraProviderMinDeposit.ToInt64()
+	minDepositLNO := strconv.FormatInt(minDeposit/types.Decimals, 10)
+	lessDepositLNO := strconv.FormatInt(minDeposit/types.Decimals-1, 10)
+
+	testCases := []struct {
+		testName     string
+		msg          sdk.Msg
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "account doesn't exist",
+			msg:          NewProviderRegisterMsg("user3", minDepositLNO),
+			expectResult: ErrAccountNotFound().Result(),
+		},
+		{
+			testName:     "insufficient deposit",
+			msg:          NewProviderRegisterMsg("user1", lessDepositLNO),
+			expectResult: ErrInsufficientDeposit().Result(),
+		},
+		{
+			testName:     "normal register",
+			msg:          NewProviderRegisterMsg("user1", minDepositLNO),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "register twice",
+			msg:          NewProviderRegisterMsg("user1", minDepositLNO),
+			expectResult: ErrInfraProviderAlreadyExist("user1").Result(),
+		},
+		{
+			testName:     "revoke non-exist provider",
+			msg:          NewProviderRevokeMsg("user2"),
+			expectResult: ErrProviderNotFound().Result(),
+		},
+		{
+			testName:     "normal revoke",
+			msg:          NewProviderRevokeMsg("user1"),
+			expectResult: sdk.Result{},
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+
+	assert.False(t, im.DoesInfraProviderExist(ctx, "user1"))
+	lst, _ := im.storage.GetInfraProviderList(ctx)
+	assert.Equal(t, 0, len(lst.AllInfraProviders))
+	// deposit returns by coin return events
+	returns, _ := am.GetPendingReturns(ctx, "user1")
+	assert.Equal(t, 1, len(returns))
+	assert.Equal(t, param.InfraProviderMinDeposit, returns[0].TotalAmount)
+	assert.Equal(t, types.InfraReturnCoin, returns[0].ReturnType)
+}
+
+func TestDisputeBasic(t *testing.T) {
+	ctx, im, am, dm, gm := setupTest(t, 0)
+	handler := NewHandler(im, am, dm, &gm)
+	im.InitGenesis(ctx)
+	dm.InitGenesis(ctx)
+	param, _ := im.paramHolder.GetInfraInternalAllocationParam(ctx)
+
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	devParam, _ := im.paramHolder.GetDeveloperParam(ctx)
+	app1Priv := createTestAccount(ctx, am, "app1", minBalance)
+	dm.RegisterDeveloper(ctx, "app1", devParam.DeveloperMinDeposit, "", "", "")
+	im.RegisterInfraProvider(ctx, "user1", param.InfraProviderMinDeposit)
+
+	result := handler(ctx, NewProviderReportMsg("user1", []SignedUsageStatement{
+		signStatement(NewUsageStatement("user1", "app1", 100, 0), app1Priv)}))
+	assert.Equal(t, sdk.Result{}, result)
+
+	testCases := []struct {
+		testName     string
+		msg          DisputeUsageReportMsg
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "report in other period",
+			msg:          NewDisputeUsageReportMsg("app1", "user1", 1),
+			expectResult: ErrInvalidUsagePeriod(1).Result(),
+		},
+		{
+			testName:     "normal case",
+			msg:          NewDisputeUsageReportMsg("app1", "user1", 0),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "dispute twice",
+			msg:          NewDisputeUsageReportMsg("app1", "user1", 0),
+			expectResult: ErrUsageReportAlreadyDisputed().Result(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
+
+	provider, _ := im.storage.GetInfraProvider(ctx, "user1")
+	assert.Equal(t, int64(0), provider.Usage)
+	assert.Equal(t, param.InfraProviderMinDeposit.Minus(param.InfraProviderDisputePenalty), provider.Deposit)
+	// slashed deposit goes to infra inflation pool
+	inflation, _ := gm.GetInfraMonthlyInflation(ctx)
+	assert.True(t, inflation.IsGTE(param.InfraProviderDisputePenalty))
+}
+
+func TestRevokeWithDisputableReport(t *testing.T) {
+	ctx, im, am, dm, gm := setupTest(t, 0)
+	handler := NewHandler(im, am, dm, &gm)
+	im.InitGenesis(ctx)
+	dm.InitGenesis(ctx)
+	param, _ := im.paramHolder.GetInfraInternalAllocationParam(ctx)
+
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	devParam, _ := im.paramHolder.GetDeveloperParam(ctx)
+	createTestAccount(ctx, am, "user1", minBalance)
+	app1Priv := createTestAccount(ctx, am, "app1", minBalance)
+	dm.RegisterDeveloper(ctx, "app1", devParam.DeveloperMinDeposit, "", "", "")
+	im.RegisterInfraProvider(ctx, "user1", param.InfraProviderMinDeposit)
+
+	result := handler(ctx, NewProviderReportMsg("user1", []SignedUsageStatement{
+		signStatement(NewUsageStatement("user1", "app1", 100, 0), app1Priv)}))
+	assert.Equal(t, sdk.Result{}, result)
+
+	testCases := []struct {
+		testName     string
+		msg          sdk.Msg
+		expectResult sdk.Result
+	}{
+		{
+			testName:     "revoke while report can be disputed",
+			msg:          NewProviderRevokeMsg("user1"),
+			expectResult: ErrUsageReportStillDisputable("user1", 0).Result(),
+		},
+		{
+			testName:     "app disputes report",
+			msg:          NewDisputeUsageReportMsg("app1", "user1", 0),
+			expectResult: sdk.Result{},
+		},
+		{
+			testName:     "revoke after report is disputed",
+			msg:          NewProviderRevokeMsg("user1"),
+			expectResult: sdk.Result{},
+		},
+	}
+
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+	}
 
+	assert.False(t, im.DoesInfraProviderExist(ctx, "user1"))
+	// deposit left after penalty returns by coin return events
+	returns, _ := am.GetPendingReturns(ctx, "user1")
+	assert.Equal(t, 1, len(returns))
+	assert.Equal(t, param.InfraProviderMinDeposit.Minus(param.InfraProviderDisputePenalty), returns[0].TotalAmount)
 }
@@ -35,9 +35,11 @@ func (im InfraManager) DoesInfraProviderExist(ctx sdk.Context, username types.Ac
 }
 
 // RegisterInfraProvider - register infra provider on KVStore
-func (im InfraManager) RegisterInfraProvider(ctx sdk.Context, username types.AccountKey) sdk.Error {
+func (im InfraManager) RegisterInfraProvider(
+	ctx sdk.Context, username types.AccountKey, deposit types.Coin) sdk.Error {
 	provider := &model.InfraProvider{
 		Username: username,
+		Deposit:  deposit,
 	}
 	if err := im.storage.SetInfraProvider(ctx, username, provider); err != nil {
 		return err
@@ -84,6 +86,20 @@ func (im InfraManager) RemoveFromProviderList(ctx sdk.Context, username types.Ac
 	return nil
 }
 
+// RevokeInfraProvider - remove infra provider from KVStore, return its remaining deposit
+func (im InfraManager) RevokeInfraProvider(
+	ctx sdk.Context, username types.AccountKey) (types.Coin, sdk.Error) {
+	provider, err := im.storage.GetInfraProvider(ctx, username)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if err := im.RemoveFromProviderList(ctx, username); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	im.storage.DeleteInfraProvider(ctx, username)
+	return provider.Deposit, nil
+}
+
 // ReportUsage - infra provider report usage and get reward
 func (im *InfraManager) ReportUsage(ctx sdk.Context, username types.AccountKey, usage int64) sdk.Error {
 	provider, err := im.storage.GetInfraProvider(ctx, username)
@@ -97,6 +113,93 @@ func (im *InfraManager) ReportUsage(ctx sdk.Context, username types.AccountKey,
 	return nil
 }
 
+// ReportAppUsage - record usage co-signed by app and add it to provider usage
+func (im *InfraManager) ReportAppUsage(
+	ctx sdk.Context, provider, app types.AccountKey, period, usage int64) sdk.Error {
+	if im.storage.DoesUsageReportExist(ctx, provider, period, app) {
+		return ErrUsageAlreadyReported(app, period)
+	}
+	report := &model.UsageReport{
+		Provider:   provider,
+		App:        app,
+		Usage:      usage,
+		Period:     period,
+		ReportedAt: ctx.BlockHeader().Time.Unix(),
+	}
+	if err := im.storage.SetUsageReport(ctx, report); err != nil {
+		return err
+	}
+	return im.ReportUsage(ctx, provider, usage)
+}
+
+// DisputeUsageReport - app disputes usage report, the reported usage is discarded
+// and provider deposit is slashed. Provider whose remaining deposit is below
+// minimum requirement is removed from provider list. Return actual penalty.
+func (im *InfraManager) DisputeUsageReport(
+	ctx sdk.Context, provider, app types.AccountKey, period int64) (types.Coin, sdk.Error) {
+	report, err := im.storage.GetUsageReport(ctx, provider, period, app)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	if report.Disputed {
+		return types.NewCoinFromInt64(0), ErrUsageReportAlreadyDisputed()
+	}
+	param, err := im.paramHolder.GetInfraInternalAllocationParam(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	infraProvider, err := im.storage.GetInfraProvider(ctx, provider)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+
+	infraProvider.Usage -= report.Usage
+	if infraProvider.Usage < 0 {
+		infraProvider.Usage = 0
+	}
+	penalty := param.InfraProviderDisputePenalty
+	if penalty.IsGT(infraProvider.Deposit) {
+		penalty = infraProvider.Deposit
+	}
+	infraProvider.Deposit = infraProvider.Deposit.Minus(penalty)
+	if !infraProvider.Deposit.IsGTE(param.InfraProviderMinDeposit) {
+		if err := im.RemoveFromProviderList(ctx, provider); err != nil {
+			return types.NewCoinFromInt64(0), err
+		}
+	}
+	if err := im.storage.SetInfraProvider(ctx, provider, infraProvider); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+
+	report.Disputed = true
+	if err := im.storage.SetUsageReport(ctx, report); err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	return penalty, nil
+}
+
+// HasDisputableUsageReport - return true if provider has usage report
+// in given period which is not disputed yet
+func (im *InfraManager) HasDisputableUsageReport(
+	ctx sdk.Context, provider types.AccountKey, period int64) (bool, sdk.Error) {
+	reports, err := im.storage.GetUsageReports(ctx, provider, period)
+	if err != nil {
+		return false, err
+	}
+	for _, report := range reports {
+		if !report.Disputed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GetUsageReports - get all usage reports of provider in given period
+func (im *InfraManager) GetUsageReports(
+	ctx sdk.Context, provider types.AccountKey, period int64) ([]model.UsageReport, sdk.Error) {
+	return im.storage.GetUsageReports(ctx, provider, period)
+}
+
 // GetUsageWeight - get the usage percentage of given infra provider
 func (im *InfraManager) GetUsageWeight(ctx sdk.Context, username types.AccountKey) (sdk.Dec, sdk.Error) {
 	lst, err := im.storage.GetInfraProviderList(ctx)
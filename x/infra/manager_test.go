@@ -9,11 +9,11 @@ import (
 )
 
 func TestRegister(t *testing.T) {
-	ctx, im := setupTest(t, 0)
+	ctx, im, _, _, _ := setupTest(t, 0)
 	im.InitGenesis(ctx)
 
 	user1 := types.AccountKey("user1")
-	im.RegisterInfraProvider(ctx, user1)
+	im.RegisterInfraProvider(ctx, user1, types.NewCoinFromInt64(0))
 
 	_, err := im.storage.GetInfraProvider(ctx, user1)
 	assert.Nil(t, err)
@@ -21,11 +21,11 @@ func TestRegister(t *testing.T) {
 }
 
 func TestInfraProviderList(t *testing.T) {
-	ctx, im := setupTest(t, 0)
+	ctx, im, _, _, _ := setupTest(t, 0)
 	im.InitGenesis(ctx)
 
 	user1 := types.AccountKey("user1")
-	im.RegisterInfraProvider(ctx, user1)
+	im.RegisterInfraProvider(ctx, user1, types.NewCoinFromInt64(0))
 
 	addErr := im.AddToInfraProviderList(ctx, "user1")
 	assert.Nil(t, addErr)
@@ -43,14 +43,14 @@ func TestInfraProviderList(t *testing.T) {
 }
 
 func TestReportUsage(t *testing.T) {
-	ctx, im := setupTest(t, 0)
+	ctx, im, _, _, _ := setupTest(t, 0)
 	im.InitGenesis(ctx)
 
 	user1 := types.AccountKey("user1")
-	im.RegisterInfraProvider(ctx, user1)
+	im.RegisterInfraProvider(ctx, user1, types.NewCoinFromInt64(0))
 
 	user2 := types.AccountKey("user2")
-	im.RegisterInfraProvider(ctx, user2)
+	im.RegisterInfraProvider(ctx, user2, types.NewCoinFromInt64(0))
 
 	im.AddToInfraProviderList(ctx, "user1")
 	im.AddToInfraProviderList(ctx, "user2")
@@ -98,3 +98,45 @@ func TestReportUsage(t *testing.T) {
 		im.ClearUsage(ctx)
 	}
 }
+
+func TestDisputeUsageReport(t *testing.T) {
+	ctx, im, _, _, _ := setupTest(t, 0)
+	im.InitGenesis(ctx)
+	param, _ := im.paramHolder.GetInfraInternalAllocationParam(ctx)
+
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+	im.RegisterInfraProvider(ctx, user1, param.InfraProviderMinDeposit.Plus(param.InfraProviderDisputePenalty))
+	im.RegisterInfraProvider(ctx, user2, param.InfraProviderMinDeposit)
+
+	assert.Nil(t, im.ReportAppUsage(ctx, user1, "app1", 0, 100))
+	assert.Nil(t, im.ReportAppUsage(ctx, user1, "app2", 0, 50))
+	assert.Nil(t, im.ReportAppUsage(ctx, user2, "app1", 0, 10))
+	assert.Equal(t, ErrUsageAlreadyReported("app1", 0), im.ReportAppUsage(ctx, user1, "app1", 0, 100))
+
+	// user1 keeps enough deposit after slash
+	penalty, err := im.DisputeUsageReport(ctx, user1, "app1", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, param.InfraProviderDisputePenalty, penalty)
+	provider, _ := im.storage.GetInfraProvider(ctx, user1)
+	assert.Equal(t, int64(50), provider.Usage)
+	assert.Equal(t, param.InfraProviderMinDeposit, provider.Deposit)
+	report, _ := im.storage.GetUsageReport(ctx, user1, 0, "app1")
+	assert.True(t, report.Disputed)
+
+	_, err = im.DisputeUsageReport(ctx, user1, "app1", 0)
+	assert.Equal(t, ErrUsageReportAlreadyDisputed(), err)
+	_, err = im.DisputeUsageReport(ctx, user1, "app3", 0)
+	assert.NotNil(t, err)
+
+	// user2 deposit falls below minimum and is removed from provider list
+	_, err = im.DisputeUsageReport(ctx, user2, "app1", 0)
+	assert.Nil(t, err)
+	lst, _ := im.storage.GetInfraProviderList(ctx)
+	assert.Equal(t, []types.AccountKey{user1}, lst.AllInfraProviders)
+
+	deposit, err := im.RevokeInfraProvider(ctx, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, param.InfraProviderMinDeposit.Minus(param.InfraProviderDisputePenalty), deposit)
+	assert.False(t, im.DoesInfraProviderExist(ctx, user2))
+}
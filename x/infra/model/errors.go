@@ -32,6 +32,21 @@ func ErrFailedToUnmarshalInfraProvider(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalInfraProvider, fmt.Sprintf("failed to unmarshal infra provider: %s", err.Error()))
 }
 
+// ErrUsageReportNotFound - error if usage report is not found
+func ErrUsageReportNotFound() sdk.Error {
+	return types.NewError(types.CodeUsageReportNotFound, fmt.Sprintf("usage report is not found"))
+}
+
+// ErrFailedToMarshalUsageReport - error if marshal usage report failed
+func ErrFailedToMarshalUsageReport(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalUsageReport, fmt.Sprintf("failed to marshal usage report: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalUsageReport - error if unmarshal usage report failed
+func ErrFailedToUnmarshalUsageReport(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalUsageReport, fmt.Sprintf("failed to unmarshal usage report: %s", err.Error()))
+}
+
 // ErrFailedToUnmarshalInfraProviderList - error if unmarshal infra provider list failed
 func ErrFailedToUnmarshalInfraProviderList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalInfraProviderList, fmt.Sprintf("failed to unmarshal infra provider list: %s", err.Error()))
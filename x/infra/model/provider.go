@@ -5,12 +5,26 @@ import (
 )
 
 // InfraProvider - infra provider of blockchain
+// Deposit - coin locked by provider, slashed when an app disputes its usage report
 type InfraProvider struct {
 	Username types.AccountKey `json:"username"`
 	Usage    int64            `json:"usage"`
+	Deposit  types.Coin       `json:"deposit"`
 }
 
 // InfraProviderList - infra provider list of blockchain
 type InfraProviderList struct {
 	AllInfraProviders []types.AccountKey `json:"all_infra_providers"`
 }
+
+// UsageReport - usage of infra service reported by provider and co-signed by app
+// Period - month since chain start the usage belongs to
+// Disputed - true if the app disputed this report and the provider was slashed
+type UsageReport struct {
+	Provider   types.AccountKey `json:"provider"`
+	App        types.AccountKey `json:"app"`
+	Usage      int64            `json:"usage"`
+	Period     int64            `json:"period"`
+	ReportedAt int64            `json:"reported_at"`
+	Disputed   bool             `json:"disputed"`
+}
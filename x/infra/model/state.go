@@ -15,10 +15,16 @@ type InfraProviderListRow struct {
 	List InfraProviderList `json:"list"`
 }
 
+// UsageReportRow - usage report, pk: (provider, period, app)
+type UsageReportRow struct {
+	Report UsageReport `json:"report"`
+}
+
 // InfraTables infra storage state
 type InfraTables struct {
 	InfraProviders    []InfraProviderRow
 	InfraProviderList InfraProviderListRow
+	UsageReports      []UsageReportRow
 }
 
 // ToIR - same
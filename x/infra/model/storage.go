@@ -1,6 +1,8 @@
 package model
 
 import (
+	"strconv"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
@@ -9,6 +11,7 @@ import (
 var (
 	infraProviderSubstore     = []byte{0x00}
 	infraProviderListSubstore = []byte{0x01}
+	usageReportSubstore       = []byte{0x02}
 )
 
 // InfraProviderStorage - infra provider storage
@@ -68,6 +71,12 @@ func (is InfraProviderStorage) SetInfraProvider(
 	return nil
 }
 
+// DeleteInfraProvider - delete infra provider from KVStore
+func (is InfraProviderStorage) DeleteInfraProvider(ctx sdk.Context, accKey types.AccountKey) {
+	store := ctx.KVStore(is.key)
+	store.Delete(GetInfraProviderKey(accKey))
+}
+
 // GetInfraProviderList - get infra provider list from KVStore
 func (is InfraProviderStorage) GetInfraProviderList(ctx sdk.Context) (*InfraProviderList, sdk.Error) {
 	store := ctx.KVStore(is.key)
@@ -93,6 +102,56 @@ func (is InfraProviderStorage) SetInfraProviderList(ctx sdk.Context, lst *InfraP
 	return nil
 }
 
+// DoesUsageReportExist - check if app already co-signed a report of provider in given period
+func (is InfraProviderStorage) DoesUsageReportExist(
+	ctx sdk.Context, provider types.AccountKey, period int64, app types.AccountKey) bool {
+	store := ctx.KVStore(is.key)
+	return store.Has(GetUsageReportKey(provider, period, app))
+}
+
+// GetUsageReport - get usage report from KVStore
+func (is InfraProviderStorage) GetUsageReport(
+	ctx sdk.Context, provider types.AccountKey, period int64, app types.AccountKey) (*UsageReport, sdk.Error) {
+	store := ctx.KVStore(is.key)
+	reportByte := store.Get(GetUsageReportKey(provider, period, app))
+	if reportByte == nil {
+		return nil, ErrUsageReportNotFound()
+	}
+	report := new(UsageReport)
+	if err := is.cdc.UnmarshalBinaryLengthPrefixed(reportByte, report); err != nil {
+		return nil, ErrFailedToUnmarshalUsageReport(err)
+	}
+	return report, nil
+}
+
+// SetUsageReport - set usage report to KVStore
+func (is InfraProviderStorage) SetUsageReport(ctx sdk.Context, report *UsageReport) sdk.Error {
+	store := ctx.KVStore(is.key)
+	reportByte, err := is.cdc.MarshalBinaryLengthPrefixed(*report)
+	if err != nil {
+		return ErrFailedToMarshalUsageReport(err)
+	}
+	store.Set(GetUsageReportKey(report.Provider, report.Period, report.App), reportByte)
+	return nil
+}
+
+// GetUsageReports - get all usage reports of provider in given period
+func (is InfraProviderStorage) GetUsageReports(
+	ctx sdk.Context, provider types.AccountKey, period int64) ([]UsageReport, sdk.Error) {
+	store := ctx.KVStore(is.key)
+	itr := sdk.KVStorePrefixIterator(store, GetUsageReportPrefix(provider, period))
+	defer itr.Close()
+	reports := []UsageReport{}
+	for ; itr.Valid(); itr.Next() {
+		report := new(UsageReport)
+		if err := is.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), report); err != nil {
+			return nil, ErrFailedToUnmarshalUsageReport(err)
+		}
+		reports = append(reports, *report)
+	}
+	return reports, nil
+}
+
 // Export - infra state
 func (is InfraProviderStorage) Export(ctx sdk.Context) *InfraTables {
 	tables := &InfraTables{}
@@ -123,6 +182,20 @@ func (is InfraProviderStorage) Export(ctx sdk.Context) *InfraTables {
 	tables.InfraProviderList = InfraProviderListRow{
 		List: *list,
 	}
+	// export table.UsageReports
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, usageReportSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			report := new(UsageReport)
+			if err := is.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), report); err != nil {
+				panic("failed to read usage report: " + err.Error())
+			}
+			tables.UsageReports = append(tables.UsageReports, UsageReportRow{
+				Report: *report,
+			})
+		}
+	}()
 	return tables
 }
 
@@ -141,6 +214,11 @@ func (is InfraProviderStorage) Import(ctx sdk.Context, tb *InfraTablesIR) {
 	// import ProviderList
 	err := is.SetInfraProviderList(ctx, &tb.InfraProviderList.List)
 	check(err)
+	// import table.UsageReports
+	for _, v := range tb.UsageReports {
+		err := is.SetUsageReport(ctx, &v.Report)
+		check(err)
+	}
 }
 
 // GetInfraProviderKey - get infra provider key in infra provider substore
@@ -152,3 +230,14 @@ func GetInfraProviderKey(accKey types.AccountKey) []byte {
 func GetInfraProviderListKey() []byte {
 	return infraProviderListSubstore
 }
+
+// GetUsageReportPrefix - "usage report substore" + "provider" + "/" + "period" + "/"
+func GetUsageReportPrefix(provider types.AccountKey, period int64) []byte {
+	prefix := append(append(usageReportSubstore, provider...), types.KeySeparator...)
+	return append(append(prefix, strconv.FormatInt(period, 10)...), types.KeySeparator...)
+}
+
+// GetUsageReportKey - "usage report prefix" + "app"
+func GetUsageReportKey(provider types.AccountKey, period int64, app types.AccountKey) []byte {
+	return append(GetUsageReportPrefix(provider, period), app...)
+}
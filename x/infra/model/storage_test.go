@@ -21,6 +21,7 @@ func TestInfraProvider(t *testing.T) {
 	provider := InfraProvider{
 		Username: "user1",
 		Usage:    int64(1000),
+		Deposit:  types.NewCoinFromInt64(0),
 	}
 
 	runTest(t, func(env TestEnv) {
@@ -50,6 +51,35 @@ func TestInfraProviderList(t *testing.T) {
 
 }
 
+func TestUsageReport(t *testing.T) {
+	report1 := UsageReport{
+		Provider: "provider", App: "app1", Usage: 100, Period: 1, ReportedAt: 10}
+	report2 := UsageReport{
+		Provider: "provider", App: "app2", Usage: 200, Period: 1, ReportedAt: 20}
+	report3 := UsageReport{
+		Provider: "provider", App: "app1", Usage: 300, Period: 2, ReportedAt: 30}
+
+	runTest(t, func(env TestEnv) {
+		assert.False(t, env.is.DoesUsageReportExist(env.ctx, "provider", 1, "app1"))
+		_, err := env.is.GetUsageReport(env.ctx, "provider", 1, "app1")
+		assert.Equal(t, ErrUsageReportNotFound(), err)
+
+		for _, report := range []UsageReport{report1, report2, report3} {
+			err := env.is.SetUsageReport(env.ctx, &report)
+			assert.Nil(t, err)
+		}
+		assert.True(t, env.is.DoesUsageReportExist(env.ctx, "provider", 1, "app1"))
+
+		resultPtr, err := env.is.GetUsageReport(env.ctx, "provider", 2, "app1")
+		assert.Nil(t, err)
+		assert.Equal(t, report3, *resultPtr, "usage report should be equal")
+
+		reports, err := env.is.GetUsageReports(env.ctx, "provider", 1)
+		assert.Nil(t, err)
+		assert.Equal(t, []UsageReport{report1, report2}, reports)
+	})
+}
+
 //
 // Test Environment setup
 //
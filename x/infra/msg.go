@@ -6,23 +6,79 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 var _ types.Msg = ProviderReportMsg{}
+var _ types.Msg = ProviderRegisterMsg{}
+var _ types.Msg = ProviderRevokeMsg{}
+var _ types.Msg = DisputeUsageReportMsg{}
 
-// ProviderReportMsg - infra provider report infra usage to blockchain
+// UsageStatement - usage of infra service consumed by app during period, signed by app
+type UsageStatement struct {
+	Provider types.AccountKey `json:"provider"`
+	App      types.AccountKey `json:"app"`
+	Usage    int64            `json:"usage"`
+	Period   int64            `json:"period"`
+}
+
+// SignedUsageStatement - usage statement with app signature
+type SignedUsageStatement struct {
+	Statement UsageStatement `json:"statement"`
+	PubKey    crypto.PubKey  `json:"pub_key"`
+	Signature []byte         `json:"signature"`
+}
+
+// ProviderReportMsg - infra provider report infra usage co-signed by apps to blockchain
 type ProviderReportMsg struct {
+	Username   types.AccountKey       `json:"username"`
+	Statements []SignedUsageStatement `json:"statements"`
+}
+
+// ProviderRegisterMsg - register infra provider with deposit
+type ProviderRegisterMsg struct {
+	Username types.AccountKey `json:"username"`
+	Deposit  types.LNO        `json:"deposit"`
+}
+
+// ProviderRevokeMsg - revoke infra provider, deposit returns by coin return event
+type ProviderRevokeMsg struct {
 	Username types.AccountKey `json:"username"`
-	Usage    int64            `json:"usage"`
+}
+
+// DisputeUsageReportMsg - app disputes usage reported by provider in period
+type DisputeUsageReportMsg struct {
+	App      types.AccountKey `json:"app"`
+	Provider types.AccountKey `json:"provider"`
+	Period   int64            `json:"period"`
+}
+
+// NewUsageStatement - new UsageStatement
+func NewUsageStatement(provider, app string, usage, period int64) UsageStatement {
+	return UsageStatement{
+		Provider: types.AccountKey(provider),
+		App:      types.AccountKey(app),
+		Usage:    usage,
+		Period:   period,
+	}
+}
+
+// GetSignBytes - bytes app signs for the statement
+func (statement UsageStatement) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(statement) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
 }
 
 //----------------------------------------
 // ReportMsg Msg Implementations
 // NewProviderReportMsg - new ProviderReportMsg
-func NewProviderReportMsg(provider string, usage int64) ProviderReportMsg {
+func NewProviderReportMsg(provider string, statements []SignedUsageStatement) ProviderReportMsg {
 	return ProviderReportMsg{
-		Username: types.AccountKey(provider),
-		Usage:    usage,
+		Username:   types.AccountKey(provider),
+		Statements: statements,
 	}
 }
 
@@ -39,15 +95,34 @@ func (msg ProviderReportMsg) ValidateBasic() sdk.Error {
 		return ErrInvalidUsername()
 	}
 
-	if msg.Usage <= 0 {
-		return ErrInvalidUsage()
+	if len(msg.Statements) == 0 {
+		return ErrInvalidUsageStatement()
+	}
+	if len(msg.Statements) > types.MaximumNumOfUsageStatements {
+		return ErrTooManyUsageStatements()
+	}
+	for _, signed := range msg.Statements {
+		statement := signed.Statement
+		if len(statement.App) < types.MinimumUsernameLength ||
+			len(statement.App) > types.MaximumUsernameLength {
+			return ErrInvalidUsername()
+		}
+		if statement.Provider != msg.Username || statement.Period < 0 {
+			return ErrInvalidUsageStatement()
+		}
+		if statement.Usage <= 0 {
+			return ErrInvalidUsage()
+		}
+		if signed.PubKey == nil || len(signed.Signature) == 0 {
+			return ErrInvalidUsageSignature(statement.App)
+		}
 	}
 
 	return nil
 }
 
 func (msg ProviderReportMsg) String() string {
-	return fmt.Sprintf("ProviderReportMsg{Username:%v, Usage:%v}", msg.Username, msg.Usage)
+	return fmt.Sprintf("ProviderReportMsg{Username:%v, Statements:%v}", msg.Username, len(msg.Statements))
 }
 
 // GetPermission - implements types.Msg
@@ -73,3 +148,168 @@ func (msg ProviderReportMsg) GetSigners() []sdk.AccAddress {
 func (msg ProviderReportMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// ProviderRegisterMsg Msg Implementations
+// NewProviderRegisterMsg - new ProviderRegisterMsg
+func NewProviderRegisterMsg(provider string, deposit types.LNO) ProviderRegisterMsg {
+	return ProviderRegisterMsg{
+		Username: types.AccountKey(provider),
+		Deposit:  deposit,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg ProviderRegisterMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg ProviderRegisterMsg) Type() string { return "ProviderRegisterMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ProviderRegisterMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+
+	if _, err := types.LinoToCoin(msg.Deposit); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg ProviderRegisterMsg) String() string {
+	return fmt.Sprintf("ProviderRegisterMsg{Username:%v, Deposit:%v}", msg.Username, msg.Deposit)
+}
+
+// GetPermission - implements types.Msg
+func (msg ProviderRegisterMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ProviderRegisterMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ProviderRegisterMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg ProviderRegisterMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// ProviderRevokeMsg Msg Implementations
+// NewProviderRevokeMsg - new ProviderRevokeMsg
+func NewProviderRevokeMsg(provider string) ProviderRevokeMsg {
+	return ProviderRevokeMsg{
+		Username: types.AccountKey(provider),
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg ProviderRevokeMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg ProviderRevokeMsg) Type() string { return "ProviderRevokeMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg ProviderRevokeMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg ProviderRevokeMsg) String() string {
+	return fmt.Sprintf("ProviderRevokeMsg{Username:%v}", msg.Username)
+}
+
+// GetPermission - implements types.Msg
+func (msg ProviderRevokeMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg ProviderRevokeMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg ProviderRevokeMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg ProviderRevokeMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// DisputeUsageReportMsg Msg Implementations
+// NewDisputeUsageReportMsg - new DisputeUsageReportMsg
+func NewDisputeUsageReportMsg(app, provider string, period int64) DisputeUsageReportMsg {
+	return DisputeUsageReportMsg{
+		App:      types.AccountKey(app),
+		Provider: types.AccountKey(provider),
+		Period:   period,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg DisputeUsageReportMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg DisputeUsageReportMsg) Type() string { return "DisputeUsageReportMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg DisputeUsageReportMsg) ValidateBasic() sdk.Error {
+	if len(msg.App) < types.MinimumUsernameLength ||
+		len(msg.App) > types.MaximumUsernameLength ||
+		len(msg.Provider) < types.MinimumUsernameLength ||
+		len(msg.Provider) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if msg.Period < 0 {
+		return ErrInvalidUsagePeriod(msg.Period)
+	}
+	return nil
+}
+
+func (msg DisputeUsageReportMsg) String() string {
+	return fmt.Sprintf("DisputeUsageReportMsg{App:%v, Provider:%v, Period:%v}", msg.App, msg.Provider, msg.Period)
+}
+
+// GetPermission - implements types.Msg
+func (msg DisputeUsageReportMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg DisputeUsageReportMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg DisputeUsageReportMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.App)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg DisputeUsageReportMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
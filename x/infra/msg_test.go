@@ -7,9 +7,21 @@ import (
 	"github.com/lino-network/lino/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
 func TestProviderReportMsg(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	statements := func(provider, app string, usage int64) []SignedUsageStatement {
+		return []SignedUsageStatement{
+			signStatement(NewUsageStatement(provider, app, usage, 0), priv),
+		}
+	}
+	tooManyStatements := []SignedUsageStatement{}
+	for i := 0; i <= types.MaximumNumOfUsageStatements; i++ {
+		tooManyStatements = append(tooManyStatements, statements("user1", "app1", 100)...)
+	}
+
 	testCases := []struct {
 		testName          string
 		providerReportMsg ProviderReportMsg
@@ -17,19 +29,45 @@ func TestProviderReportMsg(t *testing.T) {
 	}{
 		{
 			testName:          "normal case",
-			providerReportMsg: NewProviderReportMsg("user1", 100),
+			providerReportMsg: NewProviderReportMsg("user1", statements("user1", "app1", 100)),
 			expectError:       nil,
 		},
 		{
 			testName:          "invalid username",
-			providerReportMsg: NewProviderReportMsg("", 100),
+			providerReportMsg: NewProviderReportMsg("", statements("", "app1", 100)),
 			expectError:       ErrInvalidUsername(),
 		},
 		{
 			testName:          "invalid usage",
-			providerReportMsg: NewProviderReportMsg("user1", -100),
+			providerReportMsg: NewProviderReportMsg("user1", statements("user1", "app1", -100)),
 			expectError:       ErrInvalidUsage(),
 		},
+		{
+			testName:          "no statement",
+			providerReportMsg: NewProviderReportMsg("user1", []SignedUsageStatement{}),
+			expectError:       ErrInvalidUsageStatement(),
+		},
+		{
+			testName:          "too many statements",
+			providerReportMsg: NewProviderReportMsg("user1", tooManyStatements),
+			expectError:       ErrTooManyUsageStatements(),
+		},
+		{
+			testName:          "statement for other provider",
+			providerReportMsg: NewProviderReportMsg("user1", statements("user2", "app1", 100)),
+			expectError:       ErrInvalidUsageStatement(),
+		},
+		{
+			testName:          "invalid app",
+			providerReportMsg: NewProviderReportMsg("user1", statements("user1", "a", 100)),
+			expectError:       ErrInvalidUsername(),
+		},
+		{
+			testName: "missing signature",
+			providerReportMsg: NewProviderReportMsg("user1", []SignedUsageStatement{
+				{Statement: NewUsageStatement("user1", "app1", 100, 0), PubKey: priv.PubKey()}}),
+			expectError: ErrInvalidUsageSignature("app1"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -40,13 +78,92 @@ func TestProviderReportMsg(t *testing.T) {
 	}
 }
 
+func TestProviderRegisterMsg(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		msg         ProviderRegisterMsg
+		expectError sdk.Error
+	}{
+		{
+			testName:    "normal case",
+			msg:         NewProviderRegisterMsg("user1", "1000"),
+			expectError: nil,
+		},
+		{
+			testName:    "invalid username",
+			msg:         NewProviderRegisterMsg("", "1000"),
+			expectError: ErrInvalidUsername(),
+		},
+		{
+			testName:    "invalid deposit",
+			msg:         NewProviderRegisterMsg("user1", "-1"),
+			expectError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
+func TestDisputeUsageReportMsg(t *testing.T) {
+	testCases := []struct {
+		testName    string
+		msg         DisputeUsageReportMsg
+		expectError sdk.Error
+	}{
+		{
+			testName:    "normal case",
+			msg:         NewDisputeUsageReportMsg("app1", "user1", 0),
+			expectError: nil,
+		},
+		{
+			testName:    "invalid app",
+			msg:         NewDisputeUsageReportMsg("", "user1", 0),
+			expectError: ErrInvalidUsername(),
+		},
+		{
+			testName:    "invalid provider",
+			msg:         NewDisputeUsageReportMsg("app1", "", 0),
+			expectError: ErrInvalidUsername(),
+		},
+		{
+			testName:    "invalid period",
+			msg:         NewDisputeUsageReportMsg("app1", "user1", -1),
+			expectError: ErrInvalidUsagePeriod(-1),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := map[string]struct {
 		msg              types.Msg
 		expectPermission types.Permission
 	}{
 		"provider report msg": {
-			msg:              NewProviderReportMsg("test", 1),
+			msg:              NewProviderReportMsg("test", nil),
+			expectPermission: types.TransactionPermission,
+		},
+		"provider register msg": {
+			msg:              NewProviderRegisterMsg("test", "1"),
+			expectPermission: types.TransactionPermission,
+		},
+		"provider revoke msg": {
+			msg:              NewProviderRevokeMsg("test"),
+			expectPermission: types.TransactionPermission,
+		},
+		"dispute usage report msg": {
+			msg:              NewDisputeUsageReportMsg("app", "test", 0),
 			expectPermission: types.TransactionPermission,
 		},
 	}
@@ -65,7 +182,16 @@ func TestGetSignBytes(t *testing.T) {
 		msg types.Msg
 	}{
 		"provider report msg": {
-			msg: NewProviderReportMsg("test", 1),
+			msg: NewProviderReportMsg("test", nil),
+		},
+		"provider register msg": {
+			msg: NewProviderRegisterMsg("test", "1"),
+		},
+		"provider revoke msg": {
+			msg: NewProviderRevokeMsg("test"),
+		},
+		"dispute usage report msg": {
+			msg: NewDisputeUsageReportMsg("app", "test", 0),
 		},
 	}
 
@@ -80,9 +206,21 @@ func TestGetSigners(t *testing.T) {
 		expectSigners []types.AccountKey
 	}{
 		"provider report msg": {
-			msg:           NewProviderReportMsg("test", 1),
+			msg:           NewProviderReportMsg("test", nil),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		"provider register msg": {
+			msg:           NewProviderRegisterMsg("test", "1"),
+			expectSigners: []types.AccountKey{"test"},
+		},
+		"provider revoke msg": {
+			msg:           NewProviderRevokeMsg("test"),
 			expectSigners: []types.AccountKey{"test"},
 		},
+		"dispute usage report msg": {
+			msg:           NewDisputeUsageReportMsg("app", "test", 0),
+			expectSigners: []types.AccountKey{"app"},
+		},
 	}
 
 	for testName, tc := range testCases {
@@ -1,6 +1,8 @@
 package infra
 
 import (
+	"strconv"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
@@ -19,6 +21,7 @@ const (
 
 	QueryInfraProvider = "infra"
 	QueryInfraList     = "infraList"
+	QueryUsageReports  = "usageReports"
 )
 
 // creates a querier for infra REST endpoints
@@ -31,6 +34,8 @@ func NewQuerier(im InfraManager) sdk.Querier {
 			return queryInfraProvider(ctx, cdc, path[1:], req, im)
 		case QueryInfraList:
 			return queryInfraList(ctx, cdc, path[1:], req, im)
+		case QueryUsageReports:
+			return queryUsageReports(ctx, cdc, path[1:], req, im)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown infra query endpoint")
 		}
@@ -63,3 +68,22 @@ func queryInfraList(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.Re
 	}
 	return res, nil
 }
+
+func queryUsageReports(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, im InfraManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 2); err != nil {
+		return nil, err
+	}
+	period, parseErr := strconv.ParseInt(path[1], 10, 64)
+	if parseErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	reports, err := im.GetUsageReports(ctx, types.AccountKey(path[0]), period)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(reports)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
@@ -6,22 +6,41 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/param"
+	"github.com/lino-network/lino/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	acc "github.com/lino-network/lino/x/account"
+	dev "github.com/lino-network/lino/x/developer"
+	global "github.com/lino-network/lino/x/global"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
 var (
-	testInfraKVStoreKey = sdk.NewKVStoreKey("infra")
-	testParamKVStoreKey = sdk.NewKVStoreKey("param")
+	testInfraKVStoreKey     = sdk.NewKVStoreKey("infra")
+	testParamKVStoreKey     = sdk.NewKVStoreKey("param")
+	testAccountKVStoreKey   = sdk.NewKVStoreKey("account")
+	testDeveloperKVStoreKey = sdk.NewKVStoreKey("developer")
+	testGlobalKVStoreKey    = sdk.NewKVStoreKey("global")
 )
 
-func setupTest(t *testing.T, height int64) (sdk.Context, InfraManager) {
+func setupTest(t *testing.T, height int64) (
+	sdk.Context, InfraManager, acc.AccountManager, dev.DeveloperManager, global.GlobalManager) {
 	ctx := getContext(height)
 	ph := param.NewParamHolder(testParamKVStoreKey)
 	ph.InitParam(ctx)
 	im := NewInfraManager(testInfraKVStoreKey, ph)
-	return ctx, im
+	am := acc.NewAccountManager(testAccountKVStoreKey, ph)
+	dm := dev.NewDeveloperManager(testDeveloperKVStoreKey, ph)
+	gm := global.NewGlobalManager(testGlobalKVStoreKey, ph)
+	cdc := gm.WireCodec()
+	err := gm.InitGlobalManager(ctx, types.NewCoinFromInt64(10000*types.Decimals))
+	assert.Nil(t, err)
+	cdc.RegisterInterface((*types.Event)(nil), nil)
+	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "event/return", nil)
+	return ctx, im, am, dm, gm
 }
 
 func getContext(height int64) sdk.Context {
@@ -29,7 +48,19 @@ func getContext(height int64) sdk.Context {
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(testInfraKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(testParamKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(testAccountKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(testDeveloperKVStoreKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(testGlobalKVStoreKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 
 	return sdk.NewContext(ms, abci.Header{Height: height}, false, log.NewNopLogger())
 }
+
+// helper function to create an account for testing purpose, return app private key
+func createTestAccount(
+	ctx sdk.Context, am acc.AccountManager, username string, initCoin types.Coin) secp256k1.PrivKeySecp256k1 {
+	appPriv := secp256k1.GenPrivKey()
+	am.CreateAccount(ctx, "referrer", types.AccountKey(username),
+		secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey(), appPriv.PubKey(), initCoin)
+	return appPriv
+}
@@ -7,10 +7,14 @@ import (
 // RegisterWire - register concrete types on wire codec
 func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(ProviderReportMsg{}, "lino/providerReport", nil)
+	cdc.RegisterConcrete(ProviderRegisterMsg{}, "lino/providerRegister", nil)
+	cdc.RegisterConcrete(ProviderRevokeMsg{}, "lino/providerRevoke", nil)
+	cdc.RegisterConcrete(DisputeUsageReportMsg{}, "lino/disputeUsageReport", nil)
 }
 
 var msgCdc = wire.New()
 
 func init() {
 	RegisterWire(msgCdc)
+	wire.RegisterCrypto(msgCdc)
 }
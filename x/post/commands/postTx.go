@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/client"
@@ -24,11 +25,15 @@ func PostTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagPostID, "", "post id to identify this post for the author")
 	cmd.Flags().String(client.FlagTitle, "", "title for the post")
 	cmd.Flags().String(client.FlagContent, "", "content for the post")
+	cmd.Flags().String(client.FlagContentHash, "", "hex encoded sha256 of content stored off chain")
+	cmd.Flags().String(client.FlagContentURI, "", "storage uri of content stored off chain")
 	cmd.Flags().String(client.FlagParentAuthor, "", "parent post author name")
 	cmd.Flags().String(client.FlagParentPostID, "", "parent post id")
 	cmd.Flags().String(client.FlagSourceAuthor, "", "source post author name")
 	cmd.Flags().String(client.FlagSourcePostID, "", "source post id")
 	cmd.Flags().String(client.FlagRedistributionSplitRate, "0", "redistribution split rate")
+	cmd.Flags().String(client.FlagBeneficiaries, "", "beneficiaries and weights, e.g. user1:0.5,user2:0.5")
+	cmd.Flags().String(client.FlagTags, "", "tags of the post, e.g. gaming,music")
 	return cmd
 }
 
@@ -37,16 +42,33 @@ func sendPostTx(cdc *wire.Codec) client.CommandTxCallback {
 	return func(cmd *cobra.Command, args []string) error {
 		ctx := client.NewCoreContextFromViper()
 		author := viper.GetString(client.FlagAuthor)
+		beneficiaries := []types.Beneficiary{}
+		if beneficiaryStr := viper.GetString(client.FlagBeneficiaries); beneficiaryStr != "" {
+			for _, pair := range strings.Split(beneficiaryStr, ",") {
+				strs := strings.Split(pair, ":")
+				if len(strs) != 2 {
+					return fmt.Errorf("invalid beneficiary: %s", pair)
+				}
+				beneficiaries = append(beneficiaries, types.Beneficiary{
+					Username: types.AccountKey(strs[0]),
+					Weight:   strs[1],
+				})
+			}
+		}
 		msg := post.CreatePostMsg{
 			Author:                  types.AccountKey(author),
 			PostID:                  viper.GetString(client.FlagPostID),
 			Title:                   viper.GetString(client.FlagTitle),
 			Content:                 viper.GetString(client.FlagContent),
+			ContentHash:             viper.GetString(client.FlagContentHash),
+			ContentURI:              viper.GetString(client.FlagContentURI),
 			ParentAuthor:            types.AccountKey(viper.GetString(client.FlagParentAuthor)),
 			ParentPostID:            viper.GetString(client.FlagParentPostID),
 			SourceAuthor:            types.AccountKey(viper.GetString(client.FlagSourceAuthor)),
 			SourcePostID:            viper.GetString(client.FlagSourcePostID),
 			RedistributionSplitRate: viper.GetString(client.FlagRedistributionSplitRate),
+			Tags:                    getTags(viper.GetString(client.FlagTags)),
+			Beneficiaries:           beneficiaries,
 		}
 
 		// build and sign the transaction, then broadcast to Tendermint
@@ -60,3 +82,15 @@ func sendPostTx(cdc *wire.Codec) client.CommandTxCallback {
 		return nil
 	}
 }
+
+// getTags - parse comma separated tags and normalize them
+func getTags(tagStr string) []string {
+	tags := []string{}
+	if tagStr == "" {
+		return tags
+	}
+	for _, tag := range strings.Split(tagStr, ",") {
+		tags = append(tags, post.NormalizeTag(tag))
+	}
+	return tags
+}
@@ -1,12 +1,16 @@
 package commands
 
 import (
+	"fmt"
+	"io/ioutil"
+
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	post "github.com/lino-network/lino/x/post"
 	"github.com/lino-network/lino/x/post/model"
 )
 
@@ -107,3 +111,303 @@ func (c commander) getPostsCmd(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+// GetBeneficiaryRewardsCmd - returns income of each beneficiary from the post
+func GetBeneficiaryRewardsCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "post-beneficiary-rewards <author> <postID>",
+		Short: "Query income of each beneficiary from a post",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+				return errors.New("You must provide an valid author and post id")
+			}
+			permlink := types.GetPermlink(types.AccountKey(args[0]), args[1])
+
+			res, err := ctx.QueryCustom(post.QuerierRoute, post.QueryBeneficiaryRewards, string(permlink))
+			if err != nil {
+				return err
+			}
+			rewards := []model.BeneficiaryReward{}
+			if err := cdc.UnmarshalJSON(res, &rewards); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(rewards); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetPostRevisionsCmd - returns previous bodies of the post
+func GetPostRevisionsCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "post-revisions <author> <postID>",
+		Short: "Query revision history of a post",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+				return errors.New("You must provide an valid author and post id")
+			}
+			permlink := types.GetPermlink(types.AccountKey(args[0]), args[1])
+
+			res, err := ctx.QueryCustom(post.QuerierRoute, post.QueryPostRevisions, string(permlink))
+			if err != nil {
+				return err
+			}
+			revisions := []model.Revision{}
+			if err := cdc.UnmarshalJSON(res, &revisions); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(revisions); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetDonationsCmd - returns refundable donation records of a donor to the post
+func GetDonationsCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "donations <author> <postID> <donor>",
+		Short: "Query donation records of a donor to a post",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 3 || len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 {
+				return errors.New("You must provide an valid author, post id and donor")
+			}
+			permlink := types.GetPermlink(types.AccountKey(args[0]), args[1])
+
+			res, err := ctx.QueryCustom(post.QuerierRoute, post.QueryDonations, string(permlink), args[2])
+			if err != nil {
+				return err
+			}
+			donations := []model.Donation{}
+			if err := cdc.UnmarshalJSON(res, &donations); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(donations); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetPostReportOrUpvotesCmd - returns all reports and upvotes to the post
+func GetPostReportOrUpvotesCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "post-report-or-upvotes <author> <postID>",
+		Short: "Query who reported or upvoted a post",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+				return errors.New("You must provide an valid author and post id")
+			}
+			permlink := types.GetPermlink(types.AccountKey(args[0]), args[1])
+
+			res, err := ctx.QueryCustom(post.QuerierRoute, post.QueryPostReportOrUpvotes, string(permlink))
+			if err != nil {
+				return err
+			}
+			reportOrUpvotes := []model.ReportOrUpvote{}
+			if err := cdc.UnmarshalJSON(res, &reportOrUpvotes); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(reportOrUpvotes); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetUserReportOrUpvotesCmd - returns all reports and upvotes made by the user
+func GetUserReportOrUpvotesCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "user-report-or-upvotes <username>",
+		Short: "Query posts a user reported or upvoted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 1 || len(args[0]) == 0 {
+				return errors.New("You must provide an valid username")
+			}
+
+			res, err := ctx.QueryCustom(post.QuerierRoute, post.QueryUserReportOrUpvotes, args[0])
+			if err != nil {
+				return err
+			}
+			reportOrUpvotes := []model.UserReportOrUpvote{}
+			if err := cdc.UnmarshalJSON(res, &reportOrUpvotes); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(reportOrUpvotes); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetPostPendingRewardsCmd - returns reward events of the post not executed yet
+func GetPostPendingRewardsCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "post-pending-rewards <author> <postID>",
+		Short: "Query pending rewards of a post with estimated amount",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 2 || len(args[0]) == 0 || len(args[1]) == 0 {
+				return errors.New("You must provide an valid author and post id")
+			}
+			permlink := types.GetPermlink(types.AccountKey(args[0]), args[1])
+
+			res, err := ctx.QueryCustom(post.QuerierRoute, post.QueryPostPendingRewards, string(permlink))
+			if err != nil {
+				return err
+			}
+			pendingRewards := new(post.PendingRewards)
+			if err := cdc.UnmarshalJSON(res, pendingRewards); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(pendingRewards); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetAuthorPendingRewardsCmd - returns reward events of all posts of the author not executed yet
+func GetAuthorPendingRewardsCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "author-pending-rewards <author>",
+		Short: "Query pending rewards of all posts of an author with estimated amount",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 1 || len(args[0]) == 0 {
+				return errors.New("You must provide an valid author")
+			}
+
+			res, err := ctx.QueryCustom(post.QuerierRoute, post.QueryAuthorPendingRewards, args[0])
+			if err != nil {
+				return err
+			}
+			pendingRewards := new(post.PendingRewards)
+			if err := cdc.UnmarshalJSON(res, pendingRewards); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(pendingRewards); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// VerifyPostCmd - checks a local file against the content hash of the post on chain
+func VerifyPostCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify <author> <postID> <file>",
+		Short: "Verify local content file against content hash of a post",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 3 || len(args[0]) == 0 || len(args[1]) == 0 || len(args[2]) == 0 {
+				return errors.New("You must provide an valid author, post id and file")
+			}
+			permlink := types.GetPermlink(types.AccountKey(args[0]), args[1])
+
+			content, err := ioutil.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			res, err := ctx.QueryCustom(post.QuerierRoute, post.QueryPostInfo, string(permlink))
+			if err != nil {
+				return err
+			}
+			postInfo := new(model.PostInfo)
+			if err := cdc.UnmarshalJSON(res, postInfo); err != nil {
+				return err
+			}
+
+			// post with inline content is verified against hash of its content
+			expected := postInfo.ContentHash
+			if expected == "" {
+				expected = model.HashContent([]byte(postInfo.Content))
+			}
+			if actual := model.HashContent(content); actual != expected {
+				return errors.Errorf("content hash mismatch, on chain %s, local %s", expected, actual)
+			}
+			fmt.Printf("Verified. Content hash: %s\n", expected)
+			return nil
+		},
+	}
+}
+
+// GetTagPostsCmd - returns posts with the tag ordered by created time
+func GetTagPostsCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tag-posts <tag> [offset] [limit]",
+		Short: "Query posts with a tag",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) < 1 || len(args) > 3 || len(args[0]) == 0 {
+				return errors.New("You must provide an valid tag")
+			}
+			path := append([]string{post.QueryTagPosts, post.NormalizeTag(args[0])}, args[1:]...)
+
+			res, err := ctx.QueryCustom(post.QuerierRoute, path...)
+			if err != nil {
+				return err
+			}
+			tagPosts := []model.TagPost{}
+			if err := cdc.UnmarshalJSON(res, &tagPosts); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(tagPosts); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetCommentTreeCmd - returns comments at a level of the thread of a root post
+func GetCommentTreeCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "comment-tree <author> <postID> <level> [offset] [limit]",
+		Short: "Query comments at a level of the thread of a post",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) < 3 || len(args) > 5 || len(args[0]) == 0 || len(args[1]) == 0 {
+				return errors.New("You must provide an valid author, post id and level")
+			}
+			permlink := types.GetPermlink(types.AccountKey(args[0]), args[1])
+			path := append([]string{post.QueryCommentTree, string(permlink)}, args[2:]...)
+
+			res, err := ctx.QueryCustom(post.QuerierRoute, path...)
+			if err != nil {
+				return err
+			}
+			nodes := []post.CommentNode{}
+			if err := cdc.UnmarshalJSON(res, &nodes); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(nodes); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
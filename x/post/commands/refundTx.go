@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"fmt"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lino-network/lino/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	post "github.com/lino-network/lino/x/post"
+)
+
+// RefundDonationTxCmd will create a refund donation tx and sign it with the given key
+func RefundDonationTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-donation",
+		Short: "refund a donation within the refund window",
+		RunE:  sendRefundDonationTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "donor or app of the donation who signs this transaction")
+	cmd.Flags().String(client.FlagDonator, "", "donator of the donation")
+	cmd.Flags().String(client.FlagAuthor, "", "author of the target post")
+	cmd.Flags().String(client.FlagPostID, "", "post id of the target post")
+	cmd.Flags().Int64(client.FlagDonationID, 0, "id of the donation to refund")
+	return cmd
+}
+
+// send refund donation transaction to the blockchain
+func sendRefundDonationTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := post.NewRefundDonationMsg(
+			viper.GetString(client.FlagUser), viper.GetString(client.FlagDonator),
+			viper.GetString(client.FlagAuthor), viper.GetString(client.FlagPostID),
+			viper.GetInt64(client.FlagDonationID))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"fmt"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	"github.com/lino-network/lino/client"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	post "github.com/lino-network/lino/x/post"
+)
+
+// UpdateAllowRepliesTxCmd allows or disallows comments to a post and sign it with the given key
+func UpdateAllowRepliesTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "post-replies",
+		Short: "allow or disallow comments to a post",
+		RunE:  sendUpdateAllowRepliesTx(cdc),
+	}
+	cmd.Flags().String(client.FlagAuthor, "", "author of this post")
+	cmd.Flags().String(client.FlagPostID, "", "post id to identify this post for the author")
+	cmd.Flags().Bool(client.FlagAllowReplies, true, "allow comments to this post")
+	return cmd
+}
+
+// send update allow replies transaction to the blockchain
+func sendUpdateAllowRepliesTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		msg := post.NewUpdateAllowRepliesMsg(
+			viper.GetString(client.FlagAuthor), viper.GetString(client.FlagPostID),
+			viper.GetBool(client.FlagAllowReplies))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
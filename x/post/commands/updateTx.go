@@ -24,6 +24,9 @@ func UpdatePostTxCmd(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(client.FlagPostID, "", "post id to identify this post for the author")
 	cmd.Flags().String(client.FlagTitle, "", "title for the post")
 	cmd.Flags().String(client.FlagContent, "", "content for the post")
+	cmd.Flags().String(client.FlagContentHash, "", "hex encoded sha256 of content stored off chain")
+	cmd.Flags().String(client.FlagContentURI, "", "storage uri of content stored off chain")
+	cmd.Flags().String(client.FlagTags, "", "tags of the post, e.g. gaming,music")
 	return cmd
 }
 
@@ -36,6 +39,9 @@ func sendUpdatePostTx(cdc *wire.Codec) client.CommandTxCallback {
 			viper.GetString(client.FlagAuthor), viper.GetString(client.FlagPostID),
 			viper.GetString(client.FlagTitle), viper.GetString(client.FlagContent),
 			[]types.IDToURLMapping(nil))
+		msg.ContentHash = viper.GetString(client.FlagContentHash)
+		msg.ContentURI = viper.GetString(client.FlagContentURI)
+		msg.Tags = getTags(viper.GetString(client.FlagTags))
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
@@ -2,6 +2,8 @@ package commands
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/client"
@@ -46,3 +48,47 @@ func sendViewTx(cdc *wire.Codec) client.CommandTxCallback {
 		return nil
 	}
 }
+
+// BatchViewTxCmd will create a batch view tx and sign it with the app key
+func BatchViewTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-view",
+		Short: "report views of many users from an app",
+		RunE:  sendBatchViewTx(cdc),
+	}
+	cmd.Flags().String(client.FlagApp, "", "app reporting the views")
+	cmd.Flags().String(client.FlagViews, "", "views, e.g. user1:author:postID:10,user2:author:postID:3")
+	return cmd
+}
+
+// send batch view transaction to the blockchain
+func sendBatchViewTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		app := viper.GetString(client.FlagApp)
+
+		views := []post.BatchView{}
+		for _, view := range strings.Split(viper.GetString(client.FlagViews), ",") {
+			strs := strings.Split(view, ":")
+			if len(strs) != 4 {
+				return fmt.Errorf("invalid view: %s", view)
+			}
+			count, err := strconv.ParseInt(strs[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid view count: %s", view)
+			}
+			views = append(views, post.NewBatchView(strs[0], strs[1], strs[2], count))
+		}
+		msg := post.NewBatchViewMsg(app, views)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
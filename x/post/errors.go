@@ -152,3 +152,98 @@ func ErrInvalidMemo() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodePostQueryFailed, fmt.Sprintf("query post store failed"))
 }
+
+// ErrInvalidBeneficiary - error when beneficiary is invalid or duplicated
+func ErrInvalidBeneficiary(beneficiary types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeInvalidBeneficiary, fmt.Sprintf("beneficiary %v is invalid", beneficiary))
+}
+
+// ErrTooManyBeneficiaries - error when posting with too many beneficiaries
+func ErrTooManyBeneficiaries() sdk.Error {
+	return types.NewError(types.CodeTooManyBeneficiaries, fmt.Sprintf("too many beneficiaries"))
+}
+
+// ErrInvalidBeneficiaryWeight - error when beneficiary weight is invalid or weights don't sum to one
+func ErrInvalidBeneficiaryWeight() sdk.Error {
+	return types.NewError(types.CodeInvalidBeneficiaryWeight, fmt.Sprintf("beneficiary weights are invalid"))
+}
+
+// ErrInvalidBatchView - error when batch view is empty or view count is invalid
+func ErrInvalidBatchView() sdk.Error {
+	return types.NewError(types.CodeInvalidBatchView, fmt.Sprintf("invalid batch view"))
+}
+
+// ErrTooManyBatchViews - error when batch view msg contains too many views
+func ErrTooManyBatchViews() sdk.Error {
+	return types.NewError(types.CodeTooManyBatchViews, fmt.Sprintf("too many views in batch"))
+}
+
+// ErrBatchViewTooOften - error when app reports batch view too often
+func ErrBatchViewTooOften(app types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeBatchViewTooOften, fmt.Sprintf("%v batch view too often", app))
+}
+
+// ErrBatchViewCountExceedLimit - error when total view count of a batch exceeds limit
+func ErrBatchViewCountExceedLimit(count int64) sdk.Error {
+	return types.NewError(types.CodeBatchViewCountExceedLimit, fmt.Sprintf("batch view count %v exceeds limit", count))
+}
+
+// ErrInvalidContentHash - error when content hash is not a hex encoded sha256 or has no storage uri
+func ErrInvalidContentHash() sdk.Error {
+	return types.NewError(types.CodeInvalidContentHash, fmt.Sprintf("invalid content hash"))
+}
+
+// ErrContentURITooLong - error when content storage uri is too long
+func ErrContentURITooLong() sdk.Error {
+	return types.NewError(types.CodeContentURITooLong, fmt.Sprintf("content uri is too long"))
+}
+
+// ErrContentAndContentHashConflict - error when post carries both inline content and content hash
+func ErrContentAndContentHashConflict() sdk.Error {
+	return types.NewError(types.CodeContentAndContentHashConflict, fmt.Sprintf("post can't have both content and content hash"))
+}
+
+// ErrInvalidTag - error when tag is empty, too long, not normalized or duplicated
+func ErrInvalidTag(tag string) sdk.Error {
+	return types.NewError(types.CodeInvalidTag, fmt.Sprintf("invalid tag %v", tag))
+}
+
+// ErrTooManyTags - error when post has too many tags
+func ErrTooManyTags() sdk.Error {
+	return types.NewError(types.CodeTooManyTags, fmt.Sprintf("too many tags"))
+}
+
+// ErrDonationRefundWindowExpired - error when donation is refunded after refund window
+func ErrDonationRefundWindowExpired(donationID int64) sdk.Error {
+	return types.NewError(types.CodeDonationRefundWindowExpired, fmt.Sprintf("refund window of donation %v expired", donationID))
+}
+
+// ErrDonationAlreadyRefunded - error when donation has been refunded
+func ErrDonationAlreadyRefunded(donationID int64) sdk.Error {
+	return types.NewError(types.CodeDonationAlreadyRefunded, fmt.Sprintf("donation %v already refunded", donationID))
+}
+
+// ErrRefundDonationNotAllowed - error when refund is sent by neither donor nor the app donation from
+func ErrRefundDonationNotAllowed(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeRefundDonationNotAllowed, fmt.Sprintf("%v can't refund the donation", username))
+}
+
+// ErrInvalidDonationID - error when donation id is not positive
+func ErrInvalidDonationID() sdk.Error {
+	return types.NewError(types.CodeInvalidDonationID, fmt.Sprintf("invalid donation id"))
+}
+
+// ErrRepliesNotAllowed - error when commenting on a post which disallows replies
+func ErrRepliesNotAllowed(permlink types.Permlink) sdk.Error {
+	return types.NewError(types.CodeRepliesNotAllowed, fmt.Sprintf("post %v doesn't allow replies", permlink))
+}
+
+// ErrCommentTooDeep - error when comment exceeds maximum thread depth
+func ErrCommentTooDeep(depth int64) sdk.Error {
+	return types.NewError(types.CodeCommentTooDeep, fmt.Sprintf("comment depth %v exceeds maximum depth", depth))
+}
+
+// ErrCommentTreeTooLarge - error when comment tree query walks too many comments
+func ErrCommentTreeTooLarge(root types.Permlink) sdk.Error {
+	return types.NewError(types.CodeCommentTreeTooLarge, fmt.Sprintf("comment tree of %v is too large to query", root))
+}
@@ -8,6 +8,7 @@ import (
 	acc "github.com/lino-network/lino/x/account"
 	dev "github.com/lino-network/lino/x/developer"
 	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/post/model"
 	rep "github.com/lino-network/lino/x/reputation"
 	vote "github.com/lino-network/lino/x/vote"
 )
@@ -17,6 +18,7 @@ func init() {
 
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(RewardEvent{}, "event/reward", nil)
+	cdc.RegisterConcrete(ExpireDonationEvent{}, "event/expireDonation", nil)
 }
 
 // RewardEvent - when donation occurred, a reward event will be register
@@ -39,22 +41,36 @@ func (event RewardEvent) Execute(
 	vm vote.VoteManager, rm rep.ReputationManager) sdk.Error {
 
 	permlink := types.GetPermlink(event.PostAuthor, event.PostID)
-	// check if post is deleted
-	rep, err := rm.GetSumRep(ctx, permlink)
+	paneltyScore, err := getPostPenaltyScore(ctx, pm, rm, permlink)
 	if err != nil {
 		return err
 	}
-	paneltyScore, err := pm.GetPenaltyScore(ctx, rep)
-	if err != nil {
-		return err
-	}
-	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
-		paneltyScore = sdk.OneDec()
+	// reward lost to censorship is estimated before the window changes,
+	// it stays in reward pool and is paid back if the censorship is overturned.
+	lostReward := types.NewCoinFromInt64(0)
+	if pm.IsCensoredPost(ctx, permlink) {
+		repPenaltyScore, err := getReputationPenaltyScore(ctx, pm, rm, permlink)
+		if err != nil {
+			return err
+		}
+		lostReward, err = gm.EstimateReward(ctx, event.Evaluate, repPenaltyScore)
+		if err != nil {
+			return err
+		}
 	}
 	reward, err := gm.GetRewardAndPopFromWindow(ctx, event.Evaluate, paneltyScore)
 	if err != nil {
 		return err
 	}
+	if lostReward.IsPositive() {
+		if err := pm.AddLostReward(ctx, permlink, model.LostReward{
+			Consumer: event.Consumer,
+			Evaluate: event.Evaluate,
+			Reward:   lostReward,
+		}); err != nil {
+			return err
+		}
+	}
 	// if developer exist, add to developer consumption
 	if dm.DoesDeveloperExist(ctx, event.FromApp) {
 		dm.ReportConsumption(ctx, event.FromApp, reward)
@@ -70,9 +86,104 @@ func (event RewardEvent) Execute(
 		return err
 	}
 
-	if err := am.AddIncomeAndReward(
-		ctx, event.PostAuthor, event.Original, event.Friction, reward, event.Consumer, event.PostAuthor, event.PostID); err != nil {
+	// original donation, friction and reward are split among post beneficiaries
+	beneficiaries, rewardShares, err := pm.GetBeneficiaryShares(ctx, permlink, reward)
+	if err != nil {
+		return err
+	}
+	_, originalShares, err := pm.GetBeneficiaryShares(ctx, permlink, event.Original)
+	if err != nil {
 		return err
 	}
+	_, frictionShares, err := pm.GetBeneficiaryShares(ctx, permlink, event.Friction)
+	if err != nil {
+		return err
+	}
+	for i, beneficiary := range beneficiaries {
+		if err := am.AddIncomeAndReward(
+			ctx, beneficiary, originalShares[i], frictionShares[i], rewardShares[i],
+			event.Consumer, event.PostAuthor, event.PostID); err != nil {
+			return err
+		}
+		if err := pm.AddBeneficiaryReward(
+			ctx, permlink, beneficiary, types.NewCoinFromInt64(0), rewardShares[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExpireDonationEvent - when a donation is kept for refund, an expire event is
+// registered at the end of its refund window to delete the donation record.
+type ExpireDonationEvent struct {
+	Permlink   types.Permlink   `json:"permlink"`
+	Donor      types.AccountKey `json:"donor"`
+	DonationID int64            `json:"donation_id"`
+}
+
+// Execute - execute expire donation event, the donation can't be refunded any more
+func (event ExpireDonationEvent) Execute(ctx sdk.Context, pm PostManager) sdk.Error {
+	pm.DeleteDonationRecord(ctx, event.Permlink, event.Donor, event.DonationID)
 	return nil
 }
+
+// PayLostRewards - pay rewards a censored post lost to censorship penalty back to
+// beneficiaries of the post once the censorship is overturned
+func PayLostRewards(
+	ctx sdk.Context, pm PostManager, am acc.AccountManager, gm *global.GlobalManager,
+	permlink types.Permlink, lostRewards []model.LostReward) sdk.Error {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	for _, lost := range lostRewards {
+		reward, err := gm.PayLostContentReward(ctx, lost.Reward)
+		if err != nil {
+			return err
+		}
+		if err := pm.AddDonation(ctx, permlink, lost.Consumer, reward, types.Inflation); err != nil {
+			return err
+		}
+		beneficiaries, rewardShares, err := pm.GetBeneficiaryShares(ctx, permlink, reward)
+		if err != nil {
+			return err
+		}
+		for i, beneficiary := range beneficiaries {
+			if err := am.AddIncomeAndReward(
+				ctx, beneficiary, types.NewCoinFromInt64(0), types.NewCoinFromInt64(0), rewardShares[i],
+				lost.Consumer, postInfo.Author, postInfo.PostID); err != nil {
+				return err
+			}
+			if err := pm.AddBeneficiaryReward(
+				ctx, permlink, beneficiary, types.NewCoinFromInt64(0), rewardShares[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getReputationPenaltyScore - penalty score of a post from its sum of reputation
+func getReputationPenaltyScore(
+	ctx sdk.Context, pm PostManager, rm rep.ReputationManager, permlink types.Permlink) (sdk.Dec, sdk.Error) {
+	sumRep, err := rm.GetSumRep(ctx, permlink)
+	if err != nil {
+		return sdk.OneDec(), err
+	}
+	return pm.GetPenaltyScore(ctx, sumRep)
+}
+
+// getPostPenaltyScore - penalty score of a post from its sum of reputation,
+// deleted post gets full penalty
+func getPostPenaltyScore(
+	ctx sdk.Context, pm PostManager, rm rep.ReputationManager, permlink types.Permlink) (sdk.Dec, sdk.Error) {
+	penaltyScore, err := getReputationPenaltyScore(ctx, pm, rm, permlink)
+	if err != nil {
+		return sdk.OneDec(), err
+	}
+	// check if post is deleted
+	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
+		penaltyScore = sdk.OneDec()
+	}
+	return penaltyScore, nil
+}
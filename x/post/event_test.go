@@ -2,6 +2,7 @@ package post
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	"github.com/stretchr/testify/assert"
@@ -10,6 +11,7 @@ import (
 	accModel "github.com/lino-network/lino/x/account/model"
 	globalModel "github.com/lino-network/lino/x/global/model"
 	postModel "github.com/lino-network/lino/x/post/model"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestRewardEvent(t *testing.T) {
@@ -197,3 +199,272 @@ func TestRewardEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestRewardEventWithBeneficiaries(t *testing.T) {
+	ctx, am, _, pm, gm, dm, vm, rm := setupTest(t, 1)
+	gs := globalModel.NewGlobalStorage(testGlobalKVStoreKey)
+	as := accModel.NewAccountStorage(testAccountKVStoreKey)
+
+	user1 := createTestAccount(t, ctx, am, "user1")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	user3 := createTestAccount(t, ctx, am, "user3")
+	err := pm.CreatePost(
+		ctx, user1, "cohosted", "", "", "", "",
+		string(make([]byte, 1000)), string(make([]byte, 50)), "", "",
+		sdk.ZeroDec(), []types.IDToURLMapping{},
+		[]types.Beneficiary{{Username: user1, Weight: "0.6"}, {Username: user2, Weight: "0.4"}}, nil)
+	assert.Nil(t, err)
+
+	gs.SetConsumptionMeta(ctx, &globalModel.ConsumptionMeta{
+		ConsumptionRewardPool: types.NewCoinFromInt64(100),
+		ConsumptionWindow:     types.NewCoinFromInt64(100),
+	})
+	for _, user := range []types.AccountKey{user1, user2} {
+		as.SetReward(ctx, user, &accModel.Reward{})
+	}
+	event := RewardEvent{
+		PostAuthor: user1,
+		PostID:     "cohosted",
+		Consumer:   user3,
+		Evaluate:   types.NewCoinFromInt64(100),
+		Original:   types.NewCoinFromInt64(100),
+		Friction:   types.NewCoinFromInt64(15),
+		FromApp:    "",
+	}
+	err = event.Execute(ctx, pm, am, &gm, dm, vm, rm)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName     string
+		beneficiary  types.AccountKey
+		expectReward accModel.Reward
+	}{
+		{
+			testName:    "first beneficiary",
+			beneficiary: user1,
+			expectReward: accModel.Reward{
+				TotalIncome:     types.NewCoinFromInt64(60),
+				OriginalIncome:  types.NewCoinFromInt64(9),
+				FrictionIncome:  types.NewCoinFromInt64(9),
+				InflationIncome: types.NewCoinFromInt64(60),
+				UnclaimReward:   types.NewCoinFromInt64(60),
+			},
+		},
+		{
+			testName:    "last beneficiary",
+			beneficiary: user2,
+			expectReward: accModel.Reward{
+				TotalIncome:     types.NewCoinFromInt64(40),
+				OriginalIncome:  types.NewCoinFromInt64(6),
+				FrictionIncome:  types.NewCoinFromInt64(6),
+				InflationIncome: types.NewCoinFromInt64(40),
+				UnclaimReward:   types.NewCoinFromInt64(40),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		reward, err := as.GetReward(ctx, tc.beneficiary)
+		if err != nil {
+			t.Errorf("%s: failed to get reward, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectReward, *reward) {
+			t.Errorf("%s: diff reward, got %v, want %v", tc.testName, *reward, tc.expectReward)
+		}
+	}
+
+	rewards, err := pm.GetBeneficiaryRewards(ctx, types.GetPermlink(user1, "cohosted"))
+	assert.Nil(t, err)
+	assert.Equal(t, []postModel.BeneficiaryReward{
+		{Username: user1, DirectDeposit: types.NewCoinFromInt64(0), Inflation: types.NewCoinFromInt64(60)},
+		{Username: user2, DirectDeposit: types.NewCoinFromInt64(0), Inflation: types.NewCoinFromInt64(40)},
+	}, rewards)
+}
+
+func TestGetPendingRewards(t *testing.T) {
+	ctx, am, _, pm, gm, _, _, rm := setupTest(t, 1)
+	gs := globalModel.NewGlobalStorage(testGlobalKVStoreKey)
+
+	user, postID1 := createTestPost(t, ctx, "user", "postID1", am, pm, "0")
+	_, postID2 := createTestPost(t, ctx, "user", "postID2", am, pm, "0")
+	user2, postID3 := createTestPost(t, ctx, "user2", "postID3", am, pm, "0")
+	consumer := createTestAccount(t, ctx, am, "consumer")
+	consumptionMeta, err := gs.GetConsumptionMeta(ctx)
+	assert.Nil(t, err)
+	scheduledAt := ctx.BlockHeader().Time.Unix() + consumptionMeta.ConsumptionFreezingPeriodSec
+
+	for _, target := range []struct {
+		author types.AccountKey
+		postID string
+	}{{user, postID1}, {user, postID2}, {user2, postID3}} {
+		event := RewardEvent{
+			PostAuthor: target.author,
+			PostID:     target.postID,
+			Consumer:   consumer,
+			Evaluate:   types.NewCoinFromInt64(100),
+			Original:   types.NewCoinFromInt64(100),
+			Friction:   types.NewCoinFromInt64(15),
+		}
+		err := gm.AddFrictionAndRegisterContentRewardEvent(ctx, event, event.Friction, event.Evaluate)
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, gm.CommitEventCache(ctx))
+	gs.SetConsumptionMeta(ctx, &globalModel.ConsumptionMeta{
+		ConsumptionRewardPool:        types.NewCoinFromInt64(300),
+		ConsumptionWindow:            types.NewCoinFromInt64(300),
+		ConsumptionFreezingPeriodSec: consumptionMeta.ConsumptionFreezingPeriodSec,
+	})
+
+	testCases := []struct {
+		testName      string
+		match         func(event RewardEvent) bool
+		expectRewards []PendingReward
+		expectTotal   types.Coin
+	}{
+		{
+			testName: "pending rewards of a post",
+			match: func(event RewardEvent) bool {
+				return types.GetPermlink(event.PostAuthor, event.PostID) == types.GetPermlink(user, postID1)
+			},
+			expectRewards: []PendingReward{
+				{
+					Permlink:        types.GetPermlink(user, postID1),
+					Consumer:        consumer,
+					Evaluate:        types.NewCoinFromInt64(100),
+					Friction:        types.NewCoinFromInt64(15),
+					ScheduledAt:     scheduledAt,
+					EstimatedReward: types.NewCoinFromInt64(100),
+				},
+			},
+			expectTotal: types.NewCoinFromInt64(100),
+		},
+		{
+			testName: "pending rewards of an author",
+			match: func(event RewardEvent) bool {
+				return event.PostAuthor == user
+			},
+			expectRewards: []PendingReward{
+				{
+					Permlink:        types.GetPermlink(user, postID1),
+					Consumer:        consumer,
+					Evaluate:        types.NewCoinFromInt64(100),
+					Friction:        types.NewCoinFromInt64(15),
+					ScheduledAt:     scheduledAt,
+					EstimatedReward: types.NewCoinFromInt64(100),
+				},
+				{
+					Permlink:        types.GetPermlink(user, postID2),
+					Consumer:        consumer,
+					Evaluate:        types.NewCoinFromInt64(100),
+					Friction:        types.NewCoinFromInt64(15),
+					ScheduledAt:     scheduledAt,
+					EstimatedReward: types.NewCoinFromInt64(100),
+				},
+			},
+			expectTotal: types.NewCoinFromInt64(200),
+		},
+		{
+			testName: "no pending rewards",
+			match: func(event RewardEvent) bool {
+				return event.PostAuthor == consumer
+			},
+			expectRewards: []PendingReward{},
+			expectTotal:   types.NewCoinFromInt64(0),
+		},
+	}
+
+	for _, tc := range testCases {
+		pendingRewards, err := getPendingRewards(ctx, pm, &gm, rm, tc.match)
+		if err != nil {
+			t.Errorf("%s: failed to get pending rewards, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectRewards, pendingRewards.Rewards) {
+			t.Errorf("%s: diff pending rewards, got %v, want %v", tc.testName, pendingRewards.Rewards, tc.expectRewards)
+		}
+		if !tc.expectTotal.IsEqual(pendingRewards.TotalEstimatedReward) {
+			t.Errorf("%s: diff total estimated reward, got %v, want %v", tc.testName, pendingRewards.TotalEstimatedReward, tc.expectTotal)
+		}
+	}
+}
+
+func TestCensoredPostPenaltyScore(t *testing.T) {
+	ctx, am, _, pm, _, _, _, rm := setupTest(t, 1)
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	permlink := types.GetPermlink(user, postID)
+	score, err := getPostPenaltyScore(ctx, pm, rm, permlink)
+	assert.Nil(t, err)
+	assert.True(t, sdk.ZeroDec().Equal(score))
+
+	// pending reward of censored post gets full penalty
+	err = pm.CensorPost(ctx, permlink)
+	assert.Nil(t, err)
+	score, err = getPostPenaltyScore(ctx, pm, rm, permlink)
+	assert.Nil(t, err)
+	assert.True(t, sdk.OneDec().Equal(score))
+
+	// penalty is undone once censorship is overturned
+	_, err = pm.RestoreCensoredContent(ctx, permlink, 0)
+	assert.Nil(t, err)
+	score, err = getPostPenaltyScore(ctx, pm, rm, permlink)
+	assert.Nil(t, err)
+	assert.True(t, sdk.ZeroDec().Equal(score))
+}
+
+func TestCensoredPostLostReward(t *testing.T) {
+	ctx, am, _, pm, gm, dm, vm, rm := setupTest(t, 1)
+	gs := globalModel.NewGlobalStorage(testGlobalKVStoreKey)
+	as := accModel.NewAccountStorage(testAccountKVStoreKey)
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	user1 := createTestAccount(t, ctx, am, "user1")
+	permlink := types.GetPermlink(user, postID)
+	freezingPeriodSec := int64(7 * 24 * 3600)
+	gs.SetConsumptionMeta(ctx, &globalModel.ConsumptionMeta{
+		ConsumptionRewardPool:        types.NewCoinFromInt64(100),
+		ConsumptionWindow:            types.NewCoinFromInt64(100),
+		ConsumptionFreezingPeriodSec: freezingPeriodSec,
+	})
+	as.SetReward(ctx, user, &accModel.Reward{})
+	vm.AddVoter(ctx, user, types.NewCoinFromInt64(0))
+
+	// post is censored before its reward event runs after freezing period
+	err := pm.CensorPost(ctx, permlink)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{
+		ChainID: "Lino", Time: ctx.BlockHeader().Time.Add(time.Duration(freezingPeriodSec) * time.Second)})
+	event := RewardEvent{
+		PostAuthor: user,
+		PostID:     postID,
+		Consumer:   user1,
+		Evaluate:   types.NewCoinFromInt64(100),
+		Original:   types.NewCoinFromInt64(100),
+		Friction:   types.NewCoinFromInt64(15),
+	}
+	err = event.Execute(ctx, pm, am, &gm, dm, vm, rm)
+	assert.Nil(t, err)
+	reward, err := as.GetReward(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(0), reward.InflationIncome)
+	censored, err := pm.GetCensoredContent(ctx, permlink, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []postModel.LostReward{
+		{Consumer: user1, Evaluate: types.NewCoinFromInt64(100), Reward: types.NewCoinFromInt64(100)},
+	}, censored.LostRewards)
+	consumptionMeta, err := gs.GetConsumptionMeta(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(100), consumptionMeta.ConsumptionRewardPool)
+
+	// lost reward is paid back once censorship is overturned
+	lostRewards, err := pm.RestoreCensoredContent(ctx, permlink, 0)
+	assert.Nil(t, err)
+	err = PayLostRewards(ctx, pm, am, &gm, permlink, lostRewards)
+	assert.Nil(t, err)
+	reward, err = as.GetReward(ctx, user)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(100), reward.InflationIncome)
+	assert.Equal(t, types.NewCoinFromInt64(100), reward.UnclaimReward)
+	consumptionMeta, err = gs.GetConsumptionMeta(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(0), consumptionMeta.ConsumptionRewardPool)
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(100), postMeta.TotalReward)
+}
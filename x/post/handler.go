@@ -3,9 +3,12 @@ package post
 import (
 	"fmt"
 	"reflect"
+	"unicode/utf8"
 
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/post/model"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	acc "github.com/lino-network/lino/x/account"
@@ -27,10 +30,16 @@ func NewHandler(
 			return handleReportOrUpvoteMsg(ctx, msg, pm, am, gm, rm)
 		case ViewMsg:
 			return handleViewMsg(ctx, msg, pm, am, gm)
+		case BatchViewMsg:
+			return handleBatchViewMsg(ctx, msg, pm, am, dm)
 		case UpdatePostMsg:
 			return handleUpdatePostMsg(ctx, msg, pm, am)
 		case DeletePostMsg:
 			return handleDeletePostMsg(ctx, msg, pm, am)
+		case UpdateAllowRepliesMsg:
+			return handleUpdateAllowRepliesMsg(ctx, msg, pm, am)
+		case RefundDonationMsg:
+			return handleRefundDonationMsg(ctx, msg, pm, am, gm, rm)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized post msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -58,12 +67,29 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostManager, am
 	if lastPostAt+postParam.PostIntervalSec > ctx.BlockHeader().Time.Unix() {
 		return ErrPostTooOften(msg.Author).Result()
 	}
+	if err := checkPostSize(postParam, msg.Title, msg.Content, msg.Links); err != nil {
+		return err.Result()
+	}
 	if len(msg.ParentAuthor) > 0 || len(msg.ParentPostID) > 0 {
 		parentPostKey := types.GetPermlink(msg.ParentAuthor, msg.ParentPostID)
 		if !pm.DoesPostExist(ctx, parentPostKey) {
 			return ErrPostNotFound(parentPostKey).Result()
 		}
-		if err := pm.AddComment(ctx, parentPostKey, msg.Author, msg.PostID); err != nil {
+		allowReplies, err := pm.IsRepliesAllowed(ctx, parentPostKey)
+		if err != nil {
+			return err.Result()
+		}
+		if !allowReplies {
+			return ErrRepliesNotAllowed(parentPostKey).Result()
+		}
+		parentDepth, err := pm.GetCommentDepth(ctx, parentPostKey)
+		if err != nil {
+			return err.Result()
+		}
+		if postParam.MaxCommentDepth > 0 && parentDepth+1 > postParam.MaxCommentDepth {
+			return ErrCommentTooDeep(parentDepth + 1).Result()
+		}
+		if err := pm.AddComment(ctx, parentPostKey, msg.Author, msg.PostID, parentDepth+1); err != nil {
 			return err.Result()
 		}
 	}
@@ -72,11 +98,16 @@ func handleCreatePostMsg(ctx sdk.Context, msg CreatePostMsg, pm PostManager, am
 	if err != nil {
 		return ErrInvalidPostRedistributionSplitRate().Result()
 	}
+	for _, beneficiary := range msg.Beneficiaries {
+		if !am.DoesAccountExist(ctx, beneficiary.Username) {
+			return ErrAccountNotFound(beneficiary.Username).Result()
+		}
+	}
 
 	if err := pm.CreatePost(
 		ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
 		msg.ParentAuthor, msg.ParentPostID, msg.Content, msg.Title,
-		splitRate, msg.Links); err != nil {
+		msg.ContentHash, msg.ContentURI, splitRate, msg.Links, msg.Beneficiaries, msg.Tags); err != nil {
 		return err.Result()
 	}
 
@@ -95,13 +126,61 @@ func handleViewMsg(ctx sdk.Context, msg ViewMsg, pm PostManager, am acc.AccountM
 	if !pm.DoesPostExist(ctx, permlink) {
 		return ErrPostNotFound(permlink).Result()
 	}
-	if err := pm.AddOrUpdateViewToPost(ctx, permlink, msg.Username); err != nil {
+	if err := pm.AddOrUpdateViewToPost(ctx, permlink, msg.Username, 1); err != nil {
 		return err.Result()
 	}
 
 	return sdk.Result{}
 }
 
+// Handle BatchViewMsg
+func handleBatchViewMsg(
+	ctx sdk.Context, msg BatchViewMsg, pm PostManager, am acc.AccountManager,
+	dm dev.DeveloperManager) sdk.Result {
+	if !dm.DoesDeveloperExist(ctx, msg.App) {
+		return ErrDeveloperNotFound(msg.App).Result()
+	}
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	lastBatchViewAt, err := dm.GetLastBatchViewAt(ctx, msg.App)
+	if err != nil {
+		return err.Result()
+	}
+	if lastBatchViewAt+postParam.BatchViewIntervalSec > ctx.BlockHeader().Time.Unix() {
+		return ErrBatchViewTooOften(msg.App).Result()
+	}
+	totalCount := int64(0)
+	for _, view := range msg.Views {
+		// each count is checked before summing, so huge counts can't overflow the total.
+		if view.Count > postParam.MaxBatchViewCount {
+			return ErrBatchViewCountExceedLimit(view.Count).Result()
+		}
+		totalCount += view.Count
+		if totalCount > postParam.MaxBatchViewCount {
+			return ErrBatchViewCountExceedLimit(totalCount).Result()
+		}
+	}
+
+	for _, view := range msg.Views {
+		if !am.DoesAccountExist(ctx, view.Username) {
+			return ErrAccountNotFound(view.Username).Result()
+		}
+		permlink := types.GetPermlink(view.Author, view.PostID)
+		if !pm.DoesPostExist(ctx, permlink) {
+			return ErrPostNotFound(permlink).Result()
+		}
+		if err := pm.AddOrUpdateViewToPost(ctx, permlink, view.Username, view.Count); err != nil {
+			return err.Result()
+		}
+	}
+	if err := dm.ReportViews(ctx, msg.App, totalCount); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
 // Handle DonateMsg
 func handleDonateMsg(
 	ctx sdk.Context, msg DonateMsg, pm PostManager, am acc.AccountManager,
@@ -127,61 +206,98 @@ func handleDonateMsg(
 		}
 	}
 
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+
 	totalCoinDayDonated, err := am.MinusSavingCoinWithFullCoinDay(
 		ctx, msg.Username, coin, msg.Author, msg.Memo,
 		types.DonationOut)
 	if err != nil {
 		return err.Result()
 	}
+	amount := coin
+	parts := []model.DonationPart{}
 
-	// sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
-	// if err != nil {
-	// 	return err.Result()
-	// }
-	// if sourceAuthor != types.AccountKey("") && sourcePostID != "" {
-	// 	sourcePermlink := types.GetPermlink(sourceAuthor, sourcePostID)
-
-	// 	redistributionSplitRate, err := pm.GetRedistributionSplitRate(ctx, sourcePermlink)
-	// 	if err != nil {
-	// 		return err.Result()
-	// 	}
-	// 	sourceIncome := types.DecToCoin(coin.ToDec().Mul(sdk.OneDec().Sub(redistributionSplitRate)))
-	// 	coin = coin.Minus(sourceIncome)
-	// 	sourceCoinDayGained := types.DecToCoin(totalCoinDayDonated.ToDec().Mul(sdk.OneDec().Sub(redistributionSplitRate)))
-	// 	totalCoinDayDonated = totalCoinDayDonated.Minus(sourceCoinDayGained)
-	// 	if err := processDonationFriction(
-	// 		ctx, msg.Username, sourceIncome, sourceCoinDayGained, sourceAuthor, sourcePostID, msg.FromApp, am, pm, gm, rm); err != nil {
-	// 		return ErrProcessSourceDonation(sourcePermlink).Result()
-	// 	}
-	// }
-	if err := processDonationFriction(
-		ctx, msg.Username, coin, totalCoinDayDonated, msg.Author, msg.PostID, msg.FromApp, msg.Memo, am, pm, gm, rm); err != nil {
+	// donation to repost is split with source post by source's redistribution split rate
+	sourceAuthor, sourcePostID, err := pm.GetSourcePost(ctx, permlink)
+	if err != nil {
+		return err.Result()
+	}
+	if sourceAuthor != types.AccountKey("") && sourcePostID != "" && sourceAuthor != msg.Username {
+		sourcePermlink := types.GetPermlink(sourceAuthor, sourcePostID)
+		// deleted source post has split rate one, repost author keeps all donation
+		redistributionSplitRate, err := pm.GetRedistributionSplitRate(ctx, sourcePermlink)
+		if err != nil {
+			return err.Result()
+		}
+		sourceIncome := types.DecToCoin(coin.ToDec().Mul(sdk.OneDec().Sub(redistributionSplitRate)))
+		coin = coin.Minus(sourceIncome)
+		sourceCoinDayGained := types.DecToCoin(totalCoinDayDonated.ToDec().Mul(sdk.OneDec().Sub(redistributionSplitRate)))
+		totalCoinDayDonated = totalCoinDayDonated.Minus(sourceCoinDayGained)
+		sourcePart, err := processDonationFriction(
+			ctx, msg.Username, sourceIncome, sourceCoinDayGained, sourceAuthor, sourcePostID,
+			msg.FromApp, msg.Memo, am, pm, gm, rm)
+		if err != nil {
+			return ErrProcessSourceDonation(sourcePermlink).Result()
+		}
+		if sourcePart != nil {
+			parts = append(parts, *sourcePart)
+		}
+	}
+	part, err := processDonationFriction(
+		ctx, msg.Username, coin, totalCoinDayDonated, msg.Author, msg.PostID, msg.FromApp, msg.Memo, am, pm, gm, rm)
+	if err != nil {
 		return ErrProcessDonation(permlink).Result()
 	}
+	if part != nil {
+		parts = append(parts, *part)
+	}
+	// donation is kept only if it can be refunded, and deleted once it can't
+	if postParam.DonationRefundWindowSec > 0 {
+		donationID, err := pm.AddDonationRecord(ctx, permlink, msg.Username, amount, msg.FromApp, parts)
+		if err != nil {
+			return err.Result()
+		}
+		deadline, err := getDonationRefundDeadline(
+			ctx, gm, ctx.BlockHeader().Time.Unix(), postParam.DonationRefundWindowSec)
+		if err != nil {
+			return err.Result()
+		}
+		event := ExpireDonationEvent{Permlink: permlink, Donor: msg.Username, DonationID: donationID}
+		if err := gm.RegisterDonationExpireEvent(ctx, deadline+1, event); err != nil {
+			return err.Result()
+		}
+	}
 	return sdk.Result{}
 }
 
 func processDonationFriction(
 	ctx sdk.Context, consumer types.AccountKey, coin types.Coin, coinDayDonated types.Coin,
 	postAuthor types.AccountKey, postID string, fromApp types.AccountKey, memo string, am acc.AccountManager,
-	pm PostManager, gm *global.GlobalManager, rm rep.ReputationManager) sdk.Error {
+	pm PostManager, gm *global.GlobalManager, rm rep.ReputationManager) (*model.DonationPart, sdk.Error) {
 	postKey := types.GetPermlink(postAuthor, postID)
 	if coin.IsZero() {
-		return nil
+		return nil, nil
 	}
 	consumptionFrictionRate, err := gm.GetConsumptionFrictionRate(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	frictionCoin := types.DecToCoin(coin.ToDec().Mul(consumptionFrictionRate))
+	roundStartAt, err := rm.GetCurrentRound(ctx)
+	if err != nil {
+		return nil, err
+	}
 	// evaluate this consumption can get the result, the result is used to get inflation from pool
 	dp, err := rm.DonateAt(ctx, consumer, postKey, coinDayDonated)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	evaluateResult, err := evaluateConsumption(dp, gm)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	rewardEvent := RewardEvent{
 		PostAuthor: postAuthor,
@@ -194,21 +310,150 @@ func processDonationFriction(
 	}
 	if err := gm.AddFrictionAndRegisterContentRewardEvent(
 		ctx, rewardEvent, frictionCoin, evaluateResult); err != nil {
-		return err
+		return nil, err
 	}
 
 	directDeposit := coin.Minus(frictionCoin)
 	if err := pm.AddDonation(ctx, postKey, consumer, directDeposit, types.DirectDeposit); err != nil {
-		return err
+		return nil, err
+	}
+	part := &model.DonationPart{
+		Permlink:     postKey,
+		Coin:         coin,
+		CoinDay:      coinDayDonated,
+		Dp:           dp,
+		Evaluate:     evaluateResult,
+		Friction:     frictionCoin,
+		RoundStartAt: roundStartAt,
+	}
+	// direct deposit is split among post beneficiaries
+	beneficiaries, shares, err := pm.GetBeneficiaryShares(ctx, postKey, directDeposit)
+	if err != nil {
+		return nil, err
+	}
+	for i, beneficiary := range beneficiaries {
+		if err := am.AddSavingCoin(
+			ctx, beneficiary, shares[i], consumer, memo, types.DonationIn); err != nil {
+			return nil, err
+		}
+		if err := am.AddDirectDeposit(ctx, beneficiary, shares[i]); err != nil {
+			return nil, err
+		}
+		if err := pm.AddBeneficiaryReward(
+			ctx, postKey, beneficiary, shares[i], types.NewCoinFromInt64(0)); err != nil {
+			return nil, err
+		}
+		part.Deposits = append(part.Deposits, model.DonationDeposit{Username: beneficiary, Coin: shares[i]})
+	}
+	if err := gm.AddConsumption(ctx, coin); err != nil {
+		return nil, err
+	}
+	return part, nil
+}
+
+// Handle RefundDonationMsg
+func handleRefundDonationMsg(
+	ctx sdk.Context, msg RefundDonationMsg, pm PostManager, am acc.AccountManager,
+	gm *global.GlobalManager, rm rep.ReputationManager) sdk.Result {
+	permlink := types.GetPermlink(msg.Author, msg.PostID)
+	donation, err := pm.GetDonation(ctx, permlink, msg.Donor, msg.DonationID)
+	if err != nil {
+		return err.Result()
+	}
+	// donation can be refunded by donor or the app it came from
+	if msg.Username != donation.Donor &&
+		(donation.FromApp == types.AccountKey("") || msg.Username != donation.FromApp) {
+		return ErrRefundDonationNotAllowed(msg.Username).Result()
+	}
+	if donation.IsRefunded {
+		return ErrDonationAlreadyRefunded(msg.DonationID).Result()
+	}
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	if postParam.DonationRefundWindowSec == 0 {
+		return ErrDonationRefundWindowExpired(msg.DonationID).Result()
+	}
+	deadline, err := getDonationRefundDeadline(ctx, gm, donation.CreatedAt, postParam.DonationRefundWindowSec)
+	if err != nil {
+		return err.Result()
+	}
+	if ctx.BlockHeader().Time.Unix() > deadline {
+		return ErrDonationRefundWindowExpired(msg.DonationID).Result()
+	}
+
+	for _, part := range donation.Parts {
+		if err := refundDonationPart(ctx, donation, part, am, pm, gm, rm); err != nil {
+			return err.Result()
+		}
 	}
 	if err := am.AddSavingCoin(
-		ctx, postAuthor, directDeposit, consumer, memo, types.DonationIn); err != nil {
+		ctx, donation.Donor, donation.Amount, msg.Author, "", types.DonationRefundIn); err != nil {
+		return err.Result()
+	}
+	if err := pm.SetDonationRefunded(ctx, permlink, donation.Donor, donation.DonationID); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// getDonationRefundDeadline - last second a donation made at createdAt can be refunded,
+// which is the end of refund window but no later than the end of the friction day of donation,
+// since once the day is over its friction can be claimed by stakers as interest
+func getDonationRefundDeadline(
+	ctx sdk.Context, gm *global.GlobalManager, createdAt, refundWindowSec int64) (int64, sdk.Error) {
+	pastDay, err := gm.GetPastDay(ctx, createdAt)
+	if err != nil {
+		return 0, err
+	}
+	chainStartTime, err := gm.GetChainStartTime(ctx)
+	if err != nil {
+		return 0, err
+	}
+	deadline := createdAt + refundWindowSec
+	if dayEnd := chainStartTime + (pastDay+1)*24*3600 - 1; deadline > dayEnd {
+		deadline = dayEnd
+	}
+	return deadline, nil
+}
+
+// refundDonationPart - reverse direct deposit, pending reward event, consumption
+// statistics and donation power of a donation processed on a post
+func refundDonationPart(
+	ctx sdk.Context, donation *model.Donation, part model.DonationPart, am acc.AccountManager,
+	pm PostManager, gm *global.GlobalManager, rm rep.ReputationManager) sdk.Error {
+	for _, deposit := range part.Deposits {
+		if err := am.MinusSavingCoin(
+			ctx, deposit.Username, deposit.Coin, donation.Donor, "", types.DonationRefundOut); err != nil {
+			return err
+		}
+		if err := am.MinusDirectDeposit(ctx, deposit.Username, deposit.Coin); err != nil {
+			return err
+		}
+		if err := pm.MinusBeneficiaryReward(ctx, part.Permlink, deposit.Username, deposit.Coin); err != nil {
+			return err
+		}
+	}
+	if err := pm.MinusDonation(ctx, part.Permlink, part.Coin.Minus(part.Friction)); err != nil {
 		return err
 	}
-	if err := am.AddDirectDeposit(ctx, postAuthor, directDeposit); err != nil {
+	matchRewardEvent := func(event types.Event) bool {
+		rewardEvent, ok := event.(RewardEvent)
+		return ok && types.GetPermlink(rewardEvent.PostAuthor, rewardEvent.PostID) == part.Permlink &&
+			rewardEvent.Consumer == donation.Donor && rewardEvent.FromApp == donation.FromApp &&
+			rewardEvent.Original.IsEqual(part.Coin) && rewardEvent.Friction.IsEqual(part.Friction) &&
+			rewardEvent.Evaluate.IsEqual(part.Evaluate)
+	}
+	if err := gm.MinusFrictionAndRemoveContentRewardEvent(
+		ctx, donation.CreatedAt, matchRewardEvent, part.Friction, part.Evaluate); err != nil {
 		return err
 	}
-	if err := gm.AddConsumption(ctx, coin); err != nil {
+	if err := rm.RefundAt(
+		ctx, donation.Donor, part.Permlink, part.CoinDay, part.Dp, part.RoundStartAt); err != nil {
+		return err
+	}
+	if err := gm.MinusConsumption(ctx, part.Coin); err != nil {
 		return err
 	}
 	return nil
@@ -245,12 +490,22 @@ func handleReportOrUpvoteMsg(
 	if lastReportOrUpvoteAt+postParam.ReportOrUpvoteIntervalSec > ctx.BlockHeader().Time.Unix() {
 		return ErrReportOrUpvoteTooOften().Result()
 	}
+	coinDay, err := am.GetCoinDay(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+	// upvote is weighted by coin day on post, report also lowers post reputation
+	// until it is replaced by an upvote from the same user
 	if msg.IsReport {
 		if _, err := rm.ReportAt(ctx, msg.Username, permlink); err != nil {
 			return err.Result()
 		}
+	} else if pm.IsReportedBy(ctx, permlink, msg.Username) {
+		if _, err := rm.UnreportAt(ctx, msg.Username, permlink); err != nil {
+			return err.Result()
+		}
 	}
-	if err := pm.UpdateLastActivityAt(ctx, permlink); err != nil {
+	if err := pm.ReportOrUpvoteToPost(ctx, permlink, msg.Username, coinDay, msg.IsReport); err != nil {
 		return err.Result()
 	}
 
@@ -272,14 +527,59 @@ func handleUpdatePostMsg(
 	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
 		return ErrUpdatePostIsDeleted(permlink).Result()
 	}
+	postParam, err := pm.paramHolder.GetPostParam(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	if err := checkPostSize(postParam, msg.Title, msg.Content, msg.Links); err != nil {
+		return err.Result()
+	}
 
 	if err := pm.UpdatePost(
-		ctx, msg.Author, msg.PostID, msg.Title, msg.Content, msg.Links); err != nil {
+		ctx, msg.Author, msg.PostID, msg.Title, msg.Content,
+		msg.ContentHash, msg.ContentURI, msg.Links, msg.Tags); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle UpdateAllowRepliesMsg
+func handleUpdateAllowRepliesMsg(
+	ctx sdk.Context, msg UpdateAllowRepliesMsg, pm PostManager, am acc.AccountManager) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Author) {
+		return ErrAccountNotFound(msg.Author).Result()
+	}
+	permlink := types.GetPermlink(msg.Author, msg.PostID)
+	if !pm.DoesPostExist(ctx, permlink) {
+		return ErrPostNotFound(permlink).Result()
+	}
+	if isDeleted, err := pm.IsDeleted(ctx, permlink); isDeleted || err != nil {
+		return ErrUpdatePostIsDeleted(permlink).Result()
+	}
+	if err := pm.SetAllowReplies(ctx, permlink, msg.AllowReplies); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
 }
 
+// checkPostSize - title, content and links of a post can't exceed limits in post param,
+// zero limit (e.g. param stored before the limits were introduced) means no limit
+func checkPostSize(
+	postParam *param.PostParam, title, content string, links []types.IDToURLMapping) sdk.Error {
+	if postParam.MaxTitleLength > 0 &&
+		int64(utf8.RuneCountInString(title)) > postParam.MaxTitleLength {
+		return ErrPostTitleExceedMaxLength()
+	}
+	if postParam.MaxContentLength > 0 &&
+		int64(utf8.RuneCountInString(content)) > postParam.MaxContentLength {
+		return ErrPostContentExceedMaxLength()
+	}
+	if postParam.MaxNumOfLinks > 0 && int64(len(links)) > postParam.MaxNumOfLinks {
+		return ErrTooManyURL()
+	}
+	return nil
+}
+
 func handleDeletePostMsg(
 	ctx sdk.Context, msg DeletePostMsg, pm PostManager, am acc.AccountManager) sdk.Result {
 	if !am.DoesAccountExist(ctx, msg.Author) {
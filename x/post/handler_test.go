@@ -1,12 +1,15 @@
 package post
 
 import (
+	"math"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	dev "github.com/lino-network/lino/x/developer"
+	devModel "github.com/lino-network/lino/x/developer/model"
 	"github.com/lino-network/lino/x/global"
 	"github.com/lino-network/lino/x/post/model"
 	rep "github.com/lino-network/lino/x/reputation"
@@ -61,6 +64,62 @@ func TestHandlerCreatePost(t *testing.T) {
 	assert.Equal(t, result, ErrPostTooOften(msg.Author).Result())
 }
 
+func TestCheckPostSize(t *testing.T) {
+	links := []types.IDToURLMapping{
+		{Identifier: "#1", URL: "https://lino.network"},
+		{Identifier: "#2", URL: "https://lino.network"},
+	}
+	testCases := []struct {
+		testName   string
+		postParam  param.PostParam
+		title      string
+		content    string
+		wantResult sdk.Error
+	}{
+		{
+			testName:   "within limits",
+			postParam:  param.PostParam{MaxTitleLength: 5, MaxContentLength: 5, MaxNumOfLinks: 2},
+			title:      "title",
+			content:    "body",
+			wantResult: nil,
+		},
+		{
+			testName:   "title exceeds limit",
+			postParam:  param.PostParam{MaxTitleLength: 4, MaxContentLength: 5, MaxNumOfLinks: 2},
+			title:      "title",
+			content:    "body",
+			wantResult: ErrPostTitleExceedMaxLength(),
+		},
+		{
+			testName:   "content exceeds limit",
+			postParam:  param.PostParam{MaxTitleLength: 5, MaxContentLength: 3, MaxNumOfLinks: 2},
+			title:      "title",
+			content:    "body",
+			wantResult: ErrPostContentExceedMaxLength(),
+		},
+		{
+			testName:   "too many links",
+			postParam:  param.PostParam{MaxTitleLength: 5, MaxContentLength: 5, MaxNumOfLinks: 1},
+			title:      "title",
+			content:    "body",
+			wantResult: ErrTooManyURL(),
+		},
+		{
+			testName:   "zero limits from param stored before limits are not enforced",
+			postParam:  param.PostParam{},
+			title:      "title",
+			content:    "body",
+			wantResult: nil,
+		},
+	}
+	for _, tc := range testCases {
+		err := checkPostSize(&tc.postParam, tc.title, tc.content, links)
+		if !assert.Equal(t, tc.wantResult, err) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, err, tc.wantResult)
+		}
+	}
+}
+
 func TestHandlerUpdatePost(t *testing.T) {
 	ctx, am, _, pm, gm, dm, _, rm := setupTest(t, 1)
 	handler := NewHandler(pm, am, &gm, dm, rm)
@@ -95,6 +154,24 @@ func TestHandlerUpdatePost(t *testing.T) {
 			msg:        NewUpdatePostMsg(string(user1), postID1, "update title", "update content", []types.IDToURLMapping(nil)),
 			wantResult: ErrUpdatePostIsDeleted(types.GetPermlink(user1, postID1)).Result(),
 		},
+		"update to content-addressed post": {
+			msg: UpdatePostMsg{
+				Author:      user,
+				PostID:      postID,
+				Title:       "update title",
+				ContentHash: testContentHash,
+				ContentURI:  testContentURI,
+			},
+			wantResult: sdk.Result{},
+		},
+		"update title exceeds post param": {
+			msg:        NewUpdatePostMsg(string(user), postID, string(make([]byte, 101)), "update content", []types.IDToURLMapping(nil)),
+			wantResult: ErrPostTitleExceedMaxLength().Result(),
+		},
+		"update content exceeds post param": {
+			msg:        NewUpdatePostMsg(string(user), postID, "update title", string(make([]byte, 1001)), []types.IDToURLMapping(nil)),
+			wantResult: ErrPostContentExceedMaxLength().Result(),
+		},
 	}
 	for testName, tc := range testCases {
 		result := handler(ctx, tc.msg)
@@ -109,6 +186,8 @@ func TestHandlerUpdatePost(t *testing.T) {
 			PostID:       tc.msg.PostID,
 			Title:        tc.msg.Title,
 			Content:      tc.msg.Content,
+			ContentHash:  tc.msg.ContentHash,
+			ContentURI:   tc.msg.ContentURI,
 			Author:       tc.msg.Author,
 			SourceAuthor: "",
 			SourcePostID: "",
@@ -219,6 +298,7 @@ func TestHandlerCreateComment(t *testing.T) {
 		SourceAuthor: msg.SourceAuthor,
 		SourcePostID: msg.SourcePostID,
 		Links:        msg.Links,
+		Depth:        1,
 	}
 
 	postMeta := model.PostMeta{
@@ -276,6 +356,88 @@ func TestHandlerCreateComment(t *testing.T) {
 	assert.Equal(t, result, ErrPostNotFound(types.GetPermlink(user, msg.PostID)).Result())
 }
 
+func TestHandlerCommentReplyControl(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+	postParam, err := ph.GetPostParam(ctx)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Now()})
+
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	root := types.GetPermlink(user, postID)
+	newComment := func(author types.AccountKey, parent types.Permlink) CreatePostMsg {
+		parentInfo, err := pm.postStorage.GetPostInfo(ctx, parent)
+		assert.Nil(t, err)
+		return CreatePostMsg{
+			PostID:                  "comment",
+			Title:                   "comment",
+			Content:                 "comment",
+			Author:                  author,
+			ParentAuthor:            parentInfo.Author,
+			ParentPostID:            parentInfo.PostID,
+			RedistributionSplitRate: "0",
+		}
+	}
+
+	// author disallows replies
+	result := handler(ctx, NewUpdateAllowRepliesMsg(string(user), postID, false))
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewUpdateAllowRepliesMsg(string(user), "invalid", false))
+	assert.Equal(t, ErrPostNotFound(types.GetPermlink(user, "invalid")).Result(), result)
+
+	commenter := createTestAccount(t, ctx, am, "commenter0")
+	result = handler(ctx, newComment(commenter, root))
+	assert.Equal(t, ErrRepliesNotAllowed(root).Result(), result)
+
+	// author allows replies again
+	result = handler(ctx, NewUpdateAllowRepliesMsg(string(user), postID, true))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// build a thread up to max comment depth
+	parent := root
+	thread := []types.Permlink{}
+	for depth := int64(1); depth <= postParam.MaxCommentDepth; depth++ {
+		commenter := createTestAccount(t, ctx, am, "commenter"+strconv.FormatInt(depth, 10))
+		result = handler(ctx, newComment(commenter, parent))
+		assert.Equal(t, sdk.Result{}, result)
+		comment, err := pm.postStorage.GetPostComment(ctx, parent, types.GetPermlink(commenter, "comment"))
+		assert.Nil(t, err)
+		assert.Equal(t, depth, comment.Depth)
+		parent = types.GetPermlink(commenter, "comment")
+		thread = append(thread, parent)
+	}
+	commenter = createTestAccount(t, ctx, am, "toodeep")
+	result = handler(ctx, newComment(commenter, parent))
+	assert.Equal(t, ErrCommentTooDeep(postParam.MaxCommentDepth+1).Result(), result)
+
+	// comment tree is returned level by level
+	for i, permlink := range thread {
+		nodes, err := getCommentTreeLevel(ctx, pm, root, int64(i+1), maxCommentTreeScan)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(nodes))
+		assert.Equal(t, permlink, nodes[0].Permlink)
+		assert.Equal(t, int64(i+1), nodes[0].Depth)
+		if i > 0 {
+			assert.Equal(t, thread[i-1], nodes[0].ParentPermlink)
+		}
+	}
+	nodes, err := getCommentTreeLevel(ctx, pm, root, postParam.MaxCommentDepth+1, maxCommentTreeScan)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(nodes))
+	_, err = getCommentTreeLevel(ctx, pm, root, postParam.MaxCommentDepth, int(postParam.MaxCommentDepth)-1)
+	assert.Equal(t, ErrCommentTreeTooLarge(root), err)
+
+	// comments stored before depth was recorded can't be used to bypass max comment depth
+	for _, permlink := range thread {
+		postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+		assert.Nil(t, err)
+		postInfo.Depth = 0
+		assert.Nil(t, pm.postStorage.SetPostInfo(ctx, postInfo))
+	}
+	result = handler(ctx, newComment(commenter, parent))
+	assert.Equal(t, ErrCommentTooDeep(postParam.MaxCommentDepth+1).Result(), result)
+}
+
 func TestHandlerRepost(t *testing.T) {
 	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
 	handler := NewHandler(pm, am, &gm, dm, rm)
@@ -740,8 +902,8 @@ func BenchmarkNumDonate(b *testing.B) {
 		resetPriv.PubKey(), txPriv.PubKey(), appPriv.PubKey(), types.NewCoinFromInt64(100000*int64(b.N)))
 	postManager.CreatePost(
 		ctx, types.AccountKey("user1"), "postID", "", "", "", "",
-		string(make([]byte, 1000)), string(make([]byte, 50)),
-		splitRate, []types.IDToURLMapping{})
+		string(make([]byte, 1000)), string(make([]byte, 50)), "", "",
+		splitRate, []types.IDToURLMapping{}, nil, nil)
 
 	b.ResetTimer()
 	for n := 0; n < b.N; n++ {
@@ -749,113 +911,274 @@ func BenchmarkNumDonate(b *testing.B) {
 	}
 }
 
-// func TestHandlerRePostDonate(t *testing.T) {
-// 	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
-// 	postParam, _ := ph.GetPostParam(ctx)
-// 	handler := NewHandler(pm, am, &gm, dm, rm)
-
-// 	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0.15")
-// 	user2 := createTestAccount(t, ctx, am, "user2")
-// 	user3 := createTestAccount(t, ctx, am, "user3")
-// 	err := am.AddSavingCoin(
-// 		ctx, user3, types.NewCoinFromInt64(123*types.Decimals),
-// 		referrer, "", types.TransferIn)
-// 	assert.Nil(t, err)
-// 	// repost
-// 	msg := CreatePostMsg{
-// 		PostID:       "repost",
-// 		Title:        string(make([]byte, 50)),
-// 		Content:      string(make([]byte, 1000)),
-// 		Author:       user2,
-// 		ParentAuthor: "",
-// 		ParentPostID: "",
-// 		SourceAuthor: user1,
-// 		SourcePostID: postID,
-// 		Links:        nil,
-// 		RedistributionSplitRate: "0",
-// 	}
-// 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(postParam.PostIntervalSec, 0)})
-// 	result := handler(ctx, msg)
-// 	assert.Equal(t, sdk.Result{}, result)
-
-// 	donateMsg := NewDonateMsg(
-// 		string(user3), types.LNO("100"), string(user2), "repost", "", memo1)
-// 	result = handler(ctx, donateMsg)
-// 	assert.Equal(t, sdk.Result{}, result)
-// 	eventList :=
-// 		gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix()+3600*7*24)
-
-// 	// after handler check KVStore
-// 	// check repost first
-// 	postInfo := model.PostInfo{
-// 		PostID:       msg.PostID,
-// 		Title:        msg.Title,
-// 		Content:      msg.Content,
-// 		Author:       msg.Author,
-// 		ParentAuthor: msg.ParentAuthor,
-// 		ParentPostID: msg.ParentPostID,
-// 		SourceAuthor: msg.SourceAuthor,
-// 		SourcePostID: msg.SourcePostID,
-// 		Links:        msg.Links,
-// 	}
-// 	totalReward := types.DecToCoin(sdk.NewDec(15 * types.Decimals).Mul(types.NewDecFromRat(95, 100)))
-// 	postMeta := model.PostMeta{
-// 		CreatedAt:               ctx.BlockHeader().Time.Unix(),
-// 		LastUpdatedAt:           ctx.BlockHeader().Time.Unix(),
-// 		LastActivityAt:          ctx.BlockHeader().Time.Unix(),
-// 		AllowReplies:            true,
-// 		TotalDonateCount:        1,
-// 		TotalReward:             totalReward,
-// 		TotalUpvoteCoinDay:      types.NewCoinFromInt64(0),
-// 		TotalReportCoinDay:      types.NewCoinFromInt64(0),
-// 		RedistributionSplitRate: sdk.ZeroDec(),
-// 	}
-// 	checkPostKVStore(t, ctx, types.GetPermlink(user2, "repost"), postInfo, postMeta)
-// 	repostRewardEvent := RewardEvent{
-// 		PostAuthor: user2,
-// 		PostID:     "repost",
-// 		Consumer:   user3,
-// 		// XXX(yumin): rep is 1, while donation is 100, final dp is 1/100.
-// 		Evaluate: types.NewCoinFromInt64(15 * types.Decimals / 100),
-// 		Original: types.NewCoinFromInt64(15 * types.Decimals),
-// 		Friction: types.NewCoinFromInt64(75000),
-// 		FromApp:  "",
-// 	}
-// 	assert.Equal(t, repostRewardEvent, eventList.Events[1])
-
-// 	// check source post
-// 	postMeta.TotalReward = types.DecToCoin(sdk.NewDec(85 * types.Decimals).Mul(types.NewDecFromRat(95, 100)))
-// 	postMeta.CreatedAt = 0
-// 	postMeta.LastUpdatedAt = 0
-// 	postInfo.Author = user1
-// 	postInfo.PostID = postID
-// 	postInfo.SourceAuthor = ""
-// 	postInfo.SourcePostID = ""
-// 	postMeta.RedistributionSplitRate = types.NewDecFromRat(3, 20)
-// 	postMeta.TotalUpvoteCoinDay = types.NewCoinFromInt64(0)
-
-// 	checkPostKVStore(t, ctx, types.GetPermlink(user1, postID), postInfo, postMeta)
-
-// 	acc1Saving, _ := am.GetSavingFromBank(ctx, user1)
-// 	acc2Saving, _ := am.GetSavingFromBank(ctx, user2)
-// 	acc3Saving, _ := am.GetSavingFromBank(ctx, user3)
-// 	acc1SavingCoin := types.DecToCoin(sdk.NewDec(85 * types.Decimals).Mul(types.NewDecFromRat(95, 100)))
-// 	acc2SavingCoin := types.DecToCoin(sdk.NewDec(15 * types.Decimals).Mul(types.NewDecFromRat(95, 100)))
-// 	assert.Equal(t, acc1Saving, initCoin.Plus(acc1SavingCoin))
-// 	assert.Equal(t, acc2Saving, initCoin.Plus(acc2SavingCoin))
-// 	assert.Equal(t, acc3Saving, initCoin.Plus(types.NewCoinFromInt64(23*types.Decimals)))
-
-// 	sourceRewardEvent := RewardEvent{
-// 		PostAuthor: user1,
-// 		PostID:     postID,
-// 		Consumer:   user3,
-// 		Evaluate:   types.NewCoinFromInt64(85 * types.Decimals / 100),
-// 		Original:   types.NewCoinFromInt64(85 * types.Decimals),
-// 		Friction:   types.NewCoinFromInt64(425000),
-// 		FromApp:    "",
-// 	}
-// 	assert.Equal(t, sourceRewardEvent, eventList.Events[0])
-// }
+func TestHandlerRepostDonate(t *testing.T) {
+	ctx, am, _, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+
+	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0.15")
+	user2, repostID := createTestRepost(t, ctx, "user2", "repost", am, pm, user1, postID)
+	user3 := createTestAccount(t, ctx, am, "user3")
+	// repost of repost has root source post as source
+	user4, secondRepostID := createTestRepost(t, ctx, "user4", "repost", am, pm, user2, repostID)
+	user5, deletedPostID := createTestPost(t, ctx, "user5", "postID", am, pm, "0.15")
+	user6, repostOfDeletedID := createTestRepost(t, ctx, "user6", "repost", am, pm, user5, deletedPostID)
+	err := pm.DeletePost(ctx, types.GetPermlink(user5, deletedPostID))
+	assert.Nil(t, err)
+
+	for _, user := range []types.AccountKey{user1, user3} {
+		err := am.AddSavingCoin(
+			ctx, user, types.NewCoinFromInt64(1000*types.Decimals), referrer, "", types.TransferIn)
+		assert.Nil(t, err)
+	}
+	afterFriction := func(lino int64) types.Coin {
+		return types.DecToCoin(sdk.NewDec(lino * types.Decimals).Mul(types.NewDecFromRat(95, 100)))
+	}
+
+	testCases := []struct {
+		testName           string
+		donateUser         types.AccountKey
+		toAuthor           types.AccountKey
+		toPostID           string
+		sourceAuthor       types.AccountKey
+		expectAuthorIncome types.Coin
+		expectSourceIncome types.Coin
+	}{
+		{
+			testName:           "donate to repost, split with source post",
+			donateUser:         user3,
+			toAuthor:           user2,
+			toPostID:           repostID,
+			sourceAuthor:       user1,
+			expectAuthorIncome: afterFriction(15),
+			expectSourceIncome: afterFriction(85),
+		},
+		{
+			testName:           "donate to repost of repost, split with root source post",
+			donateUser:         user3,
+			toAuthor:           user4,
+			toPostID:           secondRepostID,
+			sourceAuthor:       user1,
+			expectAuthorIncome: afterFriction(15),
+			expectSourceIncome: afterFriction(85),
+		},
+		{
+			testName:           "donate to repost of deleted post, repost author gets all",
+			donateUser:         user3,
+			toAuthor:           user6,
+			toPostID:           repostOfDeletedID,
+			sourceAuthor:       user5,
+			expectAuthorIncome: afterFriction(100),
+			expectSourceIncome: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:           "source author donates to repost, repost author gets all",
+			donateUser:         user1,
+			toAuthor:           user2,
+			toPostID:           repostID,
+			sourceAuthor:       user1,
+			expectAuthorIncome: afterFriction(100),
+			expectSourceIncome: types.NewCoinFromInt64(-100 * types.Decimals),
+		},
+	}
+
+	for _, tc := range testCases {
+		authorSaving, err := am.GetSavingFromBank(ctx, tc.toAuthor)
+		assert.Nil(t, err)
+		sourceSaving, err := am.GetSavingFromBank(ctx, tc.sourceAuthor)
+		assert.Nil(t, err)
+
+		donateMsg := NewDonateMsg(
+			string(tc.donateUser), types.LNO("100"), string(tc.toAuthor), tc.toPostID, "", memo1)
+		result := handler(ctx, donateMsg)
+		if !assert.Equal(t, sdk.Result{}, result) {
+			t.Errorf("%s: diff result, got %v", tc.testName, result)
+		}
+
+		newAuthorSaving, err := am.GetSavingFromBank(ctx, tc.toAuthor)
+		assert.Nil(t, err)
+		if !newAuthorSaving.IsEqual(authorSaving.Plus(tc.expectAuthorIncome)) {
+			t.Errorf("%s: diff author saving, got %v, want %v",
+				tc.testName, newAuthorSaving, authorSaving.Plus(tc.expectAuthorIncome))
+		}
+		newSourceSaving, err := am.GetSavingFromBank(ctx, tc.sourceAuthor)
+		assert.Nil(t, err)
+		if !newSourceSaving.IsEqual(sourceSaving.Plus(tc.expectSourceIncome)) {
+			t.Errorf("%s: diff source author saving, got %v, want %v",
+				tc.testName, newSourceSaving, sourceSaving.Plus(tc.expectSourceIncome))
+		}
+	}
+
+	// check reward events of first donation
+	err = gm.CommitEventCache(ctx)
+	assert.Nil(t, err)
+	eventList := gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix()+3600*7*24)
+	sourceRewardEvent, ok := eventList.Events[0].(RewardEvent)
+	assert.True(t, ok)
+	assert.Equal(t, user1, sourceRewardEvent.PostAuthor)
+	assert.Equal(t, postID, sourceRewardEvent.PostID)
+	assert.Equal(t, user3, sourceRewardEvent.Consumer)
+	assert.Equal(t, types.NewCoinFromInt64(85*types.Decimals), sourceRewardEvent.Original)
+	assert.Equal(t, types.NewCoinFromInt64(425000), sourceRewardEvent.Friction)
+	repostRewardEvent, ok := eventList.Events[1].(RewardEvent)
+	assert.True(t, ok)
+	assert.Equal(t, user2, repostRewardEvent.PostAuthor)
+	assert.Equal(t, repostID, repostRewardEvent.PostID)
+	assert.Equal(t, types.NewCoinFromInt64(15*types.Decimals), repostRewardEvent.Original)
+	assert.Equal(t, types.NewCoinFromInt64(75000), repostRewardEvent.Friction)
+}
+
+func TestHandlerBeneficiaryDonate(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	postParam, _ := ph.GetPostParam(ctx)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+
+	user1 := createTestAccount(t, ctx, am, "user1")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	user3 := createTestAccount(t, ctx, am, "user3")
+	err := am.AddSavingCoin(
+		ctx, user3, types.NewCoinFromInt64(100*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(postParam.PostIntervalSec, 0)})
+	msg := NewCreatePostMsg(
+		string(user1), "cohosted", "title", "content", "", "", "", "", "0", nil,
+		[]types.Beneficiary{{Username: "invalid", Weight: "1"}})
+	result := handler(ctx, msg)
+	assert.Equal(t, ErrAccountNotFound("invalid").Result(), result)
+
+	msg.Beneficiaries = []types.Beneficiary{
+		{Username: user1, Weight: "0.6"},
+		{Username: user2, Weight: "0.4"},
+	}
+	result = handler(ctx, msg)
+	assert.Equal(t, sdk.Result{}, result)
+
+	user1Saving, _ := am.GetSavingFromBank(ctx, user1)
+	user2Saving, _ := am.GetSavingFromBank(ctx, user2)
+	donateMsg := NewDonateMsg(string(user3), types.LNO("100"), string(user1), "cohosted", "", memo1)
+	result = handler(ctx, donateMsg)
+	assert.Equal(t, sdk.Result{}, result)
+
+	// direct deposit after friction is split by weight
+	user1Share := types.NewCoinFromInt64(57 * types.Decimals)
+	user2Share := types.NewCoinFromInt64(38 * types.Decimals)
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.True(t, saving.IsEqual(user1Saving.Plus(user1Share)))
+	saving, _ = am.GetSavingFromBank(ctx, user2)
+	assert.True(t, saving.IsEqual(user2Saving.Plus(user2Share)))
+
+	rewards, err := pm.GetBeneficiaryRewards(ctx, types.GetPermlink(user1, "cohosted"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rewards))
+	assert.Equal(t, user1, rewards[0].Username)
+	assert.True(t, rewards[0].DirectDeposit.IsEqual(user1Share))
+	assert.Equal(t, user2, rewards[1].Username)
+	assert.True(t, rewards[1].DirectDeposit.IsEqual(user2Share))
+}
+
+func TestHandlerRefundDonation(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	postParam, _ := ph.GetPostParam(ctx)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+
+	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	user3 := createTestAccount(t, ctx, am, "user3")
+	app := createTestAccount(t, ctx, am, "app")
+	err := dm.RegisterDeveloper(ctx, app, types.NewCoinFromInt64(1000000*types.Decimals), "", "", "")
+	assert.Nil(t, err)
+	err = am.AddSavingCoin(
+		ctx, user2, types.NewCoinFromInt64(1000*types.Decimals), referrer, "", types.TransferIn)
+	assert.Nil(t, err)
+	permlink := types.GetPermlink(user1, postID)
+	baseTime := ctx.BlockHeader().Time.Unix()
+	eventTime := baseTime + 3600*7*24
+
+	authorSaving, _ := am.GetSavingFromBank(ctx, user1)
+	donorSaving, _ := am.GetSavingFromBank(ctx, user2)
+	consumption, _ := gm.GetConsumption(ctx)
+
+	// refund donation in the same block, reward event is still in event cache
+	result := handler(ctx, NewDonateMsg(string(user2), types.LNO("100"), string(user1), postID, string(app), memo1))
+	assert.Equal(t, sdk.Result{}, result)
+	donation, err := pm.GetDonation(ctx, permlink, user2, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, app, donation.FromApp)
+	assert.Equal(t, 1, len(donation.Parts))
+
+	result = handler(ctx, NewRefundDonationMsg(string(user3), string(user2), string(user1), postID, 1))
+	assert.Equal(t, ErrRefundDonationNotAllowed(user3).Result(), result)
+	result = handler(ctx, NewRefundDonationMsg(string(app), string(user2), string(user1), postID, 1))
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewRefundDonationMsg(string(user2), string(user2), string(user1), postID, 1))
+	assert.Equal(t, ErrDonationAlreadyRefunded(1).Result(), result)
+
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.True(t, saving.IsEqual(authorSaving))
+	saving, _ = am.GetSavingFromBank(ctx, user2)
+	assert.True(t, saving.IsEqual(donorSaving))
+	newConsumption, _ := gm.GetConsumption(ctx)
+	assert.True(t, newConsumption.IsEqual(consumption))
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	assert.Nil(t, err)
+	assert.True(t, postMeta.TotalReward.IsZero())
+	assert.Equal(t, int64(0), postMeta.TotalDonateCount)
+	donation, err = pm.GetDonation(ctx, permlink, user2, 1)
+	assert.Nil(t, err)
+	assert.True(t, donation.IsRefunded)
+	err = gm.CommitEventCache(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, gm.GetTimeEventListAtTime(ctx, eventTime))
+
+	// refund donation of a committed reward event
+	result = handler(ctx, NewDonateMsg(string(user2), types.LNO("100"), string(user1), postID, "", memo1))
+	assert.Equal(t, sdk.Result{}, result)
+	err = gm.CommitEventCache(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(gm.GetTimeEventListAtTime(ctx, eventTime).Events))
+
+	result = handler(ctx, NewRefundDonationMsg(string(app), string(user2), string(user1), postID, 2))
+	assert.Equal(t, ErrRefundDonationNotAllowed(app).Result(), result)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(baseTime+postParam.DonationRefundWindowSec+1, 0)})
+	result = handler(ctx, NewRefundDonationMsg(string(user2), string(user2), string(user1), postID, 2))
+	assert.Equal(t, ErrDonationRefundWindowExpired(2).Result(), result)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(baseTime+postParam.DonationRefundWindowSec, 0)})
+	result = handler(ctx, NewRefundDonationMsg(string(user2), string(user2), string(user1), postID, 2))
+	assert.Equal(t, sdk.Result{}, result)
+	assert.Nil(t, gm.GetTimeEventListAtTime(ctx, eventTime))
+	saving, _ = am.GetSavingFromBank(ctx, user2)
+	assert.True(t, saving.IsEqual(donorSaving))
+
+	// donation expires at the end of refund window and its record is deleted
+	err = gm.CommitEventCache(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Event{
+		ExpireDonationEvent{Permlink: permlink, Donor: user2, DonationID: 1},
+		ExpireDonationEvent{Permlink: permlink, Donor: user2, DonationID: 2},
+	}, gm.GetTimeEventListAtTime(ctx, baseTime+postParam.DonationRefundWindowSec+1).Events)
+	for _, donationID := range []int64{1, 2} {
+		event := ExpireDonationEvent{Permlink: permlink, Donor: user2, DonationID: donationID}
+		assert.Nil(t, event.Execute(ctx, pm))
+	}
+	donations, err := pm.GetDonations(ctx, permlink, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(donations))
+
+	// refund window ends with the day of donation, since its friction
+	// can be claimed as interest once the day is over
+	donateAt := baseTime + 24*3600 - 1
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(donateAt, 0)})
+	result = handler(ctx, NewDonateMsg(string(user2), types.LNO("100"), string(user1), postID, "", memo1))
+	assert.Equal(t, sdk.Result{}, result)
+	err = gm.CommitEventCache(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []types.Event{
+		ExpireDonationEvent{Permlink: permlink, Donor: user2, DonationID: 1},
+	}, gm.GetTimeEventListAtTime(ctx, donateAt+1).Events)
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(donateAt+1, 0)})
+	result = handler(ctx, NewRefundDonationMsg(string(user2), string(user2), string(user1), postID, 1))
+	assert.Equal(t, ErrDonationRefundWindowExpired(1).Result(), result)
+}
 
 // reputation check should be added later
 func TestHandlerReportOrUpvote(t *testing.T) {
@@ -937,12 +1260,16 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 		},
 	}
 
+	totalReportCoinDay := types.NewCoinFromInt64(0)
+	totalUpvoteCoinDay := types.NewCoinFromInt64(0)
+	records := map[types.AccountKey]model.ReportOrUpvote{}
 	for _, tc := range testCases {
 		lastReportOrUpvoteAtCtx := ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.lastReportOrUpvoteAt, 0)})
 		am.UpdateLastReportOrUpvoteAt(lastReportOrUpvoteAtCtx, types.AccountKey(tc.reportOrUpvoteUser))
 
 		newCtx := ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
 		msg := NewReportOrUpvoteMsg(tc.reportOrUpvoteUser, tc.targetPostAuthor, tc.targetPostID, tc.isReport)
+		coinDay, _ := am.GetCoinDay(newCtx, msg.Username)
 
 		result := handler(newCtx, msg)
 		if !assert.Equal(t, tc.expectResult, result) {
@@ -952,25 +1279,101 @@ func TestHandlerReportOrUpvote(t *testing.T) {
 			continue
 		}
 
+		// report or upvote from the same user replaces the previous one
+		if prev, ok := records[msg.Username]; ok {
+			if prev.IsReport {
+				totalReportCoinDay = totalReportCoinDay.Minus(prev.CoinDay)
+			} else {
+				totalUpvoteCoinDay = totalUpvoteCoinDay.Minus(prev.CoinDay)
+			}
+		}
+		if tc.isReport {
+			totalReportCoinDay = totalReportCoinDay.Plus(coinDay)
+		} else {
+			totalUpvoteCoinDay = totalUpvoteCoinDay.Plus(coinDay)
+		}
+		records[msg.Username] = model.ReportOrUpvote{
+			Username:  msg.Username,
+			CoinDay:   coinDay,
+			CreatedAt: baseTime,
+			IsReport:  tc.isReport,
+		}
+
 		postMeta := model.PostMeta{
 			CreatedAt:               ctx.BlockHeader().Time.Unix(),
 			LastUpdatedAt:           ctx.BlockHeader().Time.Unix(),
 			LastActivityAt:          newCtx.BlockHeader().Time.Unix(),
 			AllowReplies:            true,
 			RedistributionSplitRate: sdk.ZeroDec(),
-			TotalReportCoinDay:      types.NewCoinFromInt64(0),
-			TotalUpvoteCoinDay:      types.NewCoinFromInt64(0),
+			TotalReportCoinDay:      totalReportCoinDay,
+			TotalUpvoteCoinDay:      totalUpvoteCoinDay,
 			TotalReward:             types.NewCoinFromInt64(0),
 		}
 		targetPost := types.GetPermlink(types.AccountKey(tc.targetPostAuthor), tc.targetPostID)
 		checkPostMeta(t, ctx, targetPost, postMeta)
 
+		reportOrUpvote, err := pm.postStorage.GetPostReportOrUpvote(ctx, targetPost, msg.Username)
+		if err != nil {
+			t.Errorf("%s: failed to get report or upvote, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, records[msg.Username], *reportOrUpvote) {
+			t.Errorf("%s: diff report or upvote, got %v, want %v", tc.testName, *reportOrUpvote, records[msg.Username])
+		}
+		userReportOrUpvotes, err := pm.GetUserReportOrUpvotes(ctx, msg.Username)
+		if err != nil {
+			t.Errorf("%s: failed to get user report or upvotes, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, []model.UserReportOrUpvote{{
+			Permlink:  targetPost,
+			CoinDay:   coinDay,
+			CreatedAt: baseTime,
+			IsReport:  tc.isReport,
+		}}, userReportOrUpvotes) {
+			t.Errorf("%s: diff user report or upvotes", tc.testName)
+		}
+
 		lastReportOrUpvoteAt, _ := am.GetLastReportOrUpvoteAt(ctx, types.AccountKey(tc.reportOrUpvoteUser))
 		// assert.Equal(t, baseTime, lastReportOrUpvoteAt)
 		if baseTime != lastReportOrUpvoteAt {
 			t.Errorf("%s: diff time, got %v, want %v", tc.testName, lastReportOrUpvoteAt, baseTime)
 		}
 	}
+
+	reportOrUpvotes, err := pm.GetReportOrUpvotes(ctx, types.GetPermlink(user1, postID))
+	assert.Nil(t, err)
+	assert.Equal(t, []model.ReportOrUpvote{records[user1], records[user2], records[user3]}, reportOrUpvotes)
+}
+
+func TestHandlerReportReplacedByUpvote(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+	postParam, _ := ph.GetPostParam(ctx)
+
+	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	permlink := types.GetPermlink(user1, postID)
+	sumRepBefore, err := rm.GetSumRep(ctx, permlink)
+	assert.Nil(t, err)
+	reputation, err := rm.GetReputation(ctx, user2)
+	assert.Nil(t, err)
+
+	baseTime := ctx.BlockHeader().Time.Unix() + postParam.ReportOrUpvoteIntervalSec
+	reportCtx := ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
+	result := handler(reportCtx, NewReportOrUpvoteMsg(string(user2), string(user1), postID, true))
+	assert.Equal(t, sdk.Result{}, result)
+	sumRep, err := rm.GetSumRep(reportCtx, permlink)
+	assert.Nil(t, err)
+	assert.True(t, sumRepBefore.Minus(reputation).IsEqual(sumRep))
+
+	// upvote replacing the report gives back reputation deducted by the report
+	upvoteCtx := ctx.WithBlockHeader(abci.Header{
+		ChainID: "Lino", Time: time.Unix(baseTime+postParam.ReportOrUpvoteIntervalSec, 0)})
+	result = handler(upvoteCtx, NewReportOrUpvoteMsg(string(user2), string(user1), postID, false))
+	assert.Equal(t, sdk.Result{}, result)
+	sumRep, err = rm.GetSumRep(upvoteCtx, permlink)
+	assert.Nil(t, err)
+	assert.True(t, sumRepBefore.IsEqual(sumRep))
+	assert.False(t, pm.IsReportedBy(upvoteCtx, permlink, user2))
 }
 
 func TestHandlerView(t *testing.T) {
@@ -1070,3 +1473,129 @@ func TestHandlerView(t *testing.T) {
 		}
 	}
 }
+
+func TestHandlerBatchView(t *testing.T) {
+	ctx, am, ph, pm, gm, dm, _, rm := setupTest(t, 1)
+	handler := NewHandler(pm, am, &gm, dm, rm)
+	postParam, _ := ph.GetPostParam(ctx)
+
+	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	app := createTestAccount(t, ctx, am, "app")
+	err := dm.RegisterDeveloper(ctx, app, types.NewCoinFromInt64(1000000*types.Decimals), "", "", "")
+	assert.Nil(t, err)
+	permlink := types.GetPermlink(user1, postID)
+	baseTime := ctx.BlockHeader().Time.Unix()
+
+	testCases := []struct {
+		testName             string
+		msg                  BatchViewMsg
+		viewTime             int64
+		expectResult         sdk.Result
+		expectTotalViewCount int64
+		expectAppViewCount   int64
+	}{
+		{
+			testName: "app reports views of user1 and user2",
+			msg: NewBatchViewMsg(string(app), []BatchView{
+				NewBatchView(string(user1), string(user1), postID, 2),
+				NewBatchView(string(user2), string(user1), postID, 3),
+			}),
+			viewTime:             baseTime,
+			expectResult:         sdk.Result{},
+			expectTotalViewCount: 5,
+			expectAppViewCount:   5,
+		},
+		{
+			testName: "app reports batch view too often",
+			msg: NewBatchViewMsg(string(app), []BatchView{
+				NewBatchView(string(user1), string(user1), postID, 1),
+			}),
+			viewTime:             baseTime + postParam.BatchViewIntervalSec - 1,
+			expectResult:         ErrBatchViewTooOften(app).Result(),
+			expectTotalViewCount: 5,
+			expectAppViewCount:   5,
+		},
+		{
+			testName: "batch view count exceeds limit",
+			msg: NewBatchViewMsg(string(app), []BatchView{
+				NewBatchView(string(user1), string(user1), postID, postParam.MaxBatchViewCount),
+				NewBatchView(string(user2), string(user1), postID, 1),
+			}),
+			viewTime:             baseTime + postParam.BatchViewIntervalSec,
+			expectResult:         ErrBatchViewCountExceedLimit(postParam.MaxBatchViewCount + 1).Result(),
+			expectTotalViewCount: 5,
+			expectAppViewCount:   5,
+		},
+		{
+			testName: "batch view with overflowing counts",
+			msg: NewBatchViewMsg(string(app), []BatchView{
+				NewBatchView(string(user1), string(user1), postID, math.MaxInt64),
+				NewBatchView(string(user2), string(user1), postID, math.MaxInt64),
+			}),
+			viewTime:             baseTime + postParam.BatchViewIntervalSec,
+			expectResult:         ErrBatchViewCountExceedLimit(math.MaxInt64).Result(),
+			expectTotalViewCount: 5,
+			expectAppViewCount:   5,
+		},
+		{
+			testName: "batch view from non developer",
+			msg: NewBatchViewMsg(string(user2), []BatchView{
+				NewBatchView(string(user1), string(user1), postID, 1),
+			}),
+			viewTime:             baseTime + postParam.BatchViewIntervalSec,
+			expectResult:         ErrDeveloperNotFound(user2).Result(),
+			expectTotalViewCount: 5,
+			expectAppViewCount:   5,
+		},
+		{
+			testName: "batch view to invalid post",
+			msg: NewBatchViewMsg(string(app), []BatchView{
+				NewBatchView(string(user1), "invalid", "invalid", 1),
+			}),
+			viewTime:             baseTime + postParam.BatchViewIntervalSec,
+			expectResult:         ErrPostNotFound(types.GetPermlink("invalid", "invalid")).Result(),
+			expectTotalViewCount: 5,
+			expectAppViewCount:   5,
+		},
+		{
+			testName: "app reports views after interval",
+			msg: NewBatchViewMsg(string(app), []BatchView{
+				NewBatchView(string(user2), string(user1), postID, 10),
+			}),
+			viewTime:             baseTime + postParam.BatchViewIntervalSec,
+			expectResult:         sdk.Result{},
+			expectTotalViewCount: 15,
+			expectAppViewCount:   15,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.viewTime, 0)})
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.expectResult, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectResult)
+		}
+
+		postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+		if err != nil {
+			t.Errorf("%s: failed to get post meta, got err %v", tc.testName, err)
+		}
+		if postMeta.TotalViewCount != tc.expectTotalViewCount {
+			t.Errorf("%s: diff total view count, got %v, want %v",
+				tc.testName, postMeta.TotalViewCount, tc.expectTotalViewCount)
+		}
+		developer, err := devModel.NewDeveloperStorage(testDeveloperKVStoreKey).GetDeveloper(ctx, app)
+		if err != nil {
+			t.Errorf("%s: failed to get developer, got err %v", tc.testName, err)
+		}
+		if developer.AppViewCount != tc.expectAppViewCount {
+			t.Errorf("%s: diff app view count, got %v, want %v",
+				tc.testName, developer.AppViewCount, tc.expectAppViewCount)
+		}
+	}
+
+	view, err := pm.postStorage.GetPostView(ctx, permlink, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(13), view.Times)
+}
@@ -82,23 +82,35 @@ func (pm PostManager) CreatePost(
 	ctx sdk.Context, author types.AccountKey, postID string,
 	sourceAuthor types.AccountKey, sourcePostID string,
 	parentAuthor types.AccountKey, parentPostID string,
-	content string, title string, redistributionSplitRate sdk.Dec,
-	links []types.IDToURLMapping) sdk.Error {
+	content string, title string, contentHash string, contentURI string,
+	redistributionSplitRate sdk.Dec, links []types.IDToURLMapping,
+	beneficiaries []types.Beneficiary, tags []string) sdk.Error {
 	postInfo := &model.PostInfo{
-		PostID:       postID,
-		Title:        title,
-		Content:      content,
-		Author:       author,
-		ParentAuthor: parentAuthor,
-		ParentPostID: parentPostID,
-		SourceAuthor: sourceAuthor,
-		SourcePostID: sourcePostID,
-		Links:        links,
+		PostID:        postID,
+		Title:         title,
+		Content:       content,
+		ContentHash:   contentHash,
+		ContentURI:    contentURI,
+		Author:        author,
+		ParentAuthor:  parentAuthor,
+		ParentPostID:  parentPostID,
+		SourceAuthor:  sourceAuthor,
+		SourcePostID:  sourcePostID,
+		Links:         links,
+		Tags:          tags,
+		Beneficiaries: beneficiaries,
 	}
 	permlink := types.GetPermlink(postInfo.Author, postInfo.PostID)
 	if pm.DoesPostExist(ctx, permlink) {
 		return ErrPostAlreadyExist(permlink)
 	}
+	if len(parentAuthor) > 0 || len(parentPostID) > 0 {
+		parentDepth, err := pm.GetCommentDepth(ctx, types.GetPermlink(parentAuthor, parentPostID))
+		if err != nil {
+			return err
+		}
+		postInfo.Depth = parentDepth + 1
+	}
 	if err := pm.setRootSourcePost(ctx, postInfo); err != nil {
 		return ErrCreatePostSourceInvalid(permlink)
 	}
@@ -116,13 +128,16 @@ func (pm PostManager) CreatePost(
 	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
 		return err
 	}
+	for _, tag := range tags {
+		pm.postStorage.SetPostTag(ctx, tag, postMeta.CreatedAt, permlink)
+	}
 	return nil
 }
 
-// UpdatePost - update post title, content and links. Can't update a deleted post
+// UpdatePost - update post title, content, links and tags. Can't update a deleted post
 func (pm PostManager) UpdatePost(
 	ctx sdk.Context, author types.AccountKey, postID, title, content string,
-	links []types.IDToURLMapping) sdk.Error {
+	contentHash, contentURI string, links []types.IDToURLMapping, tags []string) sdk.Error {
 	permlink := types.GetPermlink(author, postID)
 	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
 	if err != nil {
@@ -133,9 +148,36 @@ func (pm PostManager) UpdatePost(
 		return err
 	}
 
+	// keep previous body as a revision before overwriting
+	revisions, err := pm.postStorage.GetPostRevisions(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	revisionHash := postInfo.ContentHash
+	if revisionHash == "" {
+		revisionHash = model.GetContentHash(postInfo.Title, postInfo.Content)
+	}
+	revision := &model.Revision{
+		RevisionID:  int64(len(revisions)) + 1,
+		ContentHash: revisionHash,
+		Title:       postInfo.Title,
+		Content:     postInfo.Content,
+		ContentURI:  postInfo.ContentURI,
+		Links:       postInfo.Links,
+		CreatedAt:   postMeta.LastUpdatedAt,
+		ReplacedAt:  ctx.BlockHeader().Time.Unix(),
+	}
+	if err := pm.postStorage.SetPostRevision(ctx, permlink, revision); err != nil {
+		return err
+	}
+
 	postInfo.Title = title
 	postInfo.Content = content
+	postInfo.ContentHash = contentHash
+	postInfo.ContentURI = contentURI
 	postInfo.Links = links
+	pm.updatePostTags(ctx, permlink, postMeta.CreatedAt, postInfo.Tags, tags)
+	postInfo.Tags = tags
 	// postMeta.RedistributionSplitRate = redistributionSplitRate
 	postMeta.LastUpdatedAt = ctx.BlockHeader().Time.Unix()
 
@@ -148,9 +190,39 @@ func (pm PostManager) UpdatePost(
 	return nil
 }
 
-// AddOrUpdateViewToPost - add or update view from the user if view exists
+// updatePostTags - keep tag index consistent when post tags change
+func (pm PostManager) updatePostTags(
+	ctx sdk.Context, permlink types.Permlink, createdAt int64, oldTags, newTags []string) {
+	contains := func(tags []string, tag string) bool {
+		for _, t := range tags {
+			if t == tag {
+				return true
+			}
+		}
+		return false
+	}
+	for _, tag := range oldTags {
+		if !contains(newTags, tag) {
+			pm.postStorage.RemovePostTag(ctx, tag, createdAt, permlink)
+		}
+	}
+	for _, tag := range newTags {
+		if !contains(oldTags, tag) {
+			pm.postStorage.SetPostTag(ctx, tag, createdAt, permlink)
+		}
+	}
+}
+
+// GetTagPosts - get posts with the tag ordered by created time, paginated by offset and limit
+func (pm PostManager) GetTagPosts(
+	ctx sdk.Context, tag string, offset, limit int64) []model.TagPost {
+	return pm.postStorage.GetTagPosts(ctx, tag, offset, limit)
+}
+
+// AddOrUpdateViewToPost - add or update view from the user if view exists,
+// times is the number of views to add
 func (pm PostManager) AddOrUpdateViewToPost(
-	ctx sdk.Context, permlink types.Permlink, user types.AccountKey) sdk.Error {
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey, times int64) sdk.Error {
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
 	if err != nil {
 		return err
@@ -160,8 +232,8 @@ func (pm PostManager) AddOrUpdateViewToPost(
 	if view == nil {
 		view = &model.View{Username: user}
 	}
-	postMeta.TotalViewCount++
-	view.Times++
+	postMeta.TotalViewCount += times
+	view.Times += times
 	view.LastViewAt = ctx.BlockHeader().Time.Unix()
 	if err := pm.postStorage.SetPostView(ctx, permlink, view); err != nil {
 		return err
@@ -172,13 +244,15 @@ func (pm PostManager) AddOrUpdateViewToPost(
 	return nil
 }
 
-// add comment to post comment list
+// add comment to post comment list, depth is the depth of the comment in the thread
 func (pm PostManager) AddComment(
-	ctx sdk.Context, permlink types.Permlink, commentAuthor types.AccountKey, commentPostID string) sdk.Error {
+	ctx sdk.Context, permlink types.Permlink, commentAuthor types.AccountKey,
+	commentPostID string, depth int64) sdk.Error {
 	comment := &model.Comment{
 		Author:    commentAuthor,
 		PostID:    commentPostID,
 		CreatedAt: ctx.BlockHeader().Time.Unix(),
+		Depth:     depth,
 	}
 	if err := pm.postStorage.SetPostComment(ctx, permlink, comment); err != nil {
 		return err
@@ -211,6 +285,156 @@ func (pm PostManager) AddDonation(
 	return nil
 }
 
+// MinusDonation - minus refunded direct deposit from post
+func (pm PostManager) MinusDonation(
+	ctx sdk.Context, permlink types.Permlink, amount types.Coin) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postMeta.TotalReward = postMeta.TotalReward.Minus(amount)
+	postMeta.TotalDonateCount = postMeta.TotalDonateCount - 1
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AddDonationRecord - keep the donation from donor to the post for refund, returns donation id
+func (pm PostManager) AddDonationRecord(
+	ctx sdk.Context, permlink types.Permlink, donor types.AccountKey, amount types.Coin,
+	fromApp types.AccountKey, parts []model.DonationPart) (int64, sdk.Error) {
+	donations, err := pm.postStorage.GetPostDonations(ctx, permlink, donor)
+	if err != nil {
+		return 0, err
+	}
+	// expired donations are deleted, donation id follows the latest one kept
+	donationID := int64(1)
+	if len(donations) > 0 {
+		donationID = donations[len(donations)-1].DonationID + 1
+	}
+	donation := &model.Donation{
+		DonationID: donationID,
+		Donor:      donor,
+		Amount:     amount,
+		FromApp:    fromApp,
+		CreatedAt:  ctx.BlockHeader().Time.Unix(),
+		Parts:      parts,
+	}
+	if err := pm.postStorage.SetPostDonation(ctx, permlink, donation); err != nil {
+		return 0, err
+	}
+	return donation.DonationID, nil
+}
+
+// GetDonation - get donation from donor to the post
+func (pm PostManager) GetDonation(
+	ctx sdk.Context, permlink types.Permlink, donor types.AccountKey, donationID int64) (*model.Donation, sdk.Error) {
+	return pm.postStorage.GetPostDonation(ctx, permlink, donor, donationID)
+}
+
+// GetDonations - get all donations from donor to the post
+func (pm PostManager) GetDonations(
+	ctx sdk.Context, permlink types.Permlink, donor types.AccountKey) ([]model.Donation, sdk.Error) {
+	return pm.postStorage.GetPostDonations(ctx, permlink, donor)
+}
+
+// SetDonationRefunded - mark donation from donor to the post as refunded
+func (pm PostManager) SetDonationRefunded(
+	ctx sdk.Context, permlink types.Permlink, donor types.AccountKey, donationID int64) sdk.Error {
+	donation, err := pm.postStorage.GetPostDonation(ctx, permlink, donor, donationID)
+	if err != nil {
+		return err
+	}
+	donation.IsRefunded = true
+	return pm.postStorage.SetPostDonation(ctx, permlink, donation)
+}
+
+// DeleteDonationRecord - delete donation kept for refund once its refund window is over
+func (pm PostManager) DeleteDonationRecord(
+	ctx sdk.Context, permlink types.Permlink, donor types.AccountKey, donationID int64) {
+	pm.postStorage.DeletePostDonation(ctx, permlink, donor, donationID)
+}
+
+// GetBeneficiaryShares - split coin among post beneficiaries by weight, rounding
+// remainder goes to the last beneficiary. Post without beneficiaries pays author.
+func (pm PostManager) GetBeneficiaryShares(
+	ctx sdk.Context, permlink types.Permlink, coin types.Coin) ([]types.AccountKey, []types.Coin, sdk.Error) {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(postInfo.Beneficiaries) == 0 {
+		return []types.AccountKey{postInfo.Author}, []types.Coin{coin}, nil
+	}
+	beneficiaries := []types.AccountKey{}
+	shares := []types.Coin{}
+	remain := coin
+	for i, beneficiary := range postInfo.Beneficiaries {
+		share := remain
+		if i != len(postInfo.Beneficiaries)-1 {
+			weight, err := sdk.NewDecFromStr(beneficiary.Weight)
+			if err != nil {
+				return nil, nil, ErrInvalidBeneficiaryWeight()
+			}
+			share = types.DecToCoin(coin.ToDec().Mul(weight))
+		}
+		remain = remain.Minus(share)
+		beneficiaries = append(beneficiaries, beneficiary.Username)
+		shares = append(shares, share)
+	}
+	return beneficiaries, shares, nil
+}
+
+// AddBeneficiaryReward - record income of beneficiary from the post,
+// post without beneficiaries doesn't keep the record
+func (pm PostManager) AddBeneficiaryReward(
+	ctx sdk.Context, permlink types.Permlink, beneficiary types.AccountKey,
+	directDeposit, inflation types.Coin) sdk.Error {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if len(postInfo.Beneficiaries) == 0 {
+		return nil
+	}
+	reward, _ := pm.postStorage.GetBeneficiaryReward(ctx, permlink, beneficiary)
+	if reward == nil {
+		reward = &model.BeneficiaryReward{
+			Username:      beneficiary,
+			DirectDeposit: types.NewCoinFromInt64(0),
+			Inflation:     types.NewCoinFromInt64(0),
+		}
+	}
+	reward.DirectDeposit = reward.DirectDeposit.Plus(directDeposit)
+	reward.Inflation = reward.Inflation.Plus(inflation)
+	return pm.postStorage.SetBeneficiaryReward(ctx, permlink, reward)
+}
+
+// MinusBeneficiaryReward - minus refunded direct deposit from beneficiary income record
+func (pm PostManager) MinusBeneficiaryReward(
+	ctx sdk.Context, permlink types.Permlink, beneficiary types.AccountKey, directDeposit types.Coin) sdk.Error {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if len(postInfo.Beneficiaries) == 0 {
+		return nil
+	}
+	reward, err := pm.postStorage.GetBeneficiaryReward(ctx, permlink, beneficiary)
+	if err != nil {
+		return err
+	}
+	reward.DirectDeposit = reward.DirectDeposit.Minus(directDeposit)
+	return pm.postStorage.SetBeneficiaryReward(ctx, permlink, reward)
+}
+
+// GetBeneficiaryRewards - get income of all beneficiaries from the post
+func (pm PostManager) GetBeneficiaryRewards(
+	ctx sdk.Context, permlink types.Permlink) ([]model.BeneficiaryReward, sdk.Error) {
+	return pm.postStorage.GetBeneficiaryRewards(ctx, permlink)
+}
+
 // DeletePost - delete post by author or content censorship
 func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.Error {
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
@@ -229,14 +453,203 @@ func (pm PostManager) DeletePost(ctx sdk.Context, permlink types.Permlink) sdk.E
 	}
 	postInfo.Title = ""
 	postInfo.Content = ""
+	postInfo.ContentURI = ""
 	postInfo.Links = nil
+	pm.updatePostTags(ctx, permlink, postMeta.CreatedAt, postInfo.Tags, nil)
+	postInfo.Tags = nil
 
 	if err := pm.postStorage.SetPostInfo(ctx, postInfo); err != nil {
 		return err
 	}
+	// previous bodies are removed with the post
+	revisions, err := pm.postStorage.GetPostRevisions(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	for _, revision := range revisions {
+		if err := pm.DeletePostRevision(ctx, permlink, revision.RevisionID); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// DoesPostRevisionExist - check if revision of the post exists
+func (pm PostManager) DoesPostRevisionExist(
+	ctx sdk.Context, permlink types.Permlink, revisionID int64) bool {
+	revision, _ := pm.postStorage.GetPostRevision(ctx, permlink, revisionID)
+	return revision != nil
+}
+
+// GetPostRevision - get a revision of the post
+func (pm PostManager) GetPostRevision(
+	ctx sdk.Context, permlink types.Permlink, revisionID int64) (*model.Revision, sdk.Error) {
+	return pm.postStorage.GetPostRevision(ctx, permlink, revisionID)
+}
+
+// GetPostRevisions - get all revisions of the post, ordered by revision id
+func (pm PostManager) GetPostRevisions(
+	ctx sdk.Context, permlink types.Permlink) ([]model.Revision, sdk.Error) {
+	return pm.postStorage.GetPostRevisions(ctx, permlink)
+}
+
+// DeletePostRevision - remove body of a revision by author or content censorship,
+// content hash is kept
+func (pm PostManager) DeletePostRevision(
+	ctx sdk.Context, permlink types.Permlink, revisionID int64) sdk.Error {
+	revision, err := pm.postStorage.GetPostRevision(ctx, permlink, revisionID)
+	if err != nil {
+		return err
+	}
+	revision.Title = ""
+	revision.Content = ""
+	revision.ContentURI = ""
+	revision.Links = nil
+	revision.IsDeleted = true
+	return pm.postStorage.SetPostRevision(ctx, permlink, revision)
+}
+
+// CensorPost - delete post by content censorship, body of the post and its
+// revisions is retained so it can be restored by an appeal
+func (pm PostManager) CensorPost(ctx sdk.Context, permlink types.Permlink) sdk.Error {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	revisions, err := pm.postStorage.GetPostRevisions(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	// revisions censored before are not restored with the post
+	retained := []model.Revision{}
+	for _, revision := range revisions {
+		if !revision.IsDeleted {
+			retained = append(retained, revision)
+		}
+	}
+	if err := pm.postStorage.SetCensoredContent(ctx, &model.CensoredContent{
+		Permlink:                permlink,
+		Revision:                0,
+		Author:                  postInfo.Author,
+		Title:                   postInfo.Title,
+		Content:                 postInfo.Content,
+		ContentURI:              postInfo.ContentURI,
+		Links:                   postInfo.Links,
+		Tags:                    postInfo.Tags,
+		RedistributionSplitRate: postMeta.RedistributionSplitRate,
+		Revisions:               retained,
+		CensoredAt:              ctx.BlockHeader().Time.Unix(),
+	}); err != nil {
+		return err
+	}
+	return pm.DeletePost(ctx, permlink)
+}
+
+// CensorPostRevision - remove body of a revision by content censorship,
+// the body is retained so it can be restored by an appeal
+func (pm PostManager) CensorPostRevision(
+	ctx sdk.Context, permlink types.Permlink, revisionID int64) sdk.Error {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	revision, err := pm.postStorage.GetPostRevision(ctx, permlink, revisionID)
+	if err != nil {
+		return err
+	}
+	if err := pm.postStorage.SetCensoredContent(ctx, &model.CensoredContent{
+		Permlink:                permlink,
+		Revision:                revisionID,
+		Author:                  postInfo.Author,
+		RedistributionSplitRate: postMeta.RedistributionSplitRate,
+		Revisions:               []model.Revision{*revision},
+		CensoredAt:              ctx.BlockHeader().Time.Unix(),
+	}); err != nil {
+		return err
+	}
+	return pm.DeletePostRevision(ctx, permlink, revisionID)
+}
+
+// GetCensoredContent - get content retained when the post or revision was censored
+func (pm PostManager) GetCensoredContent(
+	ctx sdk.Context, permlink types.Permlink, revisionID int64) (*model.CensoredContent, sdk.Error) {
+	return pm.postStorage.GetCensoredContent(ctx, permlink, revisionID)
+}
+
+// IsCensoredPost - check if a post is removed by content censorship and not restored yet
+func (pm PostManager) IsCensoredPost(ctx sdk.Context, permlink types.Permlink) bool {
+	_, err := pm.postStorage.GetCensoredContent(ctx, permlink, 0)
+	return err == nil
+}
+
+// AddLostReward - record reward a censored post lost to censorship penalty,
+// it is paid back if the censorship is overturned
+func (pm PostManager) AddLostReward(
+	ctx sdk.Context, permlink types.Permlink, lostReward model.LostReward) sdk.Error {
+	censored, err := pm.postStorage.GetCensoredContent(ctx, permlink, 0)
+	if err != nil {
+		return err
+	}
+	censored.LostRewards = append(censored.LostRewards, lostReward)
+	return pm.postStorage.SetCensoredContent(ctx, censored)
+}
+
+// RestoreCensoredContent - restore censored post or revision from retained content,
+// reward events still pending are no longer penalized once the post is restored.
+// Rewards already lost to censorship are returned to be paid back by caller.
+// A censored revision of a post deleted since then is kept removed.
+func (pm PostManager) RestoreCensoredContent(
+	ctx sdk.Context, permlink types.Permlink, revisionID int64) ([]model.LostReward, sdk.Error) {
+	censored, err := pm.postStorage.GetCensoredContent(ctx, permlink, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return nil, err
+	}
+	if revisionID != 0 && postMeta.IsDeleted {
+		return nil, nil
+	}
+
+	if revisionID == 0 {
+		postMeta.IsDeleted = false
+		postMeta.RedistributionSplitRate = censored.RedistributionSplitRate
+		postMeta.LastUpdatedAt = ctx.BlockHeader().Time.Unix()
+		if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+			return nil, err
+		}
+		postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+		if err != nil {
+			return nil, err
+		}
+		postInfo.Title = censored.Title
+		postInfo.Content = censored.Content
+		postInfo.ContentURI = censored.ContentURI
+		postInfo.Links = censored.Links
+		pm.updatePostTags(ctx, permlink, postMeta.CreatedAt, nil, censored.Tags)
+		postInfo.Tags = censored.Tags
+		if err := pm.postStorage.SetPostInfo(ctx, postInfo); err != nil {
+			return nil, err
+		}
+	}
+	for i := range censored.Revisions {
+		if err := pm.postStorage.SetPostRevision(ctx, permlink, &censored.Revisions[i]); err != nil {
+			return nil, err
+		}
+	}
+	pm.postStorage.DeleteCensoredContent(ctx, permlink, revisionID)
+	return censored.LostRewards, nil
+}
+
 // IsDeleted - check if a post is deleted or not
 func (pm PostManager) IsDeleted(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
@@ -246,6 +659,50 @@ func (pm PostManager) IsDeleted(ctx sdk.Context, permlink types.Permlink) (bool,
 	return postMeta.IsDeleted, nil
 }
 
+// IsRepliesAllowed - check if post accepts comments
+func (pm PostManager) IsRepliesAllowed(ctx sdk.Context, permlink types.Permlink) (bool, sdk.Error) {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return false, err
+	}
+	return postMeta.AllowReplies, nil
+}
+
+// SetAllowReplies - allow or disallow comments to the post
+func (pm PostManager) SetAllowReplies(
+	ctx sdk.Context, permlink types.Permlink, allowReplies bool) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	postMeta.AllowReplies = allowReplies
+	return pm.postStorage.SetPostMeta(ctx, permlink, postMeta)
+}
+
+// GetCommentDepth - depth of the post in its thread stored when the post is created,
+// root post is at depth 0
+func (pm PostManager) GetCommentDepth(ctx sdk.Context, permlink types.Permlink) (int64, sdk.Error) {
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	if err != nil {
+		return 0, err
+	}
+	if postInfo.Depth > 0 || (len(postInfo.ParentAuthor) == 0 && len(postInfo.ParentPostID) == 0) {
+		return postInfo.Depth, nil
+	}
+	// comment created before depth was stored, its depth follows its parent
+	parentDepth, err := pm.GetCommentDepth(
+		ctx, types.GetPermlink(postInfo.ParentAuthor, postInfo.ParentPostID))
+	if err != nil {
+		return 0, err
+	}
+	return parentDepth + 1, nil
+}
+
+// GetComments - get all comments to the post ordered by created time
+func (pm PostManager) GetComments(ctx sdk.Context, permlink types.Permlink) ([]model.Comment, sdk.Error) {
+	return pm.postStorage.GetPostComments(ctx, permlink)
+}
+
 // UpdateLastActivityAt - update post last activity at
 func (pm PostManager) UpdateLastActivityAt(ctx sdk.Context, permlink types.Permlink) sdk.Error {
 	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
@@ -259,6 +716,63 @@ func (pm PostManager) UpdateLastActivityAt(ctx sdk.Context, permlink types.Perml
 	return nil
 }
 
+// ReportOrUpvoteToPost - record report or upvote from user with coin day at the time,
+// previous report or upvote from the same user is replaced
+func (pm PostManager) ReportOrUpvoteToPost(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey,
+	coinDay types.Coin, isReport bool) sdk.Error {
+	postMeta, err := pm.postStorage.GetPostMeta(ctx, permlink)
+	if err != nil {
+		return err
+	}
+	if prev, _ := pm.postStorage.GetPostReportOrUpvote(ctx, permlink, user); prev != nil {
+		if prev.IsReport {
+			postMeta.TotalReportCoinDay = postMeta.TotalReportCoinDay.Minus(prev.CoinDay)
+		} else {
+			postMeta.TotalUpvoteCoinDay = postMeta.TotalUpvoteCoinDay.Minus(prev.CoinDay)
+		}
+	}
+	if isReport {
+		postMeta.TotalReportCoinDay = postMeta.TotalReportCoinDay.Plus(coinDay)
+	} else {
+		postMeta.TotalUpvoteCoinDay = postMeta.TotalUpvoteCoinDay.Plus(coinDay)
+	}
+	postMeta.LastActivityAt = ctx.BlockHeader().Time.Unix()
+
+	reportOrUpvote := &model.ReportOrUpvote{
+		Username:  user,
+		CoinDay:   coinDay,
+		CreatedAt: ctx.BlockHeader().Time.Unix(),
+		IsReport:  isReport,
+	}
+	if err := pm.postStorage.SetPostReportOrUpvote(ctx, permlink, reportOrUpvote); err != nil {
+		return err
+	}
+	if err := pm.postStorage.SetPostMeta(ctx, permlink, postMeta); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsReportedBy - return true if the latest report or upvote of user to the post is a report
+func (pm PostManager) IsReportedBy(
+	ctx sdk.Context, permlink types.Permlink, user types.AccountKey) bool {
+	prev, _ := pm.postStorage.GetPostReportOrUpvote(ctx, permlink, user)
+	return prev != nil && prev.IsReport
+}
+
+// GetReportOrUpvotes - get all reports and upvotes to the post
+func (pm PostManager) GetReportOrUpvotes(
+	ctx sdk.Context, permlink types.Permlink) ([]model.ReportOrUpvote, sdk.Error) {
+	return pm.postStorage.GetPostReportOrUpvotes(ctx, permlink)
+}
+
+// GetUserReportOrUpvotes - get all reports and upvotes made by the user
+func (pm PostManager) GetUserReportOrUpvotes(
+	ctx sdk.Context, user types.AccountKey) ([]model.UserReportOrUpvote, sdk.Error) {
+	return pm.postStorage.GetUserReportOrUpvotes(ctx, user)
+}
+
 // GetPenaltyScore - get penalty score from report and upvote
 func (pm PostManager) GetPenaltyScore(ctx sdk.Context, reputation types.Coin) (sdk.Dec, sdk.Error) {
 	if reputation.IsNotNegative() {
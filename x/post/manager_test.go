@@ -2,6 +2,7 @@ package post
 
 import (
 	"math/big"
+	"strconv"
 	"testing"
 	"time"
 
@@ -98,8 +99,8 @@ func TestCreatePost(t *testing.T) {
 		}
 		err := pm.CreatePost(
 			ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
-			msg.ParentAuthor, msg.ParentPostID, msg.Content,
-			msg.Title, sdk.ZeroDec(), msg.Links)
+			msg.ParentAuthor, msg.ParentPostID, msg.Content, msg.Title,
+			msg.ContentHash, msg.ContentURI, sdk.ZeroDec(), msg.Links, msg.Beneficiaries, msg.Tags)
 		if !assert.Equal(t, err, tc.expectResult) {
 			t.Errorf("%s: diff result, got %v, want %v", tc.testName, err, tc.expectResult)
 		}
@@ -175,7 +176,8 @@ func TestUpdatePost(t *testing.T) {
 		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(tc.updateTime, 0)})
 
 		err := pm.UpdatePost(
-			ctx, tc.msg.Author, tc.msg.PostID, tc.msg.Title, tc.msg.Content, tc.msg.Links)
+			ctx, tc.msg.Author, tc.msg.PostID, tc.msg.Title, tc.msg.Content,
+			tc.msg.ContentHash, tc.msg.ContentURI, tc.msg.Links, tc.msg.Tags)
 		if !assert.Equal(t, err, tc.expectErr) {
 			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectErr)
 		}
@@ -278,8 +280,8 @@ func TestGetSourcePost(t *testing.T) {
 		}
 		err := pm.CreatePost(
 			ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
-			msg.ParentAuthor, msg.ParentPostID, msg.Content,
-			msg.Title, sdk.ZeroDec(), msg.Links)
+			msg.ParentAuthor, msg.ParentPostID, msg.Content, msg.Title,
+			msg.ContentHash, msg.ContentURI, sdk.ZeroDec(), msg.Links, msg.Beneficiaries, msg.Tags)
 		if err != nil {
 			t.Errorf("%s: failed to create post, got err %v", tc.testName, err)
 		}
@@ -364,7 +366,7 @@ func TestAddOrUpdateViewToPost(t *testing.T) {
 	for _, tc := range testCases {
 		postKey := types.GetPermlink(tc.author, tc.postID)
 		ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(tc.viewTime, 0)})
-		err := pm.AddOrUpdateViewToPost(ctx, postKey, tc.viewUser)
+		err := pm.AddOrUpdateViewToPost(ctx, postKey, tc.viewUser, 1)
 		if err != nil {
 			t.Errorf("%s: failed to add or update view to post, got err %v", tc.testName, err)
 		}
@@ -546,3 +548,323 @@ func TestDeletePost(t *testing.T) {
 	assert.Nil(t, err)
 	checkIsDelete(t, ctx, pm, types.GetPermlink(user, postID))
 }
+
+func TestGetCommentDepth(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	root := types.GetPermlink(user, postID)
+
+	parentID := postID
+	for depth := int64(1); depth <= 3; depth++ {
+		commentID := "comment" + strconv.FormatInt(depth, 10)
+		err := pm.CreatePost(
+			ctx, user, commentID, "", "", user, parentID, "content", "title",
+			"", "", sdk.ZeroDec(), nil, nil, nil)
+		assert.Nil(t, err)
+		parentID = commentID
+		postInfo, err := pm.postStorage.GetPostInfo(ctx, types.GetPermlink(user, commentID))
+		assert.Nil(t, err)
+		assert.Equal(t, depth, postInfo.Depth)
+	}
+
+	// comment stored before depth was recorded follows depth of its parent
+	parent := types.GetPermlink(user, parentID)
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, parent)
+	assert.Nil(t, err)
+	postInfo.Depth = 0
+	assert.Nil(t, pm.postStorage.SetPostInfo(ctx, postInfo))
+	depth, err := pm.GetCommentDepth(ctx, parent)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), depth)
+
+	depth, err = pm.GetCommentDepth(ctx, root)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), depth)
+}
+
+func TestPostRevision(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	baseTime := time.Now().Unix()
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
+	user, postID := createTestPost(t, ctx, "user", "postID", am, pm, "0")
+	permlink := types.GetPermlink(user, postID)
+	originalTitle, originalContent := string(make([]byte, 50)), string(make([]byte, 1000))
+
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime+10, 0)})
+	err := pm.UpdatePost(ctx, user, postID, "title1", "content1", "", "", nil, nil)
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime+20, 0)})
+	err = pm.UpdatePost(ctx, user, postID, "title2", "content2", "", "", nil, nil)
+	assert.Nil(t, err)
+
+	revisions, err := pm.GetPostRevisions(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Revision{
+		{
+			RevisionID:  1,
+			ContentHash: model.GetContentHash(originalTitle, originalContent),
+			Title:       originalTitle,
+			Content:     originalContent,
+			CreatedAt:   baseTime,
+			ReplacedAt:  baseTime + 10,
+		},
+		{
+			RevisionID:  2,
+			ContentHash: model.GetContentHash("title1", "content1"),
+			Title:       "title1",
+			Content:     "content1",
+			CreatedAt:   baseTime + 10,
+			ReplacedAt:  baseTime + 20,
+		},
+	}, revisions)
+	assert.False(t, pm.DoesPostRevisionExist(ctx, permlink, 3))
+
+	// censor the first revision only
+	err = pm.DeletePostRevision(ctx, permlink, 1)
+	assert.Nil(t, err)
+	revision, err := pm.GetPostRevision(ctx, permlink, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, model.Revision{
+		RevisionID:  1,
+		ContentHash: model.GetContentHash(originalTitle, originalContent),
+		CreatedAt:   baseTime,
+		ReplacedAt:  baseTime + 10,
+		IsDeleted:   true,
+	}, *revision)
+	revision, err = pm.GetPostRevision(ctx, permlink, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, "content1", revision.Content)
+
+	// delete post removes all previous bodies
+	err = pm.DeletePost(ctx, permlink)
+	assert.Nil(t, err)
+	revisions, err = pm.GetPostRevisions(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(revisions))
+	for _, revision := range revisions {
+		assert.True(t, revision.IsDeleted)
+		assert.Equal(t, "", revision.Content)
+	}
+}
+
+func TestCensorAndRestorePost(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	baseTime := time.Now().Unix()
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime, 0)})
+	user := createTestAccount(t, ctx, am, "user")
+	permlink := types.GetPermlink(user, "postID")
+	splitRate := types.NewDecFromRat(1, 2)
+	err := pm.CreatePost(
+		ctx, user, "postID", "", "", "", "", "content", "title", "", "",
+		splitRate, []types.IDToURLMapping{}, nil, []string{"gaming"})
+	assert.Nil(t, err)
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime+10, 0)})
+	err = pm.UpdatePost(ctx, user, "postID", "title1", "content1", "", "", nil, []string{"gaming"})
+	assert.Nil(t, err)
+	revision, err := pm.GetPostRevision(ctx, permlink, 1)
+	assert.Nil(t, err)
+	originalRevision := *revision
+
+	// censor revision and then the whole post
+	ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(baseTime+20, 0)})
+	err = pm.CensorPostRevision(ctx, permlink, 1)
+	assert.Nil(t, err)
+	censored, err := pm.GetCensoredContent(ctx, permlink, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, user, censored.Author)
+	assert.Equal(t, []model.Revision{originalRevision}, censored.Revisions)
+	err = pm.CensorPost(ctx, permlink)
+	assert.Nil(t, err)
+	checkIsDelete(t, ctx, pm, permlink)
+	assert.Equal(t, []model.TagPost{}, pm.GetTagPosts(ctx, "gaming", 0, 100))
+	censored, err = pm.GetCensoredContent(ctx, permlink, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, "title1", censored.Title)
+	assert.Equal(t, "content1", censored.Content)
+	assert.Equal(t, []string{"gaming"}, censored.Tags)
+	assert.True(t, splitRate.Equal(censored.RedistributionSplitRate))
+	assert.Equal(t, baseTime+20, censored.CensoredAt)
+	// revision censored before is not retained with the post
+	assert.Equal(t, 0, len(censored.Revisions))
+
+	// restore the post, revision censored separately is still removed
+	_, err = pm.RestoreCensoredContent(ctx, permlink, 0)
+	assert.Nil(t, err)
+	isDeleted, err := pm.IsDeleted(ctx, permlink)
+	assert.Nil(t, err)
+	assert.False(t, isDeleted)
+	rate, err := pm.GetRedistributionSplitRate(ctx, permlink)
+	assert.Nil(t, err)
+	assert.True(t, splitRate.Equal(rate))
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink)
+	assert.Nil(t, err)
+	assert.Equal(t, "title1", postInfo.Title)
+	assert.Equal(t, "content1", postInfo.Content)
+	assert.Equal(t, []string{"gaming"}, postInfo.Tags)
+	assert.Equal(t, []model.TagPost{
+		{Permlink: permlink, CreatedAt: baseTime},
+	}, pm.GetTagPosts(ctx, "gaming", 0, 100))
+	revision, err = pm.GetPostRevision(ctx, permlink, 1)
+	assert.Nil(t, err)
+	assert.True(t, revision.IsDeleted)
+	_, err = pm.GetCensoredContent(ctx, permlink, 0)
+	assert.NotNil(t, err)
+
+	// restore the revision
+	_, err = pm.RestoreCensoredContent(ctx, permlink, 1)
+	assert.Nil(t, err)
+	revision, err = pm.GetPostRevision(ctx, permlink, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, originalRevision, *revision)
+
+	// revision of a post deleted since censorship is kept removed
+	err = pm.CensorPostRevision(ctx, permlink, 1)
+	assert.Nil(t, err)
+	err = pm.DeletePost(ctx, permlink)
+	assert.Nil(t, err)
+	_, err = pm.RestoreCensoredContent(ctx, permlink, 1)
+	assert.Nil(t, err)
+	revision, err = pm.GetPostRevision(ctx, permlink, 1)
+	assert.Nil(t, err)
+	assert.True(t, revision.IsDeleted)
+}
+
+func TestBeneficiaryShares(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	user1, postID := createTestPost(t, ctx, "user1", "postID", am, pm, "0")
+	user2 := createTestAccount(t, ctx, am, "user2")
+	user3 := createTestAccount(t, ctx, am, "user3")
+	beneficiaries := []types.Beneficiary{
+		{Username: user1, Weight: "0.333"},
+		{Username: user2, Weight: "0.333"},
+		{Username: user3, Weight: "0.334"},
+	}
+	err := pm.CreatePost(
+		ctx, user1, "cohosted", "", "", "", "",
+		string(make([]byte, 1000)), string(make([]byte, 50)), "", "",
+		sdk.ZeroDec(), []types.IDToURLMapping{}, beneficiaries, nil)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName            string
+		permlink            types.Permlink
+		coin                types.Coin
+		expectBeneficiaries []types.AccountKey
+		expectShares        []types.Coin
+	}{
+		{
+			testName:            "post without beneficiaries pays author",
+			permlink:            types.GetPermlink(user1, postID),
+			coin:                types.NewCoinFromInt64(100),
+			expectBeneficiaries: []types.AccountKey{user1},
+			expectShares:        []types.Coin{types.NewCoinFromInt64(100)},
+		},
+		{
+			testName:            "split among beneficiaries by weight",
+			permlink:            types.GetPermlink(user1, "cohosted"),
+			coin:                types.NewCoinFromInt64(1000),
+			expectBeneficiaries: []types.AccountKey{user1, user2, user3},
+			expectShares: []types.Coin{
+				types.NewCoinFromInt64(333), types.NewCoinFromInt64(333), types.NewCoinFromInt64(334)},
+		},
+		{
+			testName:            "rounding remainder goes to last beneficiary",
+			permlink:            types.GetPermlink(user1, "cohosted"),
+			coin:                types.NewCoinFromInt64(10),
+			expectBeneficiaries: []types.AccountKey{user1, user2, user3},
+			expectShares: []types.Coin{
+				types.NewCoinFromInt64(3), types.NewCoinFromInt64(3), types.NewCoinFromInt64(4)},
+		},
+	}
+	for _, tc := range testCases {
+		beneficiaries, shares, err := pm.GetBeneficiaryShares(ctx, tc.permlink, tc.coin)
+		if err != nil {
+			t.Errorf("%s: failed to get beneficiary shares, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectBeneficiaries, beneficiaries) {
+			t.Errorf("%s: diff beneficiaries, got %v, want %v", tc.testName, beneficiaries, tc.expectBeneficiaries)
+		}
+		if !assert.Equal(t, tc.expectShares, shares) {
+			t.Errorf("%s: diff shares, got %v, want %v", tc.testName, shares, tc.expectShares)
+		}
+	}
+
+	// only post with beneficiaries keeps beneficiary reward
+	err = pm.AddBeneficiaryReward(
+		ctx, types.GetPermlink(user1, postID), user1, types.NewCoinFromInt64(1), types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	rewards, err := pm.GetBeneficiaryRewards(ctx, types.GetPermlink(user1, postID))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(rewards))
+
+	err = pm.AddBeneficiaryReward(
+		ctx, types.GetPermlink(user1, "cohosted"), user2, types.NewCoinFromInt64(1), types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	err = pm.AddBeneficiaryReward(
+		ctx, types.GetPermlink(user1, "cohosted"), user2, types.NewCoinFromInt64(0), types.NewCoinFromInt64(2))
+	assert.Nil(t, err)
+	rewards, err = pm.GetBeneficiaryRewards(ctx, types.GetPermlink(user1, "cohosted"))
+	assert.Nil(t, err)
+	assert.Equal(t, []model.BeneficiaryReward{
+		{
+			Username:      user2,
+			DirectDeposit: types.NewCoinFromInt64(1),
+			Inflation:     types.NewCoinFromInt64(2),
+		},
+	}, rewards)
+}
+
+func TestPostTagIndex(t *testing.T) {
+	ctx, am, _, pm, _, _, _, _ := setupTest(t, 1)
+	baseTime := time.Now().Unix()
+	user := createTestAccount(t, ctx, am, "user")
+
+	createPost := func(postID string, createdAt int64, tags []string) types.Permlink {
+		ctx = ctx.WithBlockHeader(abci.Header{ChainID: "Lino", Time: time.Unix(createdAt, 0)})
+		err := pm.CreatePost(
+			ctx, user, postID, "", "", "", "", "content", "title", "", "",
+			sdk.ZeroDec(), []types.IDToURLMapping{}, nil, tags)
+		assert.Nil(t, err)
+		return types.GetPermlink(user, postID)
+	}
+	permlink1 := createPost("post1", baseTime+20, []string{"gaming", "music"})
+	permlink2 := createPost("post2", baseTime, []string{"gaming"})
+	permlink3 := createPost("post3", baseTime+10, []string{"gaming"})
+
+	// posts are ordered by created time
+	assert.Equal(t, []model.TagPost{
+		{Permlink: permlink2, CreatedAt: baseTime},
+		{Permlink: permlink3, CreatedAt: baseTime + 10},
+		{Permlink: permlink1, CreatedAt: baseTime + 20},
+	}, pm.GetTagPosts(ctx, "gaming", 0, 100))
+	assert.Equal(t, []model.TagPost{
+		{Permlink: permlink3, CreatedAt: baseTime + 10},
+	}, pm.GetTagPosts(ctx, "gaming", 1, 1))
+	assert.Equal(t, []model.TagPost{}, pm.GetTagPosts(ctx, "gaming", 3, 100))
+	assert.Equal(t, []model.TagPost{
+		{Permlink: permlink1, CreatedAt: baseTime + 20},
+	}, pm.GetTagPosts(ctx, "music", 0, 100))
+
+	// update moves post between tag indexes and keeps created time
+	err := pm.UpdatePost(ctx, user, "post1", "title", "content", "", "", nil, []string{"music", "news"})
+	assert.Nil(t, err)
+	assert.Equal(t, []model.TagPost{
+		{Permlink: permlink2, CreatedAt: baseTime},
+		{Permlink: permlink3, CreatedAt: baseTime + 10},
+	}, pm.GetTagPosts(ctx, "gaming", 0, 100))
+	assert.Equal(t, []model.TagPost{
+		{Permlink: permlink1, CreatedAt: baseTime + 20},
+	}, pm.GetTagPosts(ctx, "music", 0, 100))
+	assert.Equal(t, []model.TagPost{
+		{Permlink: permlink1, CreatedAt: baseTime + 20},
+	}, pm.GetTagPosts(ctx, "news", 0, 100))
+
+	// deleted post is removed from all tag indexes
+	err = pm.DeletePost(ctx, permlink1)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.TagPost{}, pm.GetTagPosts(ctx, "music", 0, 100))
+	assert.Equal(t, []model.TagPost{}, pm.GetTagPosts(ctx, "news", 0, 100))
+	postInfo, err := pm.postStorage.GetPostInfo(ctx, permlink1)
+	assert.Nil(t, err)
+	assert.Nil(t, postInfo.Tags)
+}
@@ -33,6 +33,21 @@ func ErrPostViewNotFound(key []byte) sdk.Error {
 	return types.NewError(types.CodePostViewNotFound, fmt.Sprintf("Post view not found for key: %s", key))
 }
 
+// ErrPostBeneficiaryRewardNotFound - error if beneficiary reward is not found in KVStore
+func ErrPostBeneficiaryRewardNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodePostBeneficiaryRewardNotFound, fmt.Sprintf("post beneficiary reward not found for key: %s", key))
+}
+
+// ErrPostRevisionNotFound - error if post revision is not found in KVStore
+func ErrPostRevisionNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodePostRevisionNotFound, fmt.Sprintf("post revision not found for key: %s", key))
+}
+
+// ErrCensoredContentNotFound - error if censored content is not found in KVStore
+func ErrCensoredContentNotFound(key []byte) sdk.Error {
+	return types.NewError(types.CodeCensoredContentNotFound, fmt.Sprintf("censored content not found for key: %s", key))
+}
+
 // ErrPostDonationNotFound - error if post donation is not found in KVStore
 func ErrPostDonationNotFound(key []byte) sdk.Error {
 	return types.NewError(types.CodePostDonationNotFound, fmt.Sprintf("Post donation not found for key: %s", key))
@@ -97,3 +112,33 @@ func ErrFailedToUnmarshalPostView(err error) sdk.Error {
 func ErrFailedToUnmarshalPostDonations(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalPostDonations, fmt.Sprintf("failed to unmarshal post donations: %s", err.Error()))
 }
+
+// ErrFailedToMarshalBeneficiaryReward - error if marshal beneficiary reward failed
+func ErrFailedToMarshalBeneficiaryReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalBeneficiaryReward, fmt.Sprintf("failed to marshal beneficiary reward: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalBeneficiaryReward - error if unmarshal beneficiary reward failed
+func ErrFailedToUnmarshalBeneficiaryReward(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalBeneficiaryReward, fmt.Sprintf("failed to unmarshal beneficiary reward: %s", err.Error()))
+}
+
+// ErrFailedToMarshalPostRevision - error if marshal post revision failed
+func ErrFailedToMarshalPostRevision(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalPostRevision, fmt.Sprintf("failed to marshal post revision: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalPostRevision - error if unmarshal post revision failed
+func ErrFailedToUnmarshalPostRevision(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalPostRevision, fmt.Sprintf("failed to unmarshal post revision: %s", err.Error()))
+}
+
+// ErrFailedToMarshalCensoredContent - error if marshal censored content failed
+func ErrFailedToMarshalCensoredContent(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalCensoredContent, fmt.Sprintf("failed to marshal censored content: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalCensoredContent - error if unmarshal censored content failed
+func ErrFailedToUnmarshalCensoredContent(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalCensoredContent, fmt.Sprintf("failed to unmarshal censored content: %s", err.Error()))
+}
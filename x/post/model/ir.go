@@ -28,6 +28,10 @@ type PostRowIR struct {
 
 // PostTablesIR - PostRow changed.
 type PostTablesIR struct {
-	Posts     []PostRowIR   `json:"posts"`
-	PostUsers []PostUserRow `json:"post_users"`
+	Posts              []PostRowIR                `json:"posts"`
+	PostUsers          []PostUserRow              `json:"post_users"`
+	BeneficiaryRewards []PostBeneficiaryRewardRow `json:"beneficiary_rewards"`
+	Revisions          []PostRevisionRow          `json:"revisions"`
+	Donations          []PostDonationRow          `json:"donations"`
+	CensoredContents   []CensoredContent          `json:"censored_contents"`
 }
@@ -1,6 +1,9 @@
 package model
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	"github.com/lino-network/lino/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,16 +16,25 @@ type Identifier string
 type URL string
 
 // PostInfo - can also use to present comment(with parent) or repost(with source)
+// content-addressed post keeps content off chain, only content hash and storage uri
+// are stored and content is empty
 type PostInfo struct {
 	PostID       string                 `json:"post_id"`
 	Title        string                 `json:"title"`
 	Content      string                 `json:"content"`
+	ContentHash  string                 `json:"content_hash"`
+	ContentURI   string                 `json:"content_uri"`
 	Author       types.AccountKey       `json:"author"`
 	ParentAuthor types.AccountKey       `json:"parent_author"`
 	ParentPostID string                 `json:"parent_postID"`
 	SourceAuthor types.AccountKey       `json:"source_author"`
 	SourcePostID string                 `json:"source_postID"`
 	Links        []types.IDToURLMapping `json:"links"`
+	Tags         []string               `json:"tags"`
+	// empty beneficiaries means all income goes to author
+	Beneficiaries []types.Beneficiary `json:"beneficiaries"`
+	// depth of the comment in the thread, root post is at depth 0
+	Depth int64 `json:"depth"`
 }
 
 // PostMeta - stores tiny and frequently updated fields.
@@ -65,11 +77,135 @@ type ReportOrUpvote struct {
 	IsReport  bool             `json:"is_report"`
 }
 
+// UserReportOrUpvote - report or upvote made by a user to a post
+type UserReportOrUpvote struct {
+	Permlink  types.Permlink `json:"permlink"`
+	CoinDay   types.Coin     `json:"coin_day"`
+	CreatedAt int64          `json:"created_at"`
+	IsReport  bool           `json:"is_report"`
+}
+
 // Comment - comment list store dy a post
+// Depth - depth of the comment in the thread, comment to a root post is at depth 1
 type Comment struct {
 	Author    types.AccountKey `json:"author"`
 	PostID    string           `json:"post_id"`
 	CreatedAt int64            `json:"created_at"`
+	Depth     int64            `json:"depth"`
+}
+
+// BeneficiaryReward - income a beneficiary received from a post
+type BeneficiaryReward struct {
+	Username      types.AccountKey `json:"username"`
+	DirectDeposit types.Coin       `json:"direct_deposit"`
+	Inflation     types.Coin       `json:"inflation"`
+}
+
+// Revision - previous body of a post, appended when the post is updated
+// ContentHash - hash of title and content, or content hash of a content-addressed post
+// CreatedAt - when the body of this revision was published
+// ReplacedAt - when the body of this revision was replaced by update
+type Revision struct {
+	RevisionID  int64                  `json:"revision_id"`
+	ContentHash string                 `json:"content_hash"`
+	Title       string                 `json:"title"`
+	Content     string                 `json:"content"`
+	ContentURI  string                 `json:"content_uri"`
+	Links       []types.IDToURLMapping `json:"links"`
+	CreatedAt   int64                  `json:"created_at"`
+	ReplacedAt  int64                  `json:"replaced_at"`
+	IsDeleted   bool                   `json:"is_deleted"`
+}
+
+// CensoredContent - body removed by a passed content censorship, retained so it
+// can be restored if the censorship is overturned by an appeal
+// Revision - censored revision, zero if the whole post is censored
+// RedistributionSplitRate - split rate of the post before it was censored
+// Revisions - bodies of revisions removed by the censorship
+// LostRewards - rewards the censored post lost to censorship penalty while censored
+type CensoredContent struct {
+	Permlink                types.Permlink         `json:"permlink"`
+	Revision                int64                  `json:"revision"`
+	Author                  types.AccountKey       `json:"author"`
+	Title                   string                 `json:"title"`
+	Content                 string                 `json:"content"`
+	ContentURI              string                 `json:"content_uri"`
+	Links                   []types.IDToURLMapping `json:"links"`
+	Tags                    []string               `json:"tags"`
+	RedistributionSplitRate sdk.Dec                `json:"redistribution_split_rate"`
+	Revisions               []Revision             `json:"revisions"`
+	CensoredAt              int64                  `json:"censored_at"`
+	LostRewards             []LostReward           `json:"lost_rewards"`
+}
+
+// LostReward - reward of a consumption not paid because the post was censored
+// Reward - reward the consumption would get with reputation penalty only
+type LostReward struct {
+	Consumer types.AccountKey `json:"consumer"`
+	Evaluate types.Coin       `json:"evaluate"`
+	Reward   types.Coin       `json:"reward"`
+}
+
+// GetContentHash - hex encoded sha256 of post title and content
+func GetContentHash(title, content string) string {
+	hash := sha256.New()
+	hash.Write([]byte(title))
+	hash.Write([]byte{0x00})
+	hash.Write([]byte(content))
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// HashContent - hex encoded sha256 of off chain content of a content-addressed post
+func HashContent(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// IsValidContentHash - content hash must be a lower case hex encoded sha256
+func IsValidContentHash(contentHash string) bool {
+	bz, err := hex.DecodeString(contentHash)
+	return err == nil && len(bz) == sha256.Size && hex.EncodeToString(bz) == contentHash
+}
+
+// TagPost - post in the index of a tag
+type TagPost struct {
+	Permlink  types.Permlink `json:"permlink"`
+	CreatedAt int64          `json:"created_at"`
+}
+
+// Donation - donation from a donor to a post, kept to be refunded in refund window
+// DonationID - id of donation from the donor to the post, starts from 1
+// Parts - donation processed on the post and, for repost, on its source post
+type Donation struct {
+	DonationID int64            `json:"donation_id"`
+	Donor      types.AccountKey `json:"donor"`
+	Amount     types.Coin       `json:"amount"`
+	FromApp    types.AccountKey `json:"from_app"`
+	CreatedAt  int64            `json:"created_at"`
+	IsRefunded bool             `json:"is_refunded"`
+	Parts      []DonationPart   `json:"parts"`
+}
+
+// DonationPart - donation processed on a single post
+// CoinDay - coin day donated, used as stake in reputation system
+// Dp - donation power from reputation system
+// RoundStartAt - start time of reputation round when donated
+// Deposits - direct deposit received by each beneficiary
+type DonationPart struct {
+	Permlink     types.Permlink    `json:"permlink"`
+	Coin         types.Coin        `json:"coin"`
+	CoinDay      types.Coin        `json:"coin_day"`
+	Dp           types.Coin        `json:"dp"`
+	Evaluate     types.Coin        `json:"evaluate"`
+	Friction     types.Coin        `json:"friction"`
+	RoundStartAt int64             `json:"round_start_at"`
+	Deposits     []DonationDeposit `json:"deposits"`
+}
+
+// DonationDeposit - direct deposit from a donation to a beneficiary
+type DonationDeposit struct {
+	Username types.AccountKey `json:"username"`
+	Coin     types.Coin       `json:"coin"`
 }
 
 // View - from a user to a post
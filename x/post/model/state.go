@@ -30,6 +30,24 @@ type PostUserRow struct {
 	// Donations      Donations        `json:"donations"`
 }
 
+// PostBeneficiaryRewardRow - pk: (permlink, beneficiary)
+type PostBeneficiaryRewardRow struct {
+	Permlink types.Permlink    `json:"permlink"`
+	Reward   BeneficiaryReward `json:"reward"`
+}
+
+// PostRevisionRow - pk: (permlink, revision id)
+type PostRevisionRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	Revision Revision       `json:"revision"`
+}
+
+// PostDonationRow - pk: (permlink, donor, donation id)
+type PostDonationRow struct {
+	Permlink types.Permlink `json:"permlink"`
+	Donation Donation       `json:"donation"`
+}
+
 // XXX(yumin): not exported for upgrade-1
 // PostCommentRow - pk: (permlink, commentPermlink)
 // type PostCommentRow struct {
@@ -40,8 +58,12 @@ type PostUserRow struct {
 
 // PostTables - state of post store.
 type PostTables struct {
-	Posts     []PostRow     `json:"posts"`
-	PostUsers []PostUserRow `json:"post_users"`
+	Posts              []PostRow                  `json:"posts"`
+	PostUsers          []PostUserRow              `json:"post_users"`
+	BeneficiaryRewards []PostBeneficiaryRewardRow `json:"beneficiary_rewards"`
+	Revisions          []PostRevisionRow          `json:"revisions"`
+	Donations          []PostDonationRow          `json:"donations"`
+	CensoredContents   []CensoredContent          `json:"censored_contents"`
 	// not exported for upgrade-1
 	// PostComments []PostCommentRow `json:"post_comments"`
 }
@@ -53,5 +75,9 @@ func (p PostTables) ToIR() *PostTablesIR {
 		rst.Posts = append(rst.Posts, v.ToIR())
 	}
 	rst.PostUsers = p.PostUsers
+	rst.BeneficiaryRewards = p.BeneficiaryRewards
+	rst.Revisions = p.Revisions
+	rst.Donations = p.Donations
+	rst.CensoredContents = p.CensoredContents
 	return rst
 }
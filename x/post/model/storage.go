@@ -1,6 +1,9 @@
 package model
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -17,6 +20,15 @@ var (
 	postViewsSubStore          = []byte{0x04} // SubStore for all views
 	// XXX(yukai): deprecated.
 	// postDonationsSubStore      = []byte{0x05} // SubStore for all donations
+	postBeneficiaryRewardSubStore = []byte{0x06} // SubStore for all beneficiary rewards
+	postRevisionSubStore          = []byte{0x07} // SubStore for all post revisions
+	userReportOrUpvoteSubStore    = []byte{0x08} // SubStore for report or upvote index by user
+	postTagSubStore               = []byte{0x09} // SubStore for post index by tag
+	postDonationSubStore          = []byte{0x0a} // SubStore for donations kept for refund
+	censoredContentSubStore       = []byte{0x0b} // SubStore for content retained for appeal
+
+	// createdAtKeyLength - length of zero padded created time in tag index key
+	createdAtKeyLength = 20
 )
 
 // PostStorage - post storage
@@ -120,9 +132,86 @@ func (ps PostStorage) SetPostReportOrUpvote(
 		return ErrFailedToMarshalPostReportOrUpvote(err)
 	}
 	store.Set(getPostReportOrUpvoteKey(permlink, reportOrUpvote.Username), reportOrUpvoteByte)
+	store.Set(getUserReportOrUpvoteKey(reportOrUpvote.Username, permlink), []byte(permlink))
 	return nil
 }
 
+// GetPostReportOrUpvotes - get all reports and upvotes to a post from KVStore
+func (ps PostStorage) GetPostReportOrUpvotes(
+	ctx sdk.Context, permlink types.Permlink) ([]ReportOrUpvote, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, getPostReportOrUpvotePrefix(permlink))
+	defer itr.Close()
+	reportOrUpvotes := []ReportOrUpvote{}
+	for ; itr.Valid(); itr.Next() {
+		reportOrUpvote := new(ReportOrUpvote)
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), reportOrUpvote); err != nil {
+			return nil, ErrFailedToUnmarshalPostReportOrUpvote(err)
+		}
+		reportOrUpvotes = append(reportOrUpvotes, *reportOrUpvote)
+	}
+	return reportOrUpvotes, nil
+}
+
+// GetUserReportOrUpvotes - get all reports and upvotes made by a user from KVStore
+func (ps PostStorage) GetUserReportOrUpvotes(
+	ctx sdk.Context, user types.AccountKey) ([]UserReportOrUpvote, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, getUserReportOrUpvotePrefix(user))
+	defer itr.Close()
+	reportOrUpvotes := []UserReportOrUpvote{}
+	for ; itr.Valid(); itr.Next() {
+		permlink := types.Permlink(itr.Value())
+		reportOrUpvote, err := ps.GetPostReportOrUpvote(ctx, permlink, user)
+		if err != nil {
+			return nil, err
+		}
+		reportOrUpvotes = append(reportOrUpvotes, UserReportOrUpvote{
+			Permlink:  permlink,
+			CoinDay:   reportOrUpvote.CoinDay,
+			CreatedAt: reportOrUpvote.CreatedAt,
+			IsReport:  reportOrUpvote.IsReport,
+		})
+	}
+	return reportOrUpvotes, nil
+}
+
+// SetPostTag - add post to the index of tag, ordered by post created time
+func (ps PostStorage) SetPostTag(
+	ctx sdk.Context, tag string, createdAt int64, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Set(getPostTagKey(tag, createdAt, permlink), []byte(permlink))
+}
+
+// RemovePostTag - remove post from the index of tag
+func (ps PostStorage) RemovePostTag(
+	ctx sdk.Context, tag string, createdAt int64, permlink types.Permlink) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getPostTagKey(tag, createdAt, permlink))
+}
+
+// GetTagPosts - get at most limit posts of tag after skipping offset posts,
+// ordered by post created time
+func (ps PostStorage) GetTagPosts(ctx sdk.Context, tag string, offset, limit int64) []TagPost {
+	store := ctx.KVStore(ps.key)
+	prefix := getPostTagPrefix(tag)
+	itr := sdk.KVStorePrefixIterator(store, prefix)
+	defer itr.Close()
+	tagPosts := []TagPost{}
+	for ; itr.Valid() && int64(len(tagPosts)) < limit; itr.Next() {
+		if offset > 0 {
+			offset--
+			continue
+		}
+		createdAt, _ := strconv.ParseInt(string(itr.Key()[len(prefix):len(prefix)+createdAtKeyLength]), 10, 64)
+		tagPosts = append(tagPosts, TagPost{
+			Permlink:  types.Permlink(itr.Value()),
+			CreatedAt: createdAt,
+		})
+	}
+	return tagPosts
+}
+
 // GetPostComment - get post comment from KVStore
 func (ps PostStorage) GetPostComment(
 	ctx sdk.Context, permlink types.Permlink, commentPermlink types.Permlink) (*Comment, sdk.Error) {
@@ -152,6 +241,26 @@ func (ps PostStorage) SetPostComment(
 	return nil
 }
 
+// GetPostComments - get all comments to a post from KVStore, ordered by created time
+func (ps PostStorage) GetPostComments(
+	ctx sdk.Context, permlink types.Permlink) ([]Comment, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, getPostCommentPrefix(permlink))
+	defer itr.Close()
+	comments := []Comment{}
+	for ; itr.Valid(); itr.Next() {
+		comment := new(Comment)
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), comment); err != nil {
+			return nil, ErrFailedToUnmarshalPostComment(err)
+		}
+		comments = append(comments, *comment)
+	}
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].CreatedAt < comments[j].CreatedAt
+	})
+	return comments, nil
+}
+
 // GetPostView - get post view from KVStore
 func (ps PostStorage) GetPostView(
 	ctx sdk.Context, permlink types.Permlink, viewUser types.AccountKey) (*View, sdk.Error) {
@@ -178,6 +287,183 @@ func (ps PostStorage) SetPostView(ctx sdk.Context, permlink types.Permlink, post
 	return nil
 }
 
+// GetBeneficiaryReward - get beneficiary reward of a post from KVStore
+func (ps PostStorage) GetBeneficiaryReward(
+	ctx sdk.Context, permlink types.Permlink, beneficiary types.AccountKey) (*BeneficiaryReward, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	rewardBytes := store.Get(getBeneficiaryRewardKey(permlink, beneficiary))
+	if rewardBytes == nil {
+		return nil, ErrPostBeneficiaryRewardNotFound(getBeneficiaryRewardKey(permlink, beneficiary))
+	}
+	reward := new(BeneficiaryReward)
+	if unmarshalErr := ps.cdc.UnmarshalBinaryLengthPrefixed(rewardBytes, reward); unmarshalErr != nil {
+		return nil, ErrFailedToUnmarshalBeneficiaryReward(unmarshalErr)
+	}
+	return reward, nil
+}
+
+// SetBeneficiaryReward - set beneficiary reward of a post to KVStore
+func (ps PostStorage) SetBeneficiaryReward(
+	ctx sdk.Context, permlink types.Permlink, reward *BeneficiaryReward) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	rewardBytes, err := ps.cdc.MarshalBinaryLengthPrefixed(*reward)
+	if err != nil {
+		return ErrFailedToMarshalBeneficiaryReward(err)
+	}
+	store.Set(getBeneficiaryRewardKey(permlink, reward.Username), rewardBytes)
+	return nil
+}
+
+// GetBeneficiaryRewards - get all beneficiary rewards of a post
+func (ps PostStorage) GetBeneficiaryRewards(
+	ctx sdk.Context, permlink types.Permlink) ([]BeneficiaryReward, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, getBeneficiaryRewardPrefix(permlink))
+	defer itr.Close()
+	rewards := []BeneficiaryReward{}
+	for ; itr.Valid(); itr.Next() {
+		reward := new(BeneficiaryReward)
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), reward); err != nil {
+			return nil, ErrFailedToUnmarshalBeneficiaryReward(err)
+		}
+		rewards = append(rewards, *reward)
+	}
+	return rewards, nil
+}
+
+// GetPostRevision - get post revision from KVStore
+func (ps PostStorage) GetPostRevision(
+	ctx sdk.Context, permlink types.Permlink, revisionID int64) (*Revision, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	revisionBytes := store.Get(getPostRevisionKey(permlink, revisionID))
+	if revisionBytes == nil {
+		return nil, ErrPostRevisionNotFound(getPostRevisionKey(permlink, revisionID))
+	}
+	revision := new(Revision)
+	if unmarshalErr := ps.cdc.UnmarshalBinaryLengthPrefixed(revisionBytes, revision); unmarshalErr != nil {
+		return nil, ErrFailedToUnmarshalPostRevision(unmarshalErr)
+	}
+	return revision, nil
+}
+
+// SetPostRevision - set post revision to KVStore
+func (ps PostStorage) SetPostRevision(
+	ctx sdk.Context, permlink types.Permlink, revision *Revision) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	revisionBytes, err := ps.cdc.MarshalBinaryLengthPrefixed(*revision)
+	if err != nil {
+		return ErrFailedToMarshalPostRevision(err)
+	}
+	store.Set(getPostRevisionKey(permlink, revision.RevisionID), revisionBytes)
+	return nil
+}
+
+// GetPostRevisions - get all revisions of a post, ordered by revision id
+func (ps PostStorage) GetPostRevisions(
+	ctx sdk.Context, permlink types.Permlink) ([]Revision, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, getPostRevisionPrefix(permlink))
+	defer itr.Close()
+	revisions := []Revision{}
+	for ; itr.Valid(); itr.Next() {
+		revision := new(Revision)
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), revision); err != nil {
+			return nil, ErrFailedToUnmarshalPostRevision(err)
+		}
+		revisions = append(revisions, *revision)
+	}
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].RevisionID < revisions[j].RevisionID
+	})
+	return revisions, nil
+}
+
+// GetPostDonation - get donation from a donor to a post from KVStore
+func (ps PostStorage) GetPostDonation(
+	ctx sdk.Context, permlink types.Permlink, donor types.AccountKey, donationID int64) (*Donation, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	donationBytes := store.Get(getPostDonationKey(permlink, donor, donationID))
+	if donationBytes == nil {
+		return nil, ErrPostDonationNotFound(getPostDonationKey(permlink, donor, donationID))
+	}
+	donation := new(Donation)
+	if unmarshalErr := ps.cdc.UnmarshalBinaryLengthPrefixed(donationBytes, donation); unmarshalErr != nil {
+		return nil, ErrFailedToUnmarshalPostDonations(unmarshalErr)
+	}
+	return donation, nil
+}
+
+// SetPostDonation - set donation from a donor to a post to KVStore
+func (ps PostStorage) SetPostDonation(
+	ctx sdk.Context, permlink types.Permlink, donation *Donation) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	donationBytes, err := ps.cdc.MarshalBinaryLengthPrefixed(*donation)
+	if err != nil {
+		return ErrFailedToMarshalPostDonations(err)
+	}
+	store.Set(getPostDonationKey(permlink, donation.Donor, donation.DonationID), donationBytes)
+	return nil
+}
+
+// DeletePostDonation - delete donation from a donor to a post from KVStore
+func (ps PostStorage) DeletePostDonation(
+	ctx sdk.Context, permlink types.Permlink, donor types.AccountKey, donationID int64) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getPostDonationKey(permlink, donor, donationID))
+}
+
+// GetPostDonations - get all donations from a donor to a post, ordered by donation id
+func (ps PostStorage) GetPostDonations(
+	ctx sdk.Context, permlink types.Permlink, donor types.AccountKey) ([]Donation, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	itr := sdk.KVStorePrefixIterator(store, getPostDonationPrefix(permlink, donor))
+	defer itr.Close()
+	donations := []Donation{}
+	for ; itr.Valid(); itr.Next() {
+		donation := new(Donation)
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), donation); err != nil {
+			return nil, ErrFailedToUnmarshalPostDonations(err)
+		}
+		donations = append(donations, *donation)
+	}
+	sort.Slice(donations, func(i, j int) bool {
+		return donations[i].DonationID < donations[j].DonationID
+	})
+	return donations, nil
+}
+
+// GetCensoredContent - get content removed by censorship from KVStore
+func (ps PostStorage) GetCensoredContent(
+	ctx sdk.Context, permlink types.Permlink, revisionID int64) (*CensoredContent, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	contentBytes := store.Get(getCensoredContentKey(permlink, revisionID))
+	if contentBytes == nil {
+		return nil, ErrCensoredContentNotFound(getCensoredContentKey(permlink, revisionID))
+	}
+	content := new(CensoredContent)
+	if unmarshalErr := ps.cdc.UnmarshalBinaryLengthPrefixed(contentBytes, content); unmarshalErr != nil {
+		return nil, ErrFailedToUnmarshalCensoredContent(unmarshalErr)
+	}
+	return content, nil
+}
+
+// SetCensoredContent - set content removed by censorship to KVStore
+func (ps PostStorage) SetCensoredContent(ctx sdk.Context, content *CensoredContent) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	contentBytes, err := ps.cdc.MarshalBinaryLengthPrefixed(*content)
+	if err != nil {
+		return ErrFailedToMarshalCensoredContent(err)
+	}
+	store.Set(getCensoredContentKey(content.Permlink, content.Revision), contentBytes)
+	return nil
+}
+
+// DeleteCensoredContent - delete content removed by censorship from KVStore
+func (ps PostStorage) DeleteCensoredContent(ctx sdk.Context, permlink types.Permlink, revisionID int64) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(getCensoredContentKey(permlink, revisionID))
+}
+
 // Export post storage state.
 func (ps PostStorage) Export(ctx sdk.Context) *PostTables {
 	tables := &PostTables{}
@@ -229,6 +515,85 @@ func (ps PostStorage) Export(ctx sdk.Context) *PostTables {
 			tables.PostUsers = append(tables.PostUsers, row)
 		}
 	}()
+	// export tables.BeneficiaryRewards
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, postBeneficiaryRewardSubStore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			permlinkAccount := string(k[1:])
+			strs := strings.Split(permlinkAccount, types.KeySeparator)
+			if len(strs) != 2 {
+				panic("failed to split out permlink account: " + permlinkAccount)
+			}
+			permlink, username := types.Permlink(strs[0]), types.AccountKey(strs[1])
+			reward, err := ps.GetBeneficiaryReward(ctx, permlink, username)
+			if err != nil {
+				panic("failed to get beneficiary reward: " + err.Error())
+			}
+			row := PostBeneficiaryRewardRow{
+				Permlink: permlink,
+				Reward:   *reward,
+			}
+			tables.BeneficiaryRewards = append(tables.BeneficiaryRewards, row)
+		}
+	}()
+	// export tables.Revisions
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, postRevisionSubStore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			permlinkRevision := string(k[1:])
+			strs := strings.Split(permlinkRevision, types.KeySeparator)
+			if len(strs) != 2 {
+				panic("failed to split out permlink revision: " + permlinkRevision)
+			}
+			revision := new(Revision)
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), revision); err != nil {
+				panic("failed to get post revision: " + err.Error())
+			}
+			row := PostRevisionRow{
+				Permlink: types.Permlink(strs[0]),
+				Revision: *revision,
+			}
+			tables.Revisions = append(tables.Revisions, row)
+		}
+	}()
+	// export tables.Donations
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, postDonationSubStore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			permlinkDonation := string(k[1:])
+			strs := strings.Split(permlinkDonation, types.KeySeparator)
+			if len(strs) != 3 {
+				panic("failed to split out permlink donation: " + permlinkDonation)
+			}
+			donation := new(Donation)
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), donation); err != nil {
+				panic("failed to get post donation: " + err.Error())
+			}
+			row := PostDonationRow{
+				Permlink: types.Permlink(strs[0]),
+				Donation: *donation,
+			}
+			tables.Donations = append(tables.Donations, row)
+		}
+	}()
+	// export tables.CensoredContents
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, censoredContentSubStore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			content := new(CensoredContent)
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), content); err != nil {
+				panic("failed to get censored content: " + err.Error())
+			}
+			tables.CensoredContents = append(tables.CensoredContents, *content)
+		}
+	}()
 	return tables
 }
 
@@ -257,12 +622,36 @@ func (ps PostStorage) Import(ctx sdk.Context, tb *PostTablesIR) {
 			RedistributionSplitRate: sdk.MustNewDecFromStr(v.Meta.RedistributionSplitRate),
 		})
 		check(err)
+		// tag index is rebuilt from post info
+		for _, tag := range v.Info.Tags {
+			ps.SetPostTag(ctx, tag, v.Meta.CreatedAt, v.Permlink)
+		}
 	}
-	// import PostUsers
+	// import PostUsers, user index is rebuilt on set
 	for _, v := range tb.PostUsers {
 		err := ps.SetPostReportOrUpvote(ctx, v.Permlink, &v.ReportOrUpvote)
 		check(err)
 	}
+	// import BeneficiaryRewards
+	for _, v := range tb.BeneficiaryRewards {
+		err := ps.SetBeneficiaryReward(ctx, v.Permlink, &v.Reward)
+		check(err)
+	}
+	// import Revisions
+	for _, v := range tb.Revisions {
+		err := ps.SetPostRevision(ctx, v.Permlink, &v.Revision)
+		check(err)
+	}
+	// import Donations
+	for _, v := range tb.Donations {
+		err := ps.SetPostDonation(ctx, v.Permlink, &v.Donation)
+		check(err)
+	}
+	// import CensoredContents
+	for _, v := range tb.CensoredContents {
+		err := ps.SetCensoredContent(ctx, &v)
+		check(err)
+	}
 }
 
 // GetPostInfoPrefix - "post info substore" + "author"
@@ -291,6 +680,30 @@ func getPostReportOrUpvoteKey(permlink types.Permlink, user types.AccountKey) []
 	return append(getPostReportOrUpvotePrefix(permlink), user...)
 }
 
+// getUserReportOrUpvotePrefix - "user report or upvote substore" + "user"
+// which can be used to access all reports and upvotes made by this user
+func getUserReportOrUpvotePrefix(user types.AccountKey) []byte {
+	return append(append(userReportOrUpvoteSubStore, user...), types.KeySeparator...)
+}
+
+// getUserReportOrUpvoteKey - "user report or upvote substore" + "user" + "permlink"
+func getUserReportOrUpvoteKey(user types.AccountKey, permlink types.Permlink) []byte {
+	return append(getUserReportOrUpvotePrefix(user), permlink...)
+}
+
+// getPostTagPrefix - "post tag substore" + "tag"
+// which can be used to access all posts with this tag
+func getPostTagPrefix(tag string) []byte {
+	return append(append(postTagSubStore, tag...), types.KeySeparator...)
+}
+
+// getPostTagKey - "post tag substore" + "tag" + "created at" + "permlink",
+// created at is zero padded so posts are ordered by created time
+func getPostTagKey(tag string, createdAt int64, permlink types.Permlink) []byte {
+	return append(append(append(getPostTagPrefix(tag),
+		fmt.Sprintf("%0*d", createdAtKeyLength, createdAt)...), types.KeySeparator...), permlink...)
+}
+
 // getPostViewPrefix - "post view substore" + "permlink"
 // which can be used to access all views belong to this post
 func getPostViewPrefix(permlink types.Permlink) []byte {
@@ -312,3 +725,43 @@ func getPostCommentPrefix(permlink types.Permlink) []byte {
 func getPostCommentKey(permlink types.Permlink, commentPermlink types.Permlink) []byte {
 	return append(getPostCommentPrefix(permlink), commentPermlink...)
 }
+
+// getBeneficiaryRewardPrefix - "beneficiary reward substore" + "permlink"
+// which can be used to access all beneficiary rewards belong to this post
+func getBeneficiaryRewardPrefix(permlink types.Permlink) []byte {
+	return append(append(postBeneficiaryRewardSubStore, permlink...), types.KeySeparator...)
+}
+
+// getBeneficiaryRewardKey - "beneficiary reward substore" + "permlink" + "beneficiary"
+func getBeneficiaryRewardKey(permlink types.Permlink, beneficiary types.AccountKey) []byte {
+	return append(getBeneficiaryRewardPrefix(permlink), beneficiary...)
+}
+
+// getPostRevisionPrefix - "revision substore" + "permlink"
+// which can be used to access all revisions belong to this post
+func getPostRevisionPrefix(permlink types.Permlink) []byte {
+	return append(append(postRevisionSubStore, permlink...), types.KeySeparator...)
+}
+
+// getPostRevisionKey - "revision substore" + "permlink" + "revision id"
+func getPostRevisionKey(permlink types.Permlink, revisionID int64) []byte {
+	return append(getPostRevisionPrefix(permlink), strconv.FormatInt(revisionID, 10)...)
+}
+
+// getPostDonationPrefix - "donation substore" + "permlink" + "donor"
+// which can be used to access all donations from the donor to this post
+func getPostDonationPrefix(permlink types.Permlink, donor types.AccountKey) []byte {
+	prefix := append(append(postDonationSubStore, permlink...), types.KeySeparator...)
+	return append(append(prefix, donor...), types.KeySeparator...)
+}
+
+// getPostDonationKey - "donation substore" + "permlink" + "donor" + "donation id"
+func getPostDonationKey(permlink types.Permlink, donor types.AccountKey, donationID int64) []byte {
+	return append(getPostDonationPrefix(permlink, donor), strconv.FormatInt(donationID, 10)...)
+}
+
+// getCensoredContentKey - "censored content substore" + "permlink" + "revision id"
+func getCensoredContentKey(permlink types.Permlink, revisionID int64) []byte {
+	return append(append(append(censoredContentSubStore, permlink...), types.KeySeparator...),
+		strconv.FormatInt(revisionID, 10)...)
+}
@@ -96,6 +96,28 @@ func TestPostComment(t *testing.T) {
 	})
 }
 
+func TestPostComments(t *testing.T) {
+	comment1 := Comment{Author: "b", PostID: "test", CreatedAt: 100, Depth: 1}
+	comment2 := Comment{Author: "a", PostID: "test", CreatedAt: 200, Depth: 1}
+
+	runTest(t, func(env TestEnv) {
+		comments, err := env.ps.GetPostComments(env.ctx, types.Permlink("test"))
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(comments))
+
+		err = env.ps.SetPostComment(env.ctx, types.Permlink("test"), &comment1)
+		assert.Nil(t, err)
+		err = env.ps.SetPostComment(env.ctx, types.Permlink("test"), &comment2)
+		assert.Nil(t, err)
+		err = env.ps.SetPostComment(env.ctx, types.Permlink("test2"), &comment1)
+		assert.Nil(t, err)
+
+		comments, err = env.ps.GetPostComments(env.ctx, types.Permlink("test"))
+		assert.Nil(t, err)
+		assert.Equal(t, []Comment{comment1, comment2}, comments)
+	})
+}
+
 func TestPostView(t *testing.T) {
 	user := types.AccountKey("test")
 	postView := View{Username: user, LastViewAt: 100, Times: 1}
@@ -110,6 +132,104 @@ func TestPostView(t *testing.T) {
 	})
 }
 
+func TestPostDonation(t *testing.T) {
+	donor := types.AccountKey("donor")
+	permlink := types.Permlink("author#post")
+	part := DonationPart{
+		Permlink: permlink,
+		Coin:     types.NewCoinFromInt64(100),
+		CoinDay:  types.NewCoinFromInt64(100),
+		Dp:       types.NewCoinFromInt64(100),
+		Evaluate: types.NewCoinFromInt64(100),
+		Friction: types.NewCoinFromInt64(1),
+		Deposits: []DonationDeposit{{Username: "author", Coin: types.NewCoinFromInt64(99)}},
+	}
+	donation1 := Donation{
+		DonationID: 1, Donor: donor, Amount: types.NewCoinFromInt64(100),
+		CreatedAt: 100, Parts: []DonationPart{part}}
+	donation2 := Donation{
+		DonationID: 2, Donor: donor, Amount: types.NewCoinFromInt64(100),
+		CreatedAt: 200, IsRefunded: true, Parts: []DonationPart{part}}
+
+	runTest(t, func(env TestEnv) {
+		_, err := env.ps.GetPostDonation(env.ctx, permlink, donor, 1)
+		assert.Equal(t, ErrPostDonationNotFound(getPostDonationKey(permlink, donor, 1)), err)
+
+		err = env.ps.SetPostDonation(env.ctx, permlink, &donation2)
+		assert.Nil(t, err)
+		err = env.ps.SetPostDonation(env.ctx, permlink, &donation1)
+		assert.Nil(t, err)
+
+		resultPtr, err := env.ps.GetPostDonation(env.ctx, permlink, donor, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, donation1, *resultPtr, "Post donation should be equal")
+
+		donations, err := env.ps.GetPostDonations(env.ctx, permlink, donor)
+		assert.Nil(t, err)
+		assert.Equal(t, []Donation{donation1, donation2}, donations)
+
+		donations, err = env.ps.GetPostDonations(env.ctx, permlink, types.AccountKey("donor2"))
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(donations))
+
+		tables := env.ps.Export(env.ctx)
+		assert.Equal(t, []PostDonationRow{
+			{Permlink: permlink, Donation: donation1},
+			{Permlink: permlink, Donation: donation2},
+		}, tables.Donations)
+	})
+}
+
+func TestCensoredContent(t *testing.T) {
+	permlink := types.Permlink("author#post")
+	post := CensoredContent{
+		Permlink:                permlink,
+		Author:                  types.AccountKey("author"),
+		Title:                   "title",
+		Content:                 "content",
+		Tags:                    []string{"gaming"},
+		RedistributionSplitRate: sdk.ZeroDec(),
+		Revisions: []Revision{
+			{RevisionID: 2, ContentHash: GetContentHash("title1", "content1"), Title: "title1", Content: "content1"},
+		},
+		CensoredAt: 100,
+	}
+	revision := CensoredContent{
+		Permlink:                permlink,
+		Revision:                1,
+		Author:                  types.AccountKey("author"),
+		RedistributionSplitRate: sdk.ZeroDec(),
+		Revisions: []Revision{
+			{RevisionID: 1, ContentHash: GetContentHash("title0", "content0"), Title: "title0", Content: "content0"},
+		},
+		CensoredAt: 50,
+	}
+
+	runTest(t, func(env TestEnv) {
+		_, err := env.ps.GetCensoredContent(env.ctx, permlink, 0)
+		assert.Equal(t, ErrCensoredContentNotFound(getCensoredContentKey(permlink, 0)), err)
+
+		err = env.ps.SetCensoredContent(env.ctx, &post)
+		assert.Nil(t, err)
+		err = env.ps.SetCensoredContent(env.ctx, &revision)
+		assert.Nil(t, err)
+
+		resultPtr, err := env.ps.GetCensoredContent(env.ctx, permlink, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, post, *resultPtr, "Censored post should be equal")
+		resultPtr, err = env.ps.GetCensoredContent(env.ctx, permlink, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, revision, *resultPtr, "Censored revision should be equal")
+
+		tables := env.ps.Export(env.ctx)
+		assert.Equal(t, []CensoredContent{post, revision}, tables.CensoredContents)
+
+		env.ps.DeleteCensoredContent(env.ctx, permlink, 0)
+		_, err = env.ps.GetCensoredContent(env.ctx, permlink, 0)
+		assert.Equal(t, ErrCensoredContentNotFound(getCensoredContentKey(permlink, 0)), err)
+	})
+}
+
 //
 // Test Environment setup
 //
@@ -2,9 +2,11 @@ package post
 
 import (
 	"fmt"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/post/model"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -12,31 +14,42 @@ import (
 var _ types.Msg = CreatePostMsg{}
 var _ types.Msg = UpdatePostMsg{}
 var _ types.Msg = DeletePostMsg{}
+var _ types.Msg = UpdateAllowRepliesMsg{}
 var _ types.Msg = DonateMsg{}
 var _ types.Msg = ReportOrUpvoteMsg{}
 var _ types.Msg = ViewMsg{}
+var _ types.Msg = BatchViewMsg{}
+var _ types.Msg = RefundDonationMsg{}
 
 // CreatePostMsg contains information to create a post
+// content-addressed post carries content hash and storage uri instead of content
 type CreatePostMsg struct {
 	Author                  types.AccountKey       `json:"author"`
 	PostID                  string                 `json:"post_id"`
 	Title                   string                 `json:"title"`
 	Content                 string                 `json:"content"`
+	ContentHash             string                 `json:"content_hash"`
+	ContentURI              string                 `json:"content_uri"`
 	ParentAuthor            types.AccountKey       `json:"parent_author"`
 	ParentPostID            string                 `json:"parent_postID"`
 	SourceAuthor            types.AccountKey       `json:"source_author"`
 	SourcePostID            string                 `json:"source_postID"`
 	Links                   []types.IDToURLMapping `json:"links"`
+	Tags                    []string               `json:"tags"`
 	RedistributionSplitRate string                 `json:"redistribution_split_rate"`
+	Beneficiaries           []types.Beneficiary    `json:"beneficiaries"`
 }
 
 // UpdatePostMsg - update post
 type UpdatePostMsg struct {
-	Author  types.AccountKey       `json:"author"`
-	PostID  string                 `json:"post_id"`
-	Title   string                 `json:"title"`
-	Content string                 `json:"content"`
-	Links   []types.IDToURLMapping `json:"links"`
+	Author      types.AccountKey       `json:"author"`
+	PostID      string                 `json:"post_id"`
+	Title       string                 `json:"title"`
+	Content     string                 `json:"content"`
+	ContentHash string                 `json:"content_hash"`
+	ContentURI  string                 `json:"content_uri"`
+	Links       []types.IDToURLMapping `json:"links"`
+	Tags        []string               `json:"tags"`
 }
 
 // DeletePostMsg - sent from a user to a post
@@ -45,6 +58,13 @@ type DeletePostMsg struct {
 	PostID string           `json:"post_id"`
 }
 
+// UpdateAllowRepliesMsg - sent from author to allow or disallow comments to a post
+type UpdateAllowRepliesMsg struct {
+	Author       types.AccountKey `json:"author"`
+	PostID       string           `json:"post_id"`
+	AllowReplies bool             `json:"allow_replies"`
+}
+
 // DonateMsg - sent from a user to a post
 type DonateMsg struct {
 	Username types.AccountKey `json:"username"`
@@ -62,6 +82,20 @@ type ViewMsg struct {
 	PostID   string           `json:"post_id"`
 }
 
+// BatchView - views of a post from a user
+type BatchView struct {
+	Username types.AccountKey `json:"username"`
+	Author   types.AccountKey `json:"author"`
+	PostID   string           `json:"post_id"`
+	Count    int64            `json:"count"`
+}
+
+// BatchViewMsg - sent from an app with many views of its users
+type BatchViewMsg struct {
+	App   types.AccountKey `json:"app"`
+	Views []BatchView      `json:"views"`
+}
+
 // ReportOrUpvoteMsg - sent from a user to a post
 type ReportOrUpvoteMsg struct {
 	Username types.AccountKey `json:"username"`
@@ -70,11 +104,20 @@ type ReportOrUpvoteMsg struct {
 	IsReport bool             `json:"is_report"`
 }
 
+// RefundDonationMsg - sent from donor or the app donation from to refund a donation
+type RefundDonationMsg struct {
+	Username   types.AccountKey `json:"username"`
+	Donor      types.AccountKey `json:"donor"`
+	Author     types.AccountKey `json:"author"`
+	PostID     string           `json:"post_id"`
+	DonationID int64            `json:"donation_id"`
+}
+
 // NewCreatePostMsg - constructs a post msg
 func NewCreatePostMsg(
 	author, postID, title, content, parentAuthor, parentPostID,
 	sourceAuthor, sourcePostID, redistributionSplitRate string,
-	links []types.IDToURLMapping) CreatePostMsg {
+	links []types.IDToURLMapping, beneficiaries []types.Beneficiary) CreatePostMsg {
 	return CreatePostMsg{
 		Author:       types.AccountKey(author),
 		PostID:       postID,
@@ -86,6 +129,7 @@ func NewCreatePostMsg(
 		SourcePostID: sourcePostID,
 		Links:        links,
 		RedistributionSplitRate: redistributionSplitRate,
+		Beneficiaries:           beneficiaries,
 	}
 }
 
@@ -108,6 +152,15 @@ func NewDeletePostMsg(author, postID string) DeletePostMsg {
 	}
 }
 
+// NewUpdateAllowRepliesMsg - constructs a UpdateAllowReplies msg
+func NewUpdateAllowRepliesMsg(author, postID string, allowReplies bool) UpdateAllowRepliesMsg {
+	return UpdateAllowRepliesMsg{
+		Author:       types.AccountKey(author),
+		PostID:       postID,
+		AllowReplies: allowReplies,
+	}
+}
+
 // NewViewMsg - constructs a view msg
 func NewViewMsg(user, author string, postID string) ViewMsg {
 	return ViewMsg{
@@ -117,6 +170,24 @@ func NewViewMsg(user, author string, postID string) ViewMsg {
 	}
 }
 
+// NewBatchView - constructs a batch view
+func NewBatchView(user, author, postID string, count int64) BatchView {
+	return BatchView{
+		Username: types.AccountKey(user),
+		Author:   types.AccountKey(author),
+		PostID:   postID,
+		Count:    count,
+	}
+}
+
+// NewBatchViewMsg - constructs a batch view msg
+func NewBatchViewMsg(app string, views []BatchView) BatchViewMsg {
+	return BatchViewMsg{
+		App:   types.AccountKey(app),
+		Views: views,
+	}
+}
+
 // NewDonateMsg - constructs a donate msg
 func NewDonateMsg(
 	user string, amount types.LNO, author string,
@@ -131,6 +202,18 @@ func NewDonateMsg(
 	}
 }
 
+// NewRefundDonationMsg - constructs a refund donation msg
+func NewRefundDonationMsg(
+	user, donor, author, postID string, donationID int64) RefundDonationMsg {
+	return RefundDonationMsg{
+		Username:   types.AccountKey(user),
+		Donor:      types.AccountKey(donor),
+		Author:     types.AccountKey(author),
+		PostID:     postID,
+		DonationID: donationID,
+	}
+}
+
 // NewReportOrUpvoteMsg - constructs a ReportOrUpvote msg
 func NewReportOrUpvoteMsg(
 	user, author, postID string, isReport bool) ReportOrUpvoteMsg {
@@ -161,6 +244,12 @@ func (msg DeletePostMsg) Route() string { return RouterKey }
 // Type - implements sdk.Msg
 func (msg DeletePostMsg) Type() string { return "DeletePostMsg" }
 
+// Route - implements sdk.Msg
+func (msg UpdateAllowRepliesMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg UpdateAllowRepliesMsg) Type() string { return "UpdateAllowRepliesMsg" }
+
 // Route - implements sdk.Msg
 func (msg DonateMsg) Route() string { return RouterKey }
 
@@ -179,6 +268,18 @@ func (msg ViewMsg) Route() string { return RouterKey }
 // Type - implements sdk.Msg
 func (msg ViewMsg) Type() string { return "ViewMsg" }
 
+// Route - implements sdk.Msg
+func (msg BatchViewMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg BatchViewMsg) Type() string { return "BatchViewMsg" }
+
+// Route - implements sdk.Msg
+func (msg RefundDonationMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg RefundDonationMsg) Type() string { return "RefundDonationMsg" }
+
 // ValidateBasic - implements sdk.Msg
 func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 	// Ensure permlink exists
@@ -201,6 +302,9 @@ func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 	if utf8.RuneCountInString(msg.Content) > types.MaxPostContentLength {
 		return ErrPostContentExceedMaxLength()
 	}
+	if err := validateContentAddress(msg.Content, msg.ContentHash, msg.ContentURI); err != nil {
+		return err
+	}
 	if len(msg.RedistributionSplitRate) > types.MaximumSdkRatLength {
 		return ErrRedistributionSplitRateLengthTooLong()
 	}
@@ -217,6 +321,9 @@ func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 			return ErrURLLengthTooLong()
 		}
 	}
+	if err := validateTags(msg.Tags); err != nil {
+		return err
+	}
 
 	splitRate, err := sdk.NewDecFromStr(msg.RedistributionSplitRate)
 	if err != nil {
@@ -225,6 +332,38 @@ func (msg CreatePostMsg) ValidateBasic() sdk.Error {
 	if splitRate.LT(sdk.ZeroDec()) || splitRate.GT(sdk.OneDec()) {
 		return ErrInvalidPostRedistributionSplitRate()
 	}
+
+	if len(msg.Beneficiaries) > types.MaximumNumOfBeneficiaries {
+		return ErrTooManyBeneficiaries()
+	}
+	if len(msg.Beneficiaries) > 0 {
+		totalWeight := sdk.ZeroDec()
+		for i, beneficiary := range msg.Beneficiaries {
+			if len(beneficiary.Username) < types.MinimumUsernameLength ||
+				len(beneficiary.Username) > types.MaximumUsernameLength {
+				return ErrInvalidBeneficiary(beneficiary.Username)
+			}
+			for _, prev := range msg.Beneficiaries[:i] {
+				if prev.Username == beneficiary.Username {
+					return ErrInvalidBeneficiary(beneficiary.Username)
+				}
+			}
+			if len(beneficiary.Weight) > types.MaximumSdkRatLength {
+				return ErrInvalidBeneficiaryWeight()
+			}
+			weight, err := sdk.NewDecFromStr(beneficiary.Weight)
+			if err != nil {
+				return ErrInvalidBeneficiaryWeight()
+			}
+			if weight.LTE(sdk.ZeroDec()) || weight.GT(sdk.OneDec()) {
+				return ErrInvalidBeneficiaryWeight()
+			}
+			totalWeight = totalWeight.Add(weight)
+		}
+		if !totalWeight.Equal(sdk.OneDec()) {
+			return ErrInvalidBeneficiaryWeight()
+		}
+	}
 	return nil
 }
 
@@ -243,6 +382,13 @@ func (msg UpdatePostMsg) ValidateBasic() sdk.Error {
 	if utf8.RuneCountInString(msg.Content) > types.MaxPostContentLength {
 		return ErrPostContentExceedMaxLength()
 	}
+	if err := validateContentAddress(msg.Content, msg.ContentHash, msg.ContentURI); err != nil {
+		return err
+	}
+
+	if len(msg.Links) > types.MaximumNumOfLinks {
+		return ErrTooManyURL()
+	}
 
 	for _, link := range msg.Links {
 		if len(link.Identifier) > types.MaximumLinkIdentifier {
@@ -252,6 +398,9 @@ func (msg UpdatePostMsg) ValidateBasic() sdk.Error {
 			return ErrURLLengthTooLong()
 		}
 	}
+	if err := validateTags(msg.Tags); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -267,6 +416,17 @@ func (msg DeletePostMsg) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// ValidateBasic - implements sdk.Msg
+func (msg UpdateAllowRepliesMsg) ValidateBasic() sdk.Error {
+	if len(msg.PostID) == 0 {
+		return ErrNoPostID()
+	}
+	if len(msg.Author) == 0 {
+		return ErrNoAuthor()
+	}
+	return nil
+}
+
 // ValidateBasic - implements sdk.Msg
 func (msg DonateMsg) ValidateBasic() sdk.Error {
 	// Ensure permlink  exists
@@ -310,6 +470,51 @@ func (msg ViewMsg) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// ValidateBasic - implements sdk.Msg
+func (msg BatchViewMsg) ValidateBasic() sdk.Error {
+	if len(msg.App) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Views) == 0 {
+		return ErrInvalidBatchView()
+	}
+	if len(msg.Views) > types.MaximumNumOfBatchViews {
+		return ErrTooManyBatchViews()
+	}
+	totalCount := int64(0)
+	for _, view := range msg.Views {
+		if len(view.Username) == 0 {
+			return ErrNoUsername()
+		}
+		if len(view.Author) == 0 || len(view.PostID) == 0 {
+			return ErrInvalidTarget()
+		}
+		if view.Count <= 0 || view.Count > types.MaximumBatchViewCount {
+			return ErrInvalidBatchView()
+		}
+		// each count is capped before summing, so the total can't overflow.
+		totalCount += view.Count
+		if totalCount > types.MaximumBatchViewCount {
+			return ErrInvalidBatchView()
+		}
+	}
+	return nil
+}
+
+// ValidateBasic - implements sdk.Msg
+func (msg RefundDonationMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) == 0 || len(msg.Donor) == 0 {
+		return ErrNoUsername()
+	}
+	if len(msg.Author) == 0 || len(msg.PostID) == 0 {
+		return ErrInvalidTarget()
+	}
+	if msg.DonationID <= 0 {
+		return ErrInvalidDonationID()
+	}
+	return nil
+}
+
 // GetPermission - implements types.Msg
 func (msg CreatePostMsg) GetPermission() types.Permission {
 	return types.AppPermission
@@ -325,6 +530,11 @@ func (msg DeletePostMsg) GetPermission() types.Permission {
 	return types.AppPermission
 }
 
+// GetPermission - implements types.Msg
+func (msg UpdateAllowRepliesMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
 // GetPermission - implements types.Msg
 func (msg DonateMsg) GetPermission() types.Permission {
 	return types.PreAuthorizationPermission
@@ -340,6 +550,16 @@ func (msg ViewMsg) GetPermission() types.Permission {
 	return types.AppPermission
 }
 
+// GetPermission - implements types.Msg
+func (msg BatchViewMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
+// GetPermission - implements types.Msg
+func (msg RefundDonationMsg) GetPermission() types.Permission {
+	return types.AppPermission
+}
+
 // GetSignBytes - implements sdk.Msg
 func (msg CreatePostMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
@@ -355,6 +575,11 @@ func (msg DeletePostMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
 }
 
+// GetSignBytes - implements sdk.Msg
+func (msg UpdateAllowRepliesMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
 // GetSignBytes - implements sdk.Msg
 func (msg DonateMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
@@ -370,6 +595,16 @@ func (msg ViewMsg) GetSignBytes() []byte {
 	return getSignBytes(msg)
 }
 
+// GetSignBytes - implements sdk.Msg
+func (msg BatchViewMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg RefundDonationMsg) GetSignBytes() []byte {
+	return getSignBytes(msg)
+}
+
 func getSignBytes(msg sdk.Msg) []byte {
 	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
 	if err != nil {
@@ -393,6 +628,11 @@ func (msg DeletePostMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Author)}
 }
 
+// GetSigners - implements sdk.Msg
+func (msg UpdateAllowRepliesMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Author)}
+}
+
 // GetSigners - implements sdk.Msg
 func (msg DonateMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
@@ -408,12 +648,22 @@ func (msg ViewMsg) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
 }
 
+// GetSigners - implements sdk.Msg
+func (msg BatchViewMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.App)}
+}
+
+// GetSigners - implements sdk.Msg
+func (msg RefundDonationMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
 // String implements Stringer
 func (msg CreatePostMsg) String() string {
 	return fmt.Sprintf("Post.CreatePostMsg{author:%v, postID:%v, title:%v, content:%v, parentAuthor:%v,"+
-		"parentPostID:%v, sourceAuthor:%v, sourcePostID:%v,links:%v, redistribution split rate:%v}",
+		"parentPostID:%v, sourceAuthor:%v, sourcePostID:%v,links:%v, redistribution split rate:%v, beneficiaries:%v}",
 		msg.Author, msg.PostID, msg.Title, msg.Content, msg.ParentAuthor, msg.ParentPostID, msg.SourceAuthor, msg.SourcePostID,
-		msg.Links, msg.RedistributionSplitRate)
+		msg.Links, msg.RedistributionSplitRate, msg.Beneficiaries)
 }
 
 func (msg UpdatePostMsg) String() string {
@@ -425,6 +675,11 @@ func (msg DeletePostMsg) String() string {
 	return fmt.Sprintf("Post.DeletePostMsg{author:%v, postID:%v}", msg.Author, msg.PostID)
 }
 
+func (msg UpdateAllowRepliesMsg) String() string {
+	return fmt.Sprintf("Post.UpdateAllowRepliesMsg{author:%v, postID:%v, allowReplies:%v}",
+		msg.Author, msg.PostID, msg.AllowReplies)
+}
+
 func (msg DonateMsg) String() string {
 	return fmt.Sprintf(
 		"Post.DonateMsg{donation from: %v, amount: %v, post author:%v, post id: %v}",
@@ -443,6 +698,18 @@ func (msg ViewMsg) String() string {
 		msg.Username, msg.Author, msg.PostID)
 }
 
+func (msg BatchViewMsg) String() string {
+	return fmt.Sprintf(
+		"Post.BatchViewMsg{app: %v, views: %v}",
+		msg.App, len(msg.Views))
+}
+
+func (msg RefundDonationMsg) String() string {
+	return fmt.Sprintf(
+		"Post.RefundDonationMsg{from: %v, donor: %v, post author:%v, post id: %v, donation id: %v}",
+		msg.Username, msg.Donor, msg.Author, msg.PostID, msg.DonationID)
+}
+
 // GetConsumeAmount - implements types.Msg
 func (msg CreatePostMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
@@ -458,6 +725,11 @@ func (msg DeletePostMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+// GetConsumeAmount - implements types.Msg
+func (msg UpdateAllowRepliesMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 // GetConsumeAmount - implements types.Msg
 func (msg DonateMsg) GetConsumeAmount() types.Coin {
 	coin, _ := types.LinoToCoin(msg.Amount)
@@ -473,3 +745,60 @@ func (msg ReportOrUpvoteMsg) GetConsumeAmount() types.Coin {
 func (msg ViewMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// GetConsumeAmount - implements types.Msg
+func (msg BatchViewMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg RefundDonationMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// validateContentAddress - content hash and storage uri must come together,
+// and content-addressed post can't have inline content
+func validateContentAddress(content, contentHash, contentURI string) sdk.Error {
+	if len(contentHash) == 0 && len(contentURI) == 0 {
+		return nil
+	}
+	if !model.IsValidContentHash(contentHash) || len(contentURI) == 0 {
+		return ErrInvalidContentHash()
+	}
+	if len(contentURI) > types.MaximumLengthOfContentURI {
+		return ErrContentURITooLong()
+	}
+	if len(content) > 0 {
+		return ErrContentAndContentHashConflict()
+	}
+	return nil
+}
+
+// NormalizeTag - tag is trimmed and lower cased
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// validateTags - tags must be normalized, unique and only contain
+// lower case letters, digits and hyphens
+func validateTags(tags []string) sdk.Error {
+	if len(tags) > types.MaximumNumOfTags {
+		return ErrTooManyTags()
+	}
+	for i, tag := range tags {
+		if len(tag) == 0 || len(tag) > types.MaximumLengthOfTag {
+			return ErrInvalidTag(tag)
+		}
+		for _, c := range tag {
+			if !(c >= 'a' && c <= 'z') && !(c >= '0' && c <= '9') && c != '-' {
+				return ErrInvalidTag(tag)
+			}
+		}
+		for _, prev := range tags[:i] {
+			if prev == tag {
+				return ErrInvalidTag(tag)
+			}
+		}
+	}
+	return nil
+}
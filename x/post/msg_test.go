@@ -1,6 +1,8 @@
 package post
 
 import (
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/lino-network/lino/types"
@@ -50,13 +52,17 @@ var (
 	12345 67890 你好👌12345 67890 你好👌12345 67890 你好👌12345 67890 你好👌12345 67890 你好👌12345 67890 你好👌
 	12345 67890 你好👌12345 67890 你好👌12345 67890 你好👌12345 67890 你好👌12345 67890 你好👌12345 67890 你好👌
 	12345 67890 你好👌12345 67890 你好👌12345 67890 你好👌12345 67890 你好👌12345 67890 你好👌1234`
+
+	// sha256 of "content"
+	testContentHash = "ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73"
+	testContentURI  = "ipfs://QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"
 )
 
 func getCommentAndRepost(
 	t *testing.T, parentAuthor, parentPostID, sourceAuthor, sourcePostID string) CreatePostMsg {
 	return NewCreatePostMsg(
 		"author", "TestPostID", string(make([]byte, 100)), string(make([]byte, 1000)),
-		parentAuthor, parentPostID, sourceAuthor, sourcePostID, "0", nil)
+		parentAuthor, parentPostID, sourceAuthor, sourcePostID, "0", nil, nil)
 }
 
 func TestCreatePostMsg(t *testing.T) {
@@ -267,6 +273,265 @@ func TestCreatePostMsg(t *testing.T) {
 			},
 			expectedResult: ErrURLLengthTooLong(),
 		},
+		{
+			testName: "post with beneficiaries",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Beneficiaries:           []types.Beneficiary{{Username: "TestAuthor", Weight: "0.6"}, {Username: "coauthor", Weight: "0.4"}},
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "beneficiary weights don't sum to one",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Beneficiaries:           []types.Beneficiary{{Username: "TestAuthor", Weight: "0.6"}, {Username: "coauthor", Weight: "0.3"}},
+			},
+			expectedResult: ErrInvalidBeneficiaryWeight(),
+		},
+		{
+			testName: "beneficiary weight can't be zero",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Beneficiaries:           []types.Beneficiary{{Username: "TestAuthor", Weight: "1"}, {Username: "coauthor", Weight: "0"}},
+			},
+			expectedResult: ErrInvalidBeneficiaryWeight(),
+		},
+		{
+			testName: "beneficiary weight can't be negative",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Beneficiaries:           []types.Beneficiary{{Username: "TestAuthor", Weight: "1.5"}, {Username: "coauthor", Weight: "-0.5"}},
+			},
+			expectedResult: ErrInvalidBeneficiaryWeight(),
+		},
+		{
+			testName: "invalid beneficiary weight",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Beneficiaries:           []types.Beneficiary{{Username: "TestAuthor", Weight: "half"}},
+			},
+			expectedResult: ErrInvalidBeneficiaryWeight(),
+		},
+		{
+			testName: "duplicate beneficiary",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Beneficiaries:           []types.Beneficiary{{Username: "coauthor", Weight: "0.5"}, {Username: "coauthor", Weight: "0.5"}},
+			},
+			expectedResult: ErrInvalidBeneficiary("coauthor"),
+		},
+		{
+			testName: "invalid beneficiary username",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Beneficiaries:           []types.Beneficiary{{Username: "ab", Weight: "1"}},
+			},
+			expectedResult: ErrInvalidBeneficiary("ab"),
+		},
+		{
+			testName: "too many beneficiaries",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+				Beneficiaries:           []types.Beneficiary{{Username: "user0", Weight: "0.09"}, {Username: "user1", Weight: "0.09"}, {Username: "user2", Weight: "0.09"}, {Username: "user3", Weight: "0.09"}, {Username: "user4", Weight: "0.09"}, {Username: "user5", Weight: "0.09"}, {Username: "user6", Weight: "0.09"}, {Username: "user7", Weight: "0.09"}, {Username: "user8", Weight: "0.09"}, {Username: "user9", Weight: "0.09"}, {Username: "user10", Weight: "0.09"}},
+			},
+			expectedResult: ErrTooManyBeneficiaries(),
+		},
+		{
+			testName: "content-addressed post",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				ContentHash:             testContentHash,
+				ContentURI:              testContentURI,
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "content hash is not sha256",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				ContentHash:             testContentHash[:62],
+				ContentURI:              testContentURI,
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: ErrInvalidContentHash(),
+		},
+		{
+			testName: "upper case content hash",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				ContentHash:             strings.ToUpper(testContentHash),
+				ContentURI:              testContentURI,
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: ErrInvalidContentHash(),
+		},
+		{
+			testName: "content hash without uri",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				ContentHash:             testContentHash,
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: ErrInvalidContentHash(),
+		},
+		{
+			testName: "content uri without hash",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				ContentURI:              testContentURI,
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: ErrInvalidContentHash(),
+		},
+		{
+			testName: "content uri is too long",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				ContentHash:             testContentHash,
+				ContentURI:              string(make([]byte, types.MaximumLengthOfContentURI+1)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: ErrContentURITooLong(),
+		},
+		{
+			testName: "both content and content hash",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 "content",
+				ContentHash:             testContentHash,
+				ContentURI:              testContentURI,
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: ErrContentAndContentHashConflict(),
+		},
+		{
+			testName: "post with tags",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				Tags:                    []string{"gaming", "live-stream", "2019"},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "tag is not normalized",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				Tags:                    []string{"Gaming"},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: ErrInvalidTag("Gaming"),
+		},
+		{
+			testName: "empty tag",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				Tags:                    []string{""},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: ErrInvalidTag(""),
+		},
+		{
+			testName: "duplicate tags",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				Tags:                    []string{"gaming", "gaming"},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: ErrInvalidTag("gaming"),
+		},
+		{
+			testName: "too many tags",
+			msg: CreatePostMsg{
+				PostID:                  "TestPostID",
+				Title:                   string(make([]byte, 100)),
+				Content:                 string(make([]byte, 1000)),
+				Author:                  author,
+				Links:                   []types.IDToURLMapping{},
+				Tags:                    []string{"t1", "t2", "t3", "t4", "t5", "t6"},
+				RedistributionSplitRate: "0",
+			},
+			expectedResult: ErrTooManyTags(),
+		},
 	}
 	for _, tc := range testCases {
 		result := tc.msg.ValidateBasic()
@@ -344,6 +609,36 @@ func TestUpdatePostMsg(t *testing.T) {
 				[]types.IDToURLMapping{}),
 			expectedResult: ErrPostContentExceedMaxLength(),
 		},
+		{
+			testName: "update to content-addressed post",
+			updatePostMsg: UpdatePostMsg{
+				Author:      "author",
+				PostID:      "postID",
+				Title:       "title",
+				ContentHash: testContentHash,
+				ContentURI:  testContentURI,
+			},
+			expectedResult: nil,
+		},
+		{
+			testName: "update with both content and content hash",
+			updatePostMsg: UpdatePostMsg{
+				Author:      "author",
+				PostID:      "postID",
+				Title:       "title",
+				Content:     "content",
+				ContentHash: testContentHash,
+				ContentURI:  testContentURI,
+			},
+			expectedResult: ErrContentAndContentHashConflict(),
+		},
+		{
+			testName: "too many links",
+			updatePostMsg: NewUpdatePostMsg(
+				"author", "postID", "title", "content",
+				make([]types.IDToURLMapping, types.MaximumNumOfLinks+1)),
+			expectedResult: ErrTooManyURL(),
+		},
 	}
 	for _, tc := range testCases {
 		result := tc.updatePostMsg.ValidateBasic()
@@ -397,6 +692,36 @@ func TestDeletePostMsg(t *testing.T) {
 	}
 }
 
+func TestUpdateAllowRepliesMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           UpdateAllowRepliesMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			msg:           NewUpdateAllowRepliesMsg("author", "postID", false),
+			expectedError: nil,
+		},
+		{
+			testName:      "empty author",
+			msg:           NewUpdateAllowRepliesMsg("", "postID", true),
+			expectedError: ErrNoAuthor(),
+		},
+		{
+			testName:      "empty postID",
+			msg:           NewUpdateAllowRepliesMsg("author", "", true),
+			expectedError: ErrNoPostID(),
+		},
+	}
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestCommentAndRepost(t *testing.T) {
 	parentAuthor := "Parent"
 	parentPostID := "ParentPostID"
@@ -605,6 +930,133 @@ func TestViewMsg(t *testing.T) {
 	}
 }
 
+func TestBatchViewMsg(t *testing.T) {
+	tooManyViews := make([]BatchView, types.MaximumNumOfBatchViews+1)
+	for i := range tooManyViews {
+		tooManyViews[i] = NewBatchView("test", "author", "postID", 1)
+	}
+
+	testCases := []struct {
+		testName      string
+		batchViewMsg  BatchViewMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName: "normal case",
+			batchViewMsg: NewBatchViewMsg("app", []BatchView{
+				NewBatchView("test", "author", "postID", 1),
+				NewBatchView("test2", "author", "postID", 10),
+			}),
+			expectedError: nil,
+		},
+		{
+			testName:      "no app",
+			batchViewMsg:  NewBatchViewMsg("", []BatchView{NewBatchView("test", "author", "postID", 1)}),
+			expectedError: ErrNoUsername(),
+		},
+		{
+			testName:      "empty batch",
+			batchViewMsg:  NewBatchViewMsg("app", []BatchView{}),
+			expectedError: ErrInvalidBatchView(),
+		},
+		{
+			testName:      "too many views",
+			batchViewMsg:  NewBatchViewMsg("app", tooManyViews),
+			expectedError: ErrTooManyBatchViews(),
+		},
+		{
+			testName:      "no username",
+			batchViewMsg:  NewBatchViewMsg("app", []BatchView{NewBatchView("", "author", "postID", 1)}),
+			expectedError: ErrNoUsername(),
+		},
+		{
+			testName:      "invalid target - no author",
+			batchViewMsg:  NewBatchViewMsg("app", []BatchView{NewBatchView("test", "", "postID", 1)}),
+			expectedError: ErrInvalidTarget(),
+		},
+		{
+			testName:      "zero count",
+			batchViewMsg:  NewBatchViewMsg("app", []BatchView{NewBatchView("test", "author", "postID", 0)}),
+			expectedError: ErrInvalidBatchView(),
+		},
+		{
+			testName: "count exceeds hard limit",
+			batchViewMsg: NewBatchViewMsg("app", []BatchView{
+				NewBatchView("test", "author", "postID", types.MaximumBatchViewCount+1)}),
+			expectedError: ErrInvalidBatchView(),
+		},
+		{
+			testName: "total count overflows",
+			batchViewMsg: NewBatchViewMsg("app", []BatchView{
+				NewBatchView("test", "author", "postID", math.MaxInt64),
+				NewBatchView("test2", "author", "postID", math.MaxInt64),
+			}),
+			expectedError: ErrInvalidBatchView(),
+		},
+		{
+			testName: "total count exceeds hard limit",
+			batchViewMsg: NewBatchViewMsg("app", []BatchView{
+				NewBatchView("test", "author", "postID", types.MaximumBatchViewCount),
+				NewBatchView("test2", "author", "postID", 1),
+			}),
+			expectedError: ErrInvalidBatchView(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.batchViewMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestRefundDonationMsg(t *testing.T) {
+	testCases := []struct {
+		testName          string
+		refundDonationMsg RefundDonationMsg
+		expectedError     sdk.Error
+	}{
+		{
+			testName:          "normal case",
+			refundDonationMsg: NewRefundDonationMsg("test", "test", "author", "postID", 1),
+			expectedError:     nil,
+		},
+		{
+			testName:          "app refunds donation",
+			refundDonationMsg: NewRefundDonationMsg("app", "test", "author", "postID", 1),
+			expectedError:     nil,
+		},
+		{
+			testName:          "no username",
+			refundDonationMsg: NewRefundDonationMsg("", "test", "author", "postID", 1),
+			expectedError:     ErrNoUsername(),
+		},
+		{
+			testName:          "no donor",
+			refundDonationMsg: NewRefundDonationMsg("test", "", "author", "postID", 1),
+			expectedError:     ErrNoUsername(),
+		},
+		{
+			testName:          "invalid target - no post id",
+			refundDonationMsg: NewRefundDonationMsg("test", "test", "author", "", 1),
+			expectedError:     ErrInvalidTarget(),
+		},
+		{
+			testName:          "zero donation id",
+			refundDonationMsg: NewRefundDonationMsg("test", "test", "author", "postID", 0),
+			expectedError:     ErrInvalidDonationID(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.refundDonationMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName           string
@@ -645,6 +1097,23 @@ func TestMsgPermission(t *testing.T) {
 				"test", "author", "postID"),
 			expectedPermission: types.AppPermission,
 		},
+		{
+			testName: "batch view post",
+			msg: NewBatchViewMsg(
+				"app", []BatchView{NewBatchView("test", "author", "postID", 2)}),
+			expectedPermission: types.AppPermission,
+		},
+		{
+			testName: "refund donation",
+			msg: NewRefundDonationMsg(
+				"app", "test", "author", "postID", 1),
+			expectedPermission: types.AppPermission,
+		},
+		{
+			testName:           "update allow replies",
+			msg:                NewUpdateAllowRepliesMsg("author", "postID", false),
+			expectedPermission: types.AppPermission,
+		},
 		{
 			testName: "report post",
 			msg: NewReportOrUpvoteMsg(
@@ -709,6 +1178,20 @@ func TestGetSignBytes(t *testing.T) {
 			msg: NewViewMsg(
 				"test", "author", "postID"),
 		},
+		{
+			testName: "batch view post",
+			msg: NewBatchViewMsg(
+				"app", []BatchView{NewBatchView("test", "author", "postID", 2)}),
+		},
+		{
+			testName: "refund donation",
+			msg: NewRefundDonationMsg(
+				"app", "test", "author", "postID", 1),
+		},
+		{
+			testName: "update allow replies",
+			msg:      NewUpdateAllowRepliesMsg("author", "postID", false),
+		},
 		{
 			testName: "report post",
 			msg: NewReportOrUpvoteMsg(
@@ -771,6 +1254,23 @@ func TestGetSigners(t *testing.T) {
 				"test", "author", "postID"),
 			expectSigners: []types.AccountKey{"test"},
 		},
+		{
+			testName: "batch view post",
+			msg: NewBatchViewMsg(
+				"app", []BatchView{NewBatchView("test", "author", "postID", 2)}),
+			expectSigners: []types.AccountKey{"app"},
+		},
+		{
+			testName: "refund donation",
+			msg: NewRefundDonationMsg(
+				"app", "test", "author", "postID", 1),
+			expectSigners: []types.AccountKey{"app"},
+		},
+		{
+			testName:      "update allow replies",
+			msg:           NewUpdateAllowRepliesMsg("author", "postID", false),
+			expectSigners: []types.AccountKey{"author"},
+		},
 		{
 			testName: "report post",
 			msg: NewReportOrUpvoteMsg(
@@ -845,6 +1345,23 @@ func TestGetConsumeAmount(t *testing.T) {
 				"test", "author", "postID"),
 			expectAmount: types.NewCoinFromInt64(0),
 		},
+		{
+			testName: "batch view post",
+			msg: NewBatchViewMsg(
+				"app", []BatchView{NewBatchView("test", "author", "postID", 2)}),
+			expectAmount: types.NewCoinFromInt64(0),
+		},
+		{
+			testName: "refund donation",
+			msg: NewRefundDonationMsg(
+				"app", "test", "author", "postID", 1),
+			expectAmount: types.NewCoinFromInt64(0),
+		},
+		{
+			testName:     "update allow replies",
+			msg:          NewUpdateAllowRepliesMsg("author", "postID", false),
+			expectAmount: types.NewCoinFromInt64(0),
+		},
 		{
 			testName: "report post",
 			msg: NewReportOrUpvoteMsg(
@@ -1,9 +1,13 @@
 package post
 
 import (
+	"strconv"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global"
+	rep "github.com/lino-network/lino/x/reputation"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -17,15 +21,61 @@ const (
 	// QuerierRoute is the querier route for gov
 	QuerierRoute = ModuleName
 
-	QueryPostInfo           = "info"
-	QueryPostMeta           = "meta"
-	QueryPostReportOrUpvote = "reportOrUpvote"
-	QueryPostComment        = "comment"
-	QueryPostView           = "view"
+	QueryPostInfo             = "info"
+	QueryPostMeta             = "meta"
+	QueryPostReportOrUpvote   = "reportOrUpvote"
+	QueryPostReportOrUpvotes  = "reportOrUpvotes"
+	QueryUserReportOrUpvotes  = "userReportOrUpvotes"
+	QueryPostComment          = "comment"
+	QueryPostView             = "view"
+	QueryBeneficiaryRewards   = "beneficiaryRewards"
+	QueryPostRevisions        = "revisions"
+	QueryPostRevision         = "revision"
+	QueryPostPendingRewards   = "pendingRewards"
+	QueryAuthorPendingRewards = "authorPendingRewards"
+	QueryTagPosts             = "tag"
+	QueryDonations            = "donations"
+	QueryCommentTree          = "commentTree"
+
+	// maxTagPostsLimit - maximum number of posts returned by a tag query
+	maxTagPostsLimit = 100
+	// maxCommentTreeLimit - maximum number of comments returned by a comment tree query
+	maxCommentTreeLimit = 100
+	// maxCommentTreeLevel - maximum level of thread a comment tree query can walk to
+	maxCommentTreeLevel = 32
+	// maxCommentTreeScan - maximum number of comments a comment tree query can load
+	maxCommentTreeScan = 10000
 )
 
+// CommentNode - comment in the thread of a root post
+type CommentNode struct {
+	Permlink       types.Permlink   `json:"permlink"`
+	ParentPermlink types.Permlink   `json:"parent_permlink"`
+	Author         types.AccountKey `json:"author"`
+	PostID         string           `json:"post_id"`
+	Depth          int64            `json:"depth"`
+	CreatedAt      int64            `json:"created_at"`
+}
+
+// PendingReward - reward event not executed yet with reward estimated from
+// current consumption window, reward pool and post penalty score
+type PendingReward struct {
+	Permlink        types.Permlink   `json:"permlink"`
+	Consumer        types.AccountKey `json:"consumer"`
+	Evaluate        types.Coin       `json:"evaluate"`
+	Friction        types.Coin       `json:"friction"`
+	ScheduledAt     int64            `json:"scheduled_at"`
+	EstimatedReward types.Coin       `json:"estimated_reward"`
+}
+
+// PendingRewards - all pending rewards of a post or an author
+type PendingRewards struct {
+	Rewards              []PendingReward `json:"rewards"`
+	TotalEstimatedReward types.Coin      `json:"total_estimated_reward"`
+}
+
 // creates a querier for post REST endpoints
-func NewQuerier(pm PostManager) sdk.Querier {
+func NewQuerier(pm PostManager, gm *global.GlobalManager, rm rep.ReputationManager) sdk.Querier {
 	cdc := wire.New()
 	wire.RegisterCrypto(cdc)
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
@@ -36,6 +86,26 @@ func NewQuerier(pm PostManager) sdk.Querier {
 			return queryPostMeta(ctx, cdc, path[1:], req, pm)
 		case QueryPostReportOrUpvote:
 			return queryReportOrUpvote(ctx, cdc, path[1:], req, pm)
+		case QueryPostReportOrUpvotes:
+			return queryPostReportOrUpvotes(ctx, cdc, path[1:], req, pm)
+		case QueryUserReportOrUpvotes:
+			return queryUserReportOrUpvotes(ctx, cdc, path[1:], req, pm)
+		case QueryBeneficiaryRewards:
+			return queryBeneficiaryRewards(ctx, cdc, path[1:], req, pm)
+		case QueryPostRevisions:
+			return queryPostRevisions(ctx, cdc, path[1:], req, pm)
+		case QueryPostRevision:
+			return queryPostRevision(ctx, cdc, path[1:], req, pm)
+		case QueryPostPendingRewards:
+			return queryPostPendingRewards(ctx, cdc, path[1:], req, pm, gm, rm)
+		case QueryAuthorPendingRewards:
+			return queryAuthorPendingRewards(ctx, cdc, path[1:], req, pm, gm, rm)
+		case QueryTagPosts:
+			return queryTagPosts(ctx, cdc, path[1:], req, pm)
+		case QueryDonations:
+			return queryDonations(ctx, cdc, path[1:], req, pm)
+		case QueryCommentTree:
+			return queryCommentTree(ctx, cdc, path[1:], req, pm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown post query endpoint")
 		}
@@ -86,3 +156,299 @@ func queryReportOrUpvote(ctx sdk.Context, cdc *wire.Codec, path []string, req ab
 	}
 	return res, nil
 }
+
+func queryPostReportOrUpvotes(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	reportOrUpvotes, err := pm.GetReportOrUpvotes(ctx, types.Permlink(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(reportOrUpvotes)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryUserReportOrUpvotes(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	reportOrUpvotes, err := pm.GetUserReportOrUpvotes(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(reportOrUpvotes)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryBeneficiaryRewards(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	rewards, err := pm.GetBeneficiaryRewards(ctx, types.Permlink(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(rewards)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryPostRevisions(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	revisions, err := pm.GetPostRevisions(ctx, types.Permlink(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(revisions)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryPostRevision(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 2); err != nil {
+		return nil, err
+	}
+	revisionID, parseErr := strconv.ParseInt(path[1], 10, 64)
+	if parseErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	revision, err := pm.GetPostRevision(ctx, types.Permlink(path[0]), revisionID)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(revision)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryDonations - path is permlink and donor
+func queryDonations(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 2); err != nil {
+		return nil, err
+	}
+	donations, err := pm.GetDonations(ctx, types.Permlink(path[0]), types.AccountKey(path[1]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(donations)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryTagPosts - path is tag with optional offset and limit,
+// limit is capped by maxTagPostsLimit
+func queryTagPosts(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	offset, limit := int64(0), int64(maxTagPostsLimit)
+	if len(path) > 1 {
+		var parseErr error
+		if offset, parseErr = strconv.ParseInt(path[1], 10, 64); parseErr != nil || offset < 0 {
+			return nil, ErrQueryFailed()
+		}
+	}
+	if len(path) > 2 {
+		var parseErr error
+		if limit, parseErr = strconv.ParseInt(path[2], 10, 64); parseErr != nil || limit <= 0 {
+			return nil, ErrQueryFailed()
+		}
+		if limit > maxTagPostsLimit {
+			limit = maxTagPostsLimit
+		}
+	}
+	tagPosts := pm.GetTagPosts(ctx, path[0], offset, limit)
+	res, marshalErr := cdc.MarshalJSON(tagPosts)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryCommentTree - path is root permlink and level with optional offset and limit,
+// returns comments at the level of the thread, limit is capped by maxCommentTreeLimit
+// and level is capped by maxCommentTreeLevel
+func queryCommentTree(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm PostManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 2); err != nil {
+		return nil, err
+	}
+	level, parseErr := strconv.ParseInt(path[1], 10, 64)
+	if parseErr != nil || level <= 0 || level > maxCommentTreeLevel {
+		return nil, ErrQueryFailed()
+	}
+	offset, limit := int64(0), int64(maxCommentTreeLimit)
+	if len(path) > 2 {
+		if offset, parseErr = strconv.ParseInt(path[2], 10, 64); parseErr != nil || offset < 0 {
+			return nil, ErrQueryFailed()
+		}
+	}
+	if len(path) > 3 {
+		if limit, parseErr = strconv.ParseInt(path[3], 10, 64); parseErr != nil || limit <= 0 {
+			return nil, ErrQueryFailed()
+		}
+		if limit > maxCommentTreeLimit {
+			limit = maxCommentTreeLimit
+		}
+	}
+	nodes, err := getCommentTreeLevel(ctx, pm, types.Permlink(path[0]), level, maxCommentTreeScan)
+	if err != nil {
+		return nil, err
+	}
+	if offset > int64(len(nodes)) {
+		offset = int64(len(nodes))
+	}
+	nodes = nodes[offset:]
+	if limit < int64(len(nodes)) {
+		nodes = nodes[:limit]
+	}
+	res, marshalErr := cdc.MarshalJSON(nodes)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// getCommentTreeLevel - walk the thread of root post level by level and
+// return comments at the level, ordered by parent then created time,
+// the walk fails if it loads more than maxScan comments
+func getCommentTreeLevel(
+	ctx sdk.Context, pm PostManager, root types.Permlink, level int64, maxScan int) ([]CommentNode, sdk.Error) {
+	if !pm.DoesPostExist(ctx, root) {
+		return nil, ErrPostNotFound(root)
+	}
+	parents := []types.Permlink{root}
+	nodes := []CommentNode{}
+	scanned := 0
+	for depth := int64(1); depth <= level && len(parents) > 0; depth++ {
+		nodes = []CommentNode{}
+		for _, parent := range parents {
+			comments, err := pm.GetComments(ctx, parent)
+			if err != nil {
+				return nil, err
+			}
+			scanned += len(comments)
+			if scanned > maxScan {
+				return nil, ErrCommentTreeTooLarge(root)
+			}
+			for _, comment := range comments {
+				nodes = append(nodes, CommentNode{
+					Permlink:       types.GetPermlink(comment.Author, comment.PostID),
+					ParentPermlink: parent,
+					Author:         comment.Author,
+					PostID:         comment.PostID,
+					Depth:          depth,
+					CreatedAt:      comment.CreatedAt,
+				})
+			}
+		}
+		parents = []types.Permlink{}
+		for _, node := range nodes {
+			parents = append(parents, node.Permlink)
+		}
+	}
+	return nodes, nil
+}
+
+func queryPostPendingRewards(
+	ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery,
+	pm PostManager, gm *global.GlobalManager, rm rep.ReputationManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	permlink := types.Permlink(path[0])
+	pendingRewards, err := getPendingRewards(ctx, pm, gm, rm, func(event RewardEvent) bool {
+		return types.GetPermlink(event.PostAuthor, event.PostID) == permlink
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(pendingRewards)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryAuthorPendingRewards(
+	ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery,
+	pm PostManager, gm *global.GlobalManager, rm rep.ReputationManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	author := types.AccountKey(path[0])
+	pendingRewards, err := getPendingRewards(ctx, pm, gm, rm, func(event RewardEvent) bool {
+		return event.PostAuthor == author
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(pendingRewards)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// getPendingRewards - collect reward events not executed yet and estimate
+// each reward as if it were executed now
+func getPendingRewards(
+	ctx sdk.Context, pm PostManager, gm *global.GlobalManager, rm rep.ReputationManager,
+	match func(event RewardEvent) bool) (*PendingRewards, sdk.Error) {
+	eventLists, err := gm.GetPendingTimeEventLists(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pendingRewards := &PendingRewards{
+		Rewards:              []PendingReward{},
+		TotalEstimatedReward: types.NewCoinFromInt64(0),
+	}
+	penaltyScores := map[types.Permlink]sdk.Dec{}
+	for _, eventList := range eventLists {
+		for _, e := range eventList.TimeEventList.Events {
+			event, ok := e.(RewardEvent)
+			if !ok || !match(event) {
+				continue
+			}
+			permlink := types.GetPermlink(event.PostAuthor, event.PostID)
+			penaltyScore, ok := penaltyScores[permlink]
+			if !ok {
+				penaltyScore, err = getPostPenaltyScore(ctx, pm, rm, permlink)
+				if err != nil {
+					return nil, err
+				}
+				penaltyScores[permlink] = penaltyScore
+			}
+			estimated, err := gm.EstimateReward(ctx, event.Evaluate, penaltyScore)
+			if err != nil {
+				return nil, err
+			}
+			pendingRewards.Rewards = append(pendingRewards.Rewards, PendingReward{
+				Permlink:        permlink,
+				Consumer:        event.Consumer,
+				Evaluate:        event.Evaluate,
+				Friction:        event.Friction,
+				ScheduledAt:     eventList.UnixTime,
+				EstimatedReward: estimated,
+			})
+			pendingRewards.TotalEstimatedReward = pendingRewards.TotalEstimatedReward.Plus(estimated)
+		}
+	}
+	return pendingRewards, nil
+}
@@ -60,6 +60,7 @@ func setupTest(
 	cdc := globalManager.WireCodec()
 	cdc.RegisterInterface((*types.Event)(nil), nil)
 	cdc.RegisterConcrete(RewardEvent{}, "event/reward", nil)
+	cdc.RegisterConcrete(ExpireDonationEvent{}, "event/expireDonation", nil)
 
 	err := InitGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
@@ -118,8 +119,8 @@ func createTestPost(
 	assert.Nil(t, err)
 	err = pm.CreatePost(
 		ctx, types.AccountKey(user), postID, "", "", "", "",
-		string(make([]byte, 1000)), string(make([]byte, 50)),
-		splitRate, []types.IDToURLMapping{})
+		string(make([]byte, 1000)), string(make([]byte, 50)), "", "",
+		splitRate, []types.IDToURLMapping{}, nil, nil)
 	assert.Nil(t, err)
 	return user, postID
 }
@@ -132,8 +133,8 @@ func createTestRepost(
 
 	err := pm.CreatePost(
 		ctx, types.AccountKey(user), postID, sourceUser, sourcePostID, "", "",
-		string(make([]byte, 1000)), string(make([]byte, 50)),
-		sdk.ZeroDec(), []types.IDToURLMapping{})
+		string(make([]byte, 1000)), string(make([]byte, 50)), "", "",
+		sdk.ZeroDec(), []types.IDToURLMapping{}, nil, nil)
 	assert.Nil(t, err)
 	return user, postID
 }
@@ -9,9 +9,12 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(CreatePostMsg{}, "lino/createPost", nil)
 	cdc.RegisterConcrete(UpdatePostMsg{}, "lino/updatePost", nil)
 	cdc.RegisterConcrete(DeletePostMsg{}, "lino/deletePost", nil)
+	cdc.RegisterConcrete(UpdateAllowRepliesMsg{}, "lino/updateAllowReplies", nil)
 	cdc.RegisterConcrete(DonateMsg{}, "lino/donate", nil)
 	cdc.RegisterConcrete(ViewMsg{}, "lino/view", nil)
+	cdc.RegisterConcrete(BatchViewMsg{}, "lino/batchView", nil)
 	cdc.RegisterConcrete(ReportOrUpvoteMsg{}, "lino/reportOrUpvote", nil)
+	cdc.RegisterConcrete(RefundDonationMsg{}, "lino/refundDonation", nil)
 }
 
 var msgCdc = wire.New()
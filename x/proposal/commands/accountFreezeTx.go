@@ -0,0 +1,66 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/proposal"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountFreezeTxCmd will create an accountFreeze tx and sign it with the given key
+func AccountFreezeTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-account",
+		Short: "propose to freeze transfers, donations and stake changes of an account",
+		RunE:  sendAccountFreezeTx(cdc, false),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "proposal creator")
+	cmd.Flags().String(client.FlagUser, "", "account to freeze")
+	cmd.Flags().String(client.FlagReason, "", "reason of the freeze")
+	return cmd
+}
+
+// AccountUnfreezeTxCmd will create an accountUnfreeze tx and sign it with the given key
+func AccountUnfreezeTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-account",
+		Short: "propose to unfreeze a frozen account",
+		RunE:  sendAccountFreezeTx(cdc, true),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "proposal creator")
+	cmd.Flags().String(client.FlagUser, "", "account to unfreeze")
+	cmd.Flags().String(client.FlagReason, "", "reason of the unfreeze")
+	return cmd
+}
+
+func sendAccountFreezeTx(cdc *wire.Codec, unfreeze bool) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		creator := viper.GetString(client.FlagCreator)
+		user := viper.GetString(client.FlagUser)
+		reason := viper.GetString(client.FlagReason)
+
+		// create the message
+		var msg sdk.Msg = proposal.NewAccountFreezeMsg(creator, user, reason)
+		if unfreeze {
+			msg = proposal.NewAccountUnfreezeMsg(creator, user, reason)
+		}
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -0,0 +1,50 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/proposal"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContentAppealTxCmd will create a contentAppeal tx and sign it with the given key
+func ContentAppealTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "appeal-censorship",
+		Short: "appeal against a passed content censorship of your post",
+		RunE:  sendContentAppealTx(cdc),
+	}
+	cmd.Flags().String(client.FlagAuthor, "", "author of the censored post")
+	cmd.Flags().Int64(client.FlagProposalID, -1, "content censorship proposal id")
+	cmd.Flags().String(client.FlagReason, "", "reason of the appeal")
+	return cmd
+}
+
+func sendContentAppealTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		author := viper.GetString(client.FlagAuthor)
+		id := viper.GetInt64(client.FlagProposalID)
+		reason := viper.GetString(client.FlagReason)
+
+		// create the message
+		msg := proposal.NewContentAppealMsg(author, id, reason)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -6,11 +6,16 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global"
+	"github.com/lino-network/lino/x/proposal"
 	"github.com/lino-network/lino/x/proposal/model"
+
+	votemodel "github.com/lino-network/lino/x/vote/model"
 )
 
 // GetProposalCmd returns a specific ongoing proposal
@@ -95,3 +100,271 @@ func (c commander) getExpiredProposalCmd(cmd *cobra.Command, args []string) erro
 	fmt.Println(string(output))
 	return nil
 }
+
+// GetProposalDepositsCmd returns deposits of all depositors to a proposal
+func GetProposalDepositsCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "query-proposal-deposits <proposalID>",
+		Short: "Query deposits of all depositors to a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 1 {
+				return errors.New("You must provide proposal ID")
+			}
+
+			res, err := ctx.QueryCustom(proposal.QuerierRoute, proposal.QueryProposalDeposits, args[0])
+			if err != nil {
+				return err
+			}
+			deposits := []model.ProposalDeposit{}
+			if err := cdc.UnmarshalJSON(res, &deposits); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(deposits); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetProposalListCmd returns ongoing or expired proposals match the filters
+func GetProposalListCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list <ongoing|expired> [offset] [limit]",
+		Short: "Query ongoing or expired proposals",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) < 1 || len(args) > 3 {
+				return errors.New("You must provide ongoing or expired")
+			}
+			offset, limit := "0", "100"
+			if len(args) > 1 {
+				offset = args[1]
+			}
+			if len(args) > 2 {
+				limit = args[2]
+			}
+			path := []string{proposal.QueryProposalList, args[0], offset, limit}
+			if proposalType := viper.GetString(client.FlagProposalType); proposalType != "" {
+				path = append(path, proposal.ProposalTypeFilter+"="+proposalType)
+			}
+			if result := viper.GetString(client.FlagResult); result != "" {
+				path = append(path, proposal.ProposalResultFilter+"="+result)
+			}
+			if creator := viper.GetString(client.FlagCreator); creator != "" {
+				path = append(path, proposal.ProposalCreatorFilter+"="+creator)
+			}
+
+			res, err := ctx.QueryCustom(proposal.QuerierRoute, path...)
+			if err != nil {
+				return err
+			}
+			proposals := []json.RawMessage{}
+			if err := json.Unmarshal(res, &proposals); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(proposals); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+	cmd.Flags().String(client.FlagProposalType, "", "proposal type: change_param, content_censorship, protocol_upgrade, treasury_spend, content_appeal, account_freeze or account_unfreeze")
+	cmd.Flags().String(client.FlagResult, "", "proposal result: not_pass, pass, revoked, vetoed or deposit_not_met")
+	cmd.Flags().String(client.FlagCreator, "", "creator of the proposal")
+	return cmd
+}
+
+// GetProposalTallyCmd returns tally of a proposal with its pass requirement
+func GetProposalTallyCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tally <proposalID>",
+		Short: "Query tally of a proposal, tally of ongoing proposal is based on current voting power",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 1 {
+				return errors.New("You must provide proposal ID")
+			}
+
+			res, err := ctx.QueryCustom(proposal.QuerierRoute, proposal.QueryProposalTally, args[0])
+			if err != nil {
+				return err
+			}
+			tally := new(proposal.ProposalTally)
+			if err := cdc.UnmarshalJSON(res, tally); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(tally); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetProposalVotesCmd returns votes of a proposal
+func GetProposalVotesCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "votes <proposalID> [offset] [limit]",
+		Short: "Query votes of a proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) < 1 || len(args) > 3 {
+				return errors.New("You must provide proposal ID")
+			}
+			path := append([]string{proposal.QueryProposalVotes}, args...)
+
+			res, err := ctx.QueryCustom(proposal.QuerierRoute, path...)
+			if err != nil {
+				return err
+			}
+			votes := []votemodel.Vote{}
+			if err := cdc.UnmarshalJSON(res, &votes); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(votes); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetPendingVotesCmd returns validators who haven't voted on each ongoing proposal
+func GetPendingVotesCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-votes",
+		Short: "Query validators who haven't voted on ongoing proposals",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			res, err := ctx.QueryCustom(proposal.QuerierRoute, proposal.QueryPendingVotes)
+			if err != nil {
+				return err
+			}
+			pendingVotes := []proposal.PendingVotes{}
+			if err := cdc.UnmarshalJSON(res, &pendingVotes); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(pendingVotes); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetTreasuryCmd returns coin remaining in treasury pool
+func GetTreasuryCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "treasury",
+		Short: "Query coin remaining in treasury pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			res, err := ctx.QueryCustom(global.QuerierRoute, global.QueryTreasury)
+			if err != nil {
+				return err
+			}
+			treasury := types.NewCoinFromInt64(0)
+			if err := cdc.UnmarshalJSON(res, &treasury); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(treasury); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetTreasurySpendsCmd returns past treasury spends
+func GetTreasurySpendsCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "treasury-spends [offset] [limit]",
+		Short: "Query past treasury spends",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) > 2 {
+				return errors.New("You can only provide offset and limit")
+			}
+			path := append([]string{proposal.QueryTreasurySpends}, args...)
+
+			res, err := ctx.QueryCustom(proposal.QuerierRoute, path...)
+			if err != nil {
+				return err
+			}
+			spends := []model.TreasurySpend{}
+			if err := cdc.UnmarshalJSON(res, &spends); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(spends); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetAccountFreezeCmd returns freeze of an account
+func GetAccountFreezeCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "account-freeze <username>",
+		Short: "Query freeze of a frozen account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 1 {
+				return errors.New("You must provide a username")
+			}
+
+			res, err := ctx.QueryCustom(proposal.QuerierRoute, proposal.QueryAccountFreeze, args[0])
+			if err != nil {
+				return err
+			}
+			freeze := new(model.AccountFreeze)
+			if err := cdc.UnmarshalJSON(res, freeze); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(freeze); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// GetAccountFreezesCmd returns all frozen accounts
+func GetAccountFreezesCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "account-freezes [offset] [limit]",
+		Short: "Query all frozen accounts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) > 2 {
+				return errors.New("You can only provide offset and limit")
+			}
+			path := append([]string{proposal.QueryAccountFreezes}, args...)
+
+			res, err := ctx.QueryCustom(proposal.QuerierRoute, path...)
+			if err != nil {
+				return err
+			}
+			freezes := []model.AccountFreeze{}
+			if err := cdc.UnmarshalJSON(res, &freezes); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(freezes); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/proposal"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TreasurySpendTxCmd will create a treasurySpend tx and sign it with the given key
+func TreasurySpendTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-spend",
+		Short: "propose to pay recipient from treasury, at once or vested by times and interval",
+		RunE:  sendTreasurySpendTx(cdc),
+	}
+	cmd.Flags().String(client.FlagCreator, "", "creator of the proposal")
+	cmd.Flags().String(client.FlagRecipient, "", "recipient of the spend")
+	cmd.Flags().String(client.FlagAmount, "", "amount of LNO to spend")
+	cmd.Flags().Int64(client.FlagTimes, 1, "number of payments")
+	cmd.Flags().Int64(client.FlagInterval, 0, "seconds between payments")
+	cmd.Flags().String(client.FlagReason, "", "reason of the spend")
+	return cmd
+}
+
+func sendTreasurySpendTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		creator := viper.GetString(client.FlagCreator)
+		recipient := viper.GetString(client.FlagRecipient)
+		amount := types.LNO(viper.GetString(client.FlagAmount))
+		times := viper.GetInt64(client.FlagTimes)
+		interval := viper.GetInt64(client.FlagInterval)
+		reason := viper.GetString(client.FlagReason)
+
+		// create the message
+		msg := proposal.NewTreasurySpendMsg(creator, recipient, amount, times, interval, reason)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/proposal"
 
 	wire "github.com/cosmos/cosmos-sdk/codec"
@@ -23,7 +24,7 @@ func VoteProposalTxCmd(cdc *wire.Codec) *cobra.Command {
 	}
 	cmd.Flags().String(client.FlagVoter, "", "voter for the proposal")
 	cmd.Flags().Int64(client.FlagProposalID, -1, "proposal id")
-	cmd.Flags().Bool(client.FlagResult, true, "vote result")
+	cmd.Flags().String(client.FlagOption, "yes", "vote option: yes, no, abstain or no_with_veto")
 	return cmd
 }
 
@@ -32,10 +33,13 @@ func sendVoteProposalTx(cdc *wire.Codec) client.CommandTxCallback {
 		ctx := client.NewCoreContextFromViper()
 		voter := viper.GetString(client.FlagVoter)
 		id := viper.GetInt64(client.FlagProposalID)
-		result := viper.GetBool(client.FlagResult)
+		option, err := parseVoteOption(viper.GetString(client.FlagOption))
+		if err != nil {
+			return err
+		}
 
 		// create the message
-		msg := proposal.NewVoteProposalMsg(voter, id, result)
+		msg := proposal.NewVoteProposalMsg(voter, id, option)
 
 		// build and sign the transaction, then broadcast to Tendermint
 		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
@@ -48,3 +52,17 @@ func sendVoteProposalTx(cdc *wire.Codec) client.CommandTxCallback {
 		return nil
 	}
 }
+
+func parseVoteOption(option string) (types.VoteOption, error) {
+	switch option {
+	case "yes":
+		return types.VoteOptionYes, nil
+	case "no":
+		return types.VoteOptionNo, nil
+	case "abstain":
+		return types.VoteOptionAbstain, nil
+	case "no_with_veto":
+		return types.VoteOptionNoWithVeto, nil
+	}
+	return 0, fmt.Errorf("invalid vote option: %s", option)
+}
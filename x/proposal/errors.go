@@ -76,3 +76,53 @@ func ErrIllegalParameter() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeProposalQueryFailed, fmt.Sprintf("query proposal store failed"))
 }
+
+// ErrInvalidRevision - error if post revision is invalid
+func ErrInvalidRevision() sdk.Error {
+	return types.NewError(types.CodeInvalidRevision, fmt.Sprintf("invalid post revision"))
+}
+
+// ErrCensorshipRevisionNotFound - error if censorship target revision is not found
+func ErrCensorshipRevisionNotFound(permlink types.Permlink, revision int64) sdk.Error {
+	return types.NewError(types.CodeCensorshipRevisionNotFound, fmt.Sprintf("revision %v of post %v not found", revision, permlink))
+}
+
+// ErrInvalidVoteOption - error if vote option is not yes, no, abstain or no with veto
+func ErrInvalidVoteOption() sdk.Error {
+	return types.NewError(types.CodeInvalidVoteOption, fmt.Sprintf("invalid vote option"))
+}
+
+// ErrNotDepositingProposal - error if proposal is not in deposit period
+func ErrNotDepositingProposal() sdk.Error {
+	return types.NewError(types.CodeNotDepositingProposal, fmt.Sprintf("proposal is not in deposit period"))
+}
+
+// ErrInvalidSpendSchedule - error if treasury spend times or interval is invalid
+func ErrInvalidSpendSchedule() sdk.Error {
+	return types.NewError(types.CodeInvalidSpendSchedule, fmt.Sprintf("invalid treasury spend schedule"))
+}
+
+// ErrCensorshipNotPassed - error if appealed content censorship proposal didn't pass
+func ErrCensorshipNotPassed(proposalID types.ProposalKey) sdk.Error {
+	return types.NewError(types.CodeCensorshipNotPassed, fmt.Sprintf("censorship proposal %v didn't pass", proposalID))
+}
+
+// ErrAppealWindowExpired - error if content censorship is appealed after appeal window
+func ErrAppealWindowExpired(proposalID types.ProposalKey) sdk.Error {
+	return types.NewError(types.CodeAppealWindowExpired, fmt.Sprintf("appeal window of censorship proposal %v expired", proposalID))
+}
+
+// ErrAppealNotAllowed - error if content censorship is appealed by someone other than post author
+func ErrAppealNotAllowed(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeAppealNotAllowed, fmt.Sprintf("%v can't appeal the censorship", username))
+}
+
+// ErrAppealAlreadyExist - error if content censorship is already appealed
+func ErrAppealAlreadyExist(proposalID types.ProposalKey) sdk.Error {
+	return types.NewError(types.CodeAppealAlreadyExist, fmt.Sprintf("censorship proposal %v is already appealed", proposalID))
+}
+
+// ErrAccountNotFrozen - error if account to unfreeze is not frozen
+func ErrAccountNotFrozen(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeAccountNotFrozen, fmt.Sprintf("account %v is not frozen", username))
+}
@@ -33,6 +33,13 @@ func (dpe DecideProposalEvent) Execute(
 		return err
 	}
 
+	// vote on behalf of validators who registered a default vote policy
+	if vote.IsVoteRequired(dpe.ProposalType) {
+		if err := voteManager.CastDefaultVotes(ctx, dpe.ProposalID, lst.OncallValidators); err != nil {
+			return err
+		}
+	}
+
 	// get penalty list
 	penaltyList, err := voteManager.GetPenaltyList(
 		ctx, dpe.ProposalID, dpe.ProposalType, lst.OncallValidators)
@@ -41,7 +48,7 @@ func (dpe DecideProposalEvent) Execute(
 	}
 
 	// punish validators who didn't vote
-	actualPenalty, err := valManager.PunishValidatorsDidntVote(ctx, penaltyList.PenaltyList)
+	actualPenalty, err := valManager.PunishValidatorsDidntVote(ctx, dpe.ProposalID, penaltyList.PenaltyList)
 	if err != nil {
 		return err
 	}
@@ -51,14 +58,26 @@ func (dpe DecideProposalEvent) Execute(
 		return err
 	}
 
+	// recompute tallies from current voting power
+	votes, err := voteManager.GetProposalVotes(ctx, dpe.ProposalID)
+	if err != nil {
+		return err
+	}
+	if err := proposalManager.RetallyProposalVotes(ctx, dpe.ProposalID, votes); err != nil {
+		return err
+	}
+
 	// update the ongoing and past proposal list
 	proposalRes, err := proposalManager.UpdateProposalPassStatus(
 		ctx, dpe.ProposalType, dpe.ProposalID)
 	if err != nil {
 		return err
 	}
-	// majority disagree this proposal
-	if proposalRes == types.ProposalNotPass {
+	if err := dpe.SettleDeposit(ctx, proposalRes, am, proposalManager, gm); err != nil {
+		return err
+	}
+	// majority disagree this proposal or it is vetoed
+	if proposalRes != types.ProposalPass {
 		return nil
 	}
 
@@ -76,6 +95,50 @@ func (dpe DecideProposalEvent) Execute(
 		if err := dpe.ExecuteProtocolUpgrade(ctx, dpe.ProposalID, proposalManager); err != nil {
 			return err
 		}
+	case types.TreasurySpend:
+		if err := dpe.ExecuteTreasurySpend(ctx, dpe.ProposalID, am, proposalManager, gm); err != nil {
+			return err
+		}
+	case types.ContentAppeal:
+		if err := dpe.ExecuteContentAppeal(ctx, dpe.ProposalID, am, proposalManager, postManager, gm); err != nil {
+			return err
+		}
+	case types.AccountFreeze:
+		if err := dpe.ExecuteAccountFreeze(ctx, dpe.ProposalID, proposalManager); err != nil {
+			return err
+		}
+	case types.AccountUnfreeze:
+		if err := dpe.ExecuteAccountUnfreeze(ctx, dpe.ProposalID, proposalManager); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SettleDeposit - return deposit to all depositors, deposit of vetoed proposal is burned
+func (dpe DecideProposalEvent) SettleDeposit(
+	ctx sdk.Context, proposalRes types.ProposalResult, am acc.AccountManager,
+	proposalManager ProposalManager, gm *global.GlobalManager) sdk.Error {
+	deposits, err := proposalManager.GetProposalDeposits(ctx, dpe.ProposalID)
+	if err != nil {
+		return err
+	}
+	if proposalRes == types.ProposalVetoed {
+		total := types.NewCoinFromInt64(0)
+		for _, deposit := range deposits {
+			total = total.Plus(deposit.Amount)
+		}
+		if total.IsZero() {
+			return nil
+		}
+		return gm.BurnCoin(ctx, total)
+	}
+	for _, deposit := range deposits {
+		if err := am.AddSavingCoin(
+			ctx, deposit.Depositor, deposit.Amount, "",
+			string(dpe.ProposalID), types.ProposalReturnCoin); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -94,7 +157,7 @@ func (dpe DecideProposalEvent) ExecuteChangeParam(
 	return nil
 }
 
-// ExecuteContentCensorship - delete target post
+// ExecuteContentCensorship - delete target post or target revision of the post
 func (dpe DecideProposalEvent) ExecuteContentCensorship(
 	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager,
 	postManager post.PostManager) sdk.Error {
@@ -102,19 +165,138 @@ func (dpe DecideProposalEvent) ExecuteContentCensorship(
 	if err != nil {
 		return err
 	}
+	revision, err := proposalManager.GetRevision(ctx, curID)
+	if err != nil {
+		return err
+	}
 
 	// TODO add content censorship logic
 	if exist := postManager.DoesPostExist(ctx, permlink); !exist {
 		return ErrCensorshipPostNotFound()
 	}
-	if err := postManager.DeletePost(ctx, permlink); err != nil {
+	if revision != 0 {
+		if !postManager.DoesPostRevisionExist(ctx, permlink, revision) {
+			return ErrCensorshipRevisionNotFound(permlink, revision)
+		}
+		return postManager.CensorPostRevision(ctx, permlink, revision)
+	}
+	if err := postManager.CensorPost(ctx, permlink); err != nil {
 		return err
 	}
 	return nil
 }
 
+// ExecuteContentAppeal - restore the post or revision removed by the appealed censorship,
+// and pay back rewards the post lost while censored
+func (dpe DecideProposalEvent) ExecuteContentAppeal(
+	ctx sdk.Context, curID types.ProposalKey, am acc.AccountManager, proposalManager ProposalManager,
+	postManager post.PostManager, gm *global.GlobalManager) sdk.Error {
+	appeal, err := proposalManager.GetContentAppealProposal(ctx, curID)
+	if err != nil {
+		return err
+	}
+	lostRewards, err := postManager.RestoreCensoredContent(ctx, appeal.Permlink, appeal.Revision)
+	if err != nil {
+		return err
+	}
+	return post.PayLostRewards(ctx, postManager, am, gm, appeal.Permlink, lostRewards)
+}
+
+// ExecuteAccountFreeze - freeze the target account for a fixed period
+func (dpe DecideProposalEvent) ExecuteAccountFreeze(
+	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager) sdk.Error {
+	proposal, err := proposalManager.GetAccountFreezeProposal(ctx, curID)
+	if err != nil {
+		return err
+	}
+	return proposalManager.FreezeAccount(ctx, proposal.Username, curID)
+}
+
+// ExecuteAccountUnfreeze - lift the freeze of the target account,
+// the account may be already unfrozen when the proposal is decided
+func (dpe DecideProposalEvent) ExecuteAccountUnfreeze(
+	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager) sdk.Error {
+	proposal, err := proposalManager.GetAccountUnfreezeProposal(ctx, curID)
+	if err != nil {
+		return err
+	}
+	proposalManager.UnfreezeAccount(ctx, proposal.Username)
+	return nil
+}
+
 // ExecuteProtocolUpgrade - since execute protocol upgrade engage code change, the process need to be done manually
 func (dpe DecideProposalEvent) ExecuteProtocolUpgrade(
 	ctx sdk.Context, curID types.ProposalKey, proposalManager ProposalManager) sdk.Error {
 	return nil
 }
+
+// ExecuteTreasurySpend - pay recipient from treasury pool at once or by coin return events,
+// the spend is recorded as skipped if treasury pool can't cover it any more
+func (dpe DecideProposalEvent) ExecuteTreasurySpend(
+	ctx sdk.Context, curID types.ProposalKey, am acc.AccountManager,
+	proposalManager ProposalManager, gm *global.GlobalManager) sdk.Error {
+	proposal, err := proposalManager.GetTreasurySpendProposal(ctx, curID)
+	if err != nil {
+		return err
+	}
+	treasury, err := gm.GetTreasuryPool(ctx)
+	if err != nil {
+		return err
+	}
+	if !treasury.IsGTE(proposal.Amount) {
+		return proposalManager.RecordTreasurySpend(ctx, proposal, true)
+	}
+	if err := gm.WithdrawFromTreasury(ctx, proposal.Amount); err != nil {
+		return err
+	}
+
+	if proposal.Times == 1 {
+		if err := am.AddSavingCoin(
+			ctx, proposal.Recipient, proposal.Amount, "", string(curID), types.TreasuryPayout); err != nil {
+			return err
+		}
+	} else {
+		events, err := acc.CreateCoinReturnEvents(
+			ctx, proposal.Recipient, proposal.Times, proposal.IntervalSec, proposal.Amount, types.TreasuryPayout)
+		if err != nil {
+			return err
+		}
+		if err := gm.RegisterCoinReturnEvent(ctx, events, proposal.Times, proposal.IntervalSec); err != nil {
+			return err
+		}
+	}
+	return proposalManager.RecordTreasurySpend(ctx, proposal, false)
+}
+
+// ExpireProposalDepositEvent - an event at the end of deposit period,
+// expire the proposal and refund all depositors if minimum deposit is not met
+type ExpireProposalDepositEvent struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+}
+
+// Execute - execute expire proposal deposit event
+func (epde ExpireProposalDepositEvent) Execute(
+	ctx sdk.Context, am acc.AccountManager, proposalManager ProposalManager,
+	gm *global.GlobalManager) sdk.Error {
+	// minimum deposit is met and voting has started
+	if !proposalManager.IsDepositingProposal(ctx, epde.ProposalID) {
+		return nil
+	}
+
+	deposits, err := proposalManager.GetProposalDeposits(ctx, epde.ProposalID)
+	if err != nil {
+		return err
+	}
+	// refund each depositor in next block
+	for _, deposit := range deposits {
+		events, err := acc.CreateCoinReturnEvents(
+			ctx, deposit.Depositor, 1, 0, deposit.Amount, types.ProposalReturnCoin)
+		if err != nil {
+			return err
+		}
+		if err := gm.RegisterCoinReturnEvent(ctx, events, 1, 0); err != nil {
+			return err
+		}
+	}
+	return proposalManager.ExpireDepositingProposal(ctx, epde.ProposalID)
+}
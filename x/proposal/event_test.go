@@ -5,8 +5,12 @@ import (
 
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
+	acc "github.com/lino-network/lino/x/account"
 	"github.com/lino-network/lino/x/proposal/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	valmodel "github.com/lino-network/lino/x/validator/model"
 )
 
 func TestDecideProposal(t *testing.T) {
@@ -41,8 +45,8 @@ func TestDecideProposal(t *testing.T) {
 
 	p1 := pm.CreateChangeParamProposal(ctx, param1, "")
 	p2 := pm.CreateChangeParamProposal(ctx, param2, "")
-	id1, _ := pm.AddProposal(ctx, types.AccountKey("c1"), p1, 10)
-	id2, _ := pm.AddProposal(ctx, types.AccountKey("c2"), p2, 10)
+	id1, _ := pm.AddProposal(ctx, types.AccountKey("c1"), p1, 10, types.NewCoinFromInt64(0))
+	id2, _ := pm.AddProposal(ctx, types.AccountKey("c2"), p2, 10, types.NewCoinFromInt64(0))
 
 	e1 := DecideProposalEvent{
 		ProposalType: types.ChangeParam,
@@ -60,7 +64,7 @@ func TestDecideProposal(t *testing.T) {
 		decideProposal        bool
 		voter                 types.AccountKey
 		proposalID            types.ProposalKey
-		voterRes              bool
+		voterOption           types.VoteOption
 		votingPower           types.Coin
 		expectOngoingProposal []types.ProposalKey
 		expectDecidedProposal []types.ProposalKey
@@ -74,7 +78,7 @@ func TestDecideProposal(t *testing.T) {
 			decideProposal:        false,
 			voter:                 user1,
 			proposalID:            id1,
-			voterRes:              true,
+			voterOption:           types.VoteOptionYes,
 			votingPower:           c1,
 			expectOngoingProposal: []types.ProposalKey{id1, id2},
 			expectDecidedProposal: nil,
@@ -88,7 +92,7 @@ func TestDecideProposal(t *testing.T) {
 			decideProposal:        false,
 			voter:                 user2,
 			proposalID:            id1,
-			voterRes:              false,
+			voterOption:           types.VoteOptionNo,
 			votingPower:           c2,
 			expectOngoingProposal: []types.ProposalKey{id1, id2},
 			expectDecidedProposal: nil,
@@ -102,7 +106,7 @@ func TestDecideProposal(t *testing.T) {
 			decideProposal:        true,
 			voter:                 types.AccountKey(""),
 			proposalID:            id1,
-			voterRes:              false,
+			voterOption:           types.VoteOptionNo,
 			expectOngoingProposal: []types.ProposalKey{id2},
 			expectDecidedProposal: []types.ProposalKey{id1},
 			expectProposalRes:     types.ProposalNotPass,
@@ -115,7 +119,7 @@ func TestDecideProposal(t *testing.T) {
 			decideProposal:        false,
 			voter:                 user1,
 			proposalID:            id2,
-			voterRes:              true,
+			voterOption:           types.VoteOptionYes,
 			votingPower:           c1,
 			expectOngoingProposal: []types.ProposalKey{id2},
 			expectDecidedProposal: []types.ProposalKey{id1},
@@ -129,7 +133,7 @@ func TestDecideProposal(t *testing.T) {
 			decideProposal:        false,
 			voter:                 user2,
 			proposalID:            id2,
-			voterRes:              true,
+			voterOption:           types.VoteOptionYes,
 			votingPower:           c2,
 			expectOngoingProposal: []types.ProposalKey{id2},
 			expectDecidedProposal: []types.ProposalKey{id1},
@@ -143,7 +147,7 @@ func TestDecideProposal(t *testing.T) {
 			decideProposal:        false,
 			voter:                 user4,
 			proposalID:            id2,
-			voterRes:              true,
+			voterOption:           types.VoteOptionYes,
 			votingPower:           c4,
 			expectOngoingProposal: []types.ProposalKey{id2},
 			expectDecidedProposal: []types.ProposalKey{id1},
@@ -157,7 +161,7 @@ func TestDecideProposal(t *testing.T) {
 			decideProposal:        false,
 			voter:                 user3,
 			proposalID:            id2,
-			voterRes:              false,
+			voterOption:           types.VoteOptionNo,
 			votingPower:           c3,
 			expectOngoingProposal: []types.ProposalKey{id2},
 			expectDecidedProposal: []types.ProposalKey{id1},
@@ -171,7 +175,7 @@ func TestDecideProposal(t *testing.T) {
 			decideProposal:        true,
 			voter:                 types.AccountKey(""),
 			proposalID:            id2,
-			voterRes:              false,
+			voterOption:           types.VoteOptionNo,
 			expectOngoingProposal: nil,
 			expectDecidedProposal: []types.ProposalKey{id1, id2},
 			expectProposalRes:     types.ProposalPass,
@@ -192,9 +196,10 @@ func TestDecideProposal(t *testing.T) {
 			assert.Equal(t, cs.expectDisagreeVotes, proposalInfo.DisagreeVotes)
 
 		} else {
-			voteManager.AddVote(ctx, cs.proposalID, cs.voter, cs.voterRes)
+			voteManager.AddVote(ctx, cs.proposalID, cs.voter, cs.voterOption)
 
-			err := pm.UpdateProposalVotingStatus(ctx, cs.proposalID, cs.voter, cs.voterRes, cs.votingPower)
+			err := pm.UpdateProposalVotingStatus(
+				ctx, cs.proposalID, cs.voter, cs.voterOption, cs.votingPower, nil)
 			assert.Nil(t, err)
 		}
 
@@ -217,3 +222,419 @@ func TestDecideProposal(t *testing.T) {
 		assert.Equal(t, expectExpiredProposalList, expiredList)
 	}
 }
+
+func TestDecideProposalSettleDeposit(t *testing.T) {
+	ctx, am, pm, postManager, voteManager, valManager, gm := setupTest(t, 0)
+	voteManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+	pm.InitGenesis(ctx)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+
+	c1, c2 :=
+		proposalParam.ChangeParamPassVotes.Plus(types.NewCoinFromInt64(20)),
+		proposalParam.ChangeParamPassVotes.Plus(types.NewCoinFromInt64(30))
+	deposit := proposalParam.ChangeParamMinDeposit
+
+	creator := createTestAccount(ctx, am, "creator", types.NewCoinFromInt64(0))
+	user1 := createTestAccount(ctx, am, "user1", c1)
+	user2 := createTestAccount(ctx, am, "user2", c2)
+	voteManager.AddVoter(ctx, user1, c1)
+	voteManager.AddVoter(ctx, user2, c2)
+
+	p1 := pm.CreateChangeParamProposal(ctx, param.GlobalAllocationParam{}, "")
+	p2 := pm.CreateChangeParamProposal(ctx, param.GlobalAllocationParam{}, "")
+	id1, _ := pm.AddProposal(ctx, creator, p1, 10, deposit)
+	id2, _ := pm.AddProposal(ctx, creator, p2, 10, deposit)
+
+	cases := []struct {
+		testName          string
+		proposalID        types.ProposalKey
+		voter             types.AccountKey
+		voterOption       types.VoteOption
+		votingPower       types.Coin
+		expectProposalRes types.ProposalResult
+		expectSaving      types.Coin
+	}{
+		{
+			testName:          "vetoed proposal burns deposit",
+			proposalID:        id1,
+			voter:             user2,
+			voterOption:       types.VoteOptionNoWithVeto,
+			votingPower:       c2,
+			expectProposalRes: types.ProposalVetoed,
+			expectSaving:      types.NewCoinFromInt64(0),
+		},
+		{
+			testName:          "rejected proposal returns deposit",
+			proposalID:        id2,
+			voter:             user1,
+			voterOption:       types.VoteOptionNo,
+			votingPower:       c1,
+			expectProposalRes: types.ProposalNotPass,
+			expectSaving:      deposit,
+		},
+	}
+
+	for _, cs := range cases {
+		_, err := voteManager.AddVote(ctx, cs.proposalID, cs.voter, cs.voterOption)
+		assert.Nil(t, err)
+		err = pm.UpdateProposalVotingStatus(
+			ctx, cs.proposalID, cs.voter, cs.voterOption, cs.votingPower, nil)
+		assert.Nil(t, err)
+
+		event := DecideProposalEvent{ProposalType: types.ChangeParam, ProposalID: cs.proposalID}
+		err = event.Execute(ctx, voteManager, valManager, am, pm, postManager, &gm)
+		assert.Nil(t, err)
+
+		proposal, _ := pm.storage.GetExpiredProposal(ctx, cs.proposalID)
+		assert.Equal(t, cs.expectProposalRes, proposal.GetProposalInfo().Result, cs.testName)
+		saving, _ := am.GetSavingFromBank(ctx, creator)
+		assert.Equal(t, cs.expectSaving, saving, cs.testName)
+	}
+}
+
+func TestDecideProposalWithLiveVotingPower(t *testing.T) {
+	ctx, am, pm, postManager, voteManager, valManager, gm := setupTest(t, 0)
+	voteManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+	pm.InitGenesis(ctx)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+
+	c1, c2 :=
+		proposalParam.ChangeParamPassVotes.Plus(proposalParam.ChangeParamPassVotes),
+		proposalParam.ChangeParamPassVotes.Plus(types.NewCoinFromInt64(10))
+
+	user1 := createTestAccount(ctx, am, "user1", c1)
+	user2 := createTestAccount(ctx, am, "user2", c2)
+	voteManager.AddVoter(ctx, user1, c1)
+	voteManager.AddVoter(ctx, user2, c2)
+
+	p1 := pm.CreateChangeParamProposal(ctx, param.GlobalAllocationParam{}, "")
+	id1, _ := pm.AddProposal(ctx, types.AccountKey("c1"), p1, 10, types.NewCoinFromInt64(0))
+
+	_, err := voteManager.AddVote(ctx, id1, user1, types.VoteOptionYes)
+	assert.Nil(t, err)
+	err = pm.UpdateProposalVotingStatus(ctx, id1, user1, types.VoteOptionYes, c1, nil)
+	assert.Nil(t, err)
+	_, err = voteManager.AddVote(ctx, id1, user2, types.VoteOptionNo)
+	assert.Nil(t, err)
+	err = pm.UpdateProposalVotingStatus(ctx, id1, user2, types.VoteOptionNo, c2, nil)
+	assert.Nil(t, err)
+
+	// user1 withdraws most stake after voting
+	err = voteManager.MinusLinoStake(ctx, user1, c1.Minus(types.NewCoinFromInt64(10)))
+	assert.Nil(t, err)
+
+	event := DecideProposalEvent{ProposalType: types.ChangeParam, ProposalID: id1}
+	err = event.Execute(ctx, voteManager, valManager, am, pm, postManager, &gm)
+	assert.Nil(t, err)
+
+	proposal, _ := pm.storage.GetExpiredProposal(ctx, id1)
+	proposalInfo := proposal.GetProposalInfo()
+	assert.Equal(t, types.ProposalNotPass, proposalInfo.Result)
+	assert.Equal(t, types.NewCoinFromInt64(10), proposalInfo.AgreeVotes)
+	assert.Equal(t, c2, proposalInfo.DisagreeVotes)
+}
+
+func TestDecideProposalWithDefaultVote(t *testing.T) {
+	ctx, am, pm, postManager, voteManager, valManager, gm := setupTest(t, 0)
+	voteManager.InitGenesis(ctx)
+	valManager.InitGenesis(ctx)
+	pm.InitGenesis(ctx)
+	valParam, _ := pm.paramHolder.GetValidatorParam(ctx)
+	deposit := valParam.ValidatorMinCommittingDeposit.Plus(valParam.PenaltyMissVote)
+
+	user1 := createTestAccount(ctx, am, "user1", c4600)
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+	for _, user := range []types.AccountKey{user1, user2} {
+		voteManager.AddVoter(ctx, user, valParam.ValidatorMinVotingDeposit)
+		err := valManager.RegisterValidator(ctx, user, secp256k1.GenPrivKey().PubKey(), deposit, "")
+		assert.Nil(t, err)
+		err = valManager.TryBecomeOncallValidator(ctx, user)
+		assert.Nil(t, err)
+	}
+	err := voteManager.SetDefaultVoteOption(ctx, user1, types.VoteOptionYes)
+	assert.Nil(t, err)
+
+	p1 := pm.CreateChangeParamProposal(ctx, param.GlobalAllocationParam{}, "")
+	id1, _ := pm.AddProposal(ctx, types.AccountKey("c1"), p1, 10, types.NewCoinFromInt64(0))
+
+	event := DecideProposalEvent{ProposalType: types.ChangeParam, ProposalID: id1}
+	err = event.Execute(ctx, voteManager, valManager, am, pm, postManager, &gm)
+	assert.Nil(t, err)
+
+	// default vote is cast for user1, user2 is punished for missing the vote
+	vote, err := voteManager.GetVote(ctx, id1, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, types.VoteOptionYes, vote.Option)
+	assert.False(t, voteManager.DoesVoteExist(ctx, id1, user2))
+
+	missedVotes, err := valManager.GetMissedVotes(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(missedVotes))
+	missedVotes, err = valManager.GetMissedVotes(ctx, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, []valmodel.MissedVote{
+		{
+			ProposalID: id1,
+			Penalty:    valParam.PenaltyMissVote,
+			PunishedAt: ctx.BlockHeader().Time.Unix(),
+		},
+	}, missedVotes)
+}
+
+func TestExecuteTreasurySpend(t *testing.T) {
+	ctx, am, pm, _, _, _, gm := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+	curTime := ctx.BlockHeader().Time.Unix()
+
+	user1 := createTestAccount(ctx, am, "user1", types.NewCoinFromInt64(0))
+	user2 := createTestAccount(ctx, am, "user2", types.NewCoinFromInt64(0))
+	assert.Nil(t, gm.AddToTreasuryPool(ctx, types.NewCoinFromInt64(1000)))
+
+	c100, c300 := types.NewCoinFromInt64(100), types.NewCoinFromInt64(300)
+	p1 := pm.CreateTreasurySpendProposal(ctx, user1, c100, 1, 0, "")
+	p2 := pm.CreateTreasurySpendProposal(ctx, user2, c300, 3, 3600, "")
+	p3 := pm.CreateTreasurySpendProposal(ctx, user1, types.NewCoinFromInt64(1000), 1, 0, "")
+	ids := []types.ProposalKey{}
+	for _, p := range []model.Proposal{p1, p2, p3} {
+		id, err := pm.AddProposal(ctx, user1, p, 10, types.NewCoinFromInt64(0))
+		assert.Nil(t, err)
+		_, err = pm.UpdateProposalPassStatus(ctx, types.TreasurySpend, id)
+		assert.Nil(t, err)
+		ids = append(ids, id)
+	}
+	spend1 := model.TreasurySpend{
+		ProposalID: ids[0], Recipient: user1, Amount: c100, Times: 1, SpentAt: curTime}
+	spend2 := model.TreasurySpend{
+		ProposalID: ids[1], Recipient: user2, Amount: c300, Times: 3, IntervalSec: 3600, SpentAt: curTime}
+	spend3 := model.TreasurySpend{
+		ProposalID: ids[2], Recipient: user1, Amount: types.NewCoinFromInt64(1000), Times: 1,
+		SpentAt: curTime, Skipped: true}
+
+	cases := []struct {
+		testName       string
+		proposalID     types.ProposalKey
+		expectTreasury types.Coin
+		expectSaving1  types.Coin
+		expectSpends   []model.TreasurySpend
+	}{
+		{
+			testName:       "fixed amount is paid at once",
+			proposalID:     ids[0],
+			expectTreasury: types.NewCoinFromInt64(900),
+			expectSaving1:  c100,
+			expectSpends:   []model.TreasurySpend{spend1},
+		},
+		{
+			testName:       "vesting spend registers coin return events",
+			proposalID:     ids[1],
+			expectTreasury: types.NewCoinFromInt64(600),
+			expectSaving1:  c100,
+			expectSpends:   []model.TreasurySpend{spend1, spend2},
+		},
+		{
+			testName:       "spend exceeds treasury is recorded as skipped",
+			proposalID:     ids[2],
+			expectTreasury: types.NewCoinFromInt64(600),
+			expectSaving1:  c100,
+			expectSpends:   []model.TreasurySpend{spend1, spend2, spend3},
+		},
+	}
+
+	for _, cs := range cases {
+		event := DecideProposalEvent{ProposalType: types.TreasurySpend, ProposalID: cs.proposalID}
+		err := event.ExecuteTreasurySpend(ctx, cs.proposalID, am, pm, &gm)
+		assert.Nil(t, err, cs.testName)
+		assert.Nil(t, gm.CommitEventCache(ctx))
+
+		treasury, err := gm.GetTreasuryPool(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, cs.expectTreasury, treasury, cs.testName)
+		saving, err := am.GetSavingFromBank(ctx, user1)
+		assert.Nil(t, err)
+		assert.Equal(t, cs.expectSaving1, saving, cs.testName)
+		spends, err := pm.GetTreasurySpends(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, cs.expectSpends, spends, cs.testName)
+	}
+
+	saving, err := am.GetSavingFromBank(ctx, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, types.NewCoinFromInt64(0), saving)
+	for i := int64(1); i <= 3; i++ {
+		eventList := gm.GetTimeEventListAtTime(ctx, curTime+3600*i)
+		assert.Equal(t, []types.Event{
+			acc.ReturnCoinEvent{Username: user2, Amount: c100, ReturnType: types.TreasuryPayout},
+		}, eventList.Events)
+	}
+}
+
+func TestExecuteContentAppeal(t *testing.T) {
+	ctx, am, pm, postManager, _, _, gm := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+
+	user1, postID1 := createTestPost(t, ctx, "user1", "postID", c4600, am, postManager, "0")
+	permlink := types.GetPermlink(user1, postID1)
+
+	censorship := pm.CreateContentCensorshipProposal(ctx, permlink, 0, "")
+	censorshipID, err := pm.AddProposal(ctx, user1, censorship, 10, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	_, err = pm.UpdateProposalPassStatus(ctx, types.ContentCensorship, censorshipID)
+	assert.Nil(t, err)
+	event := DecideProposalEvent{ProposalType: types.ContentCensorship, ProposalID: censorshipID}
+	err = event.ExecuteContentCensorship(ctx, censorshipID, pm, postManager)
+	assert.Nil(t, err)
+	isDeleted, err := postManager.IsDeleted(ctx, permlink)
+	assert.Nil(t, err)
+	assert.True(t, isDeleted)
+	censored, err := postManager.GetCensoredContent(ctx, permlink, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, user1, censored.Author)
+
+	appeal := pm.CreateContentAppealProposal(ctx, censorshipID, permlink, 0, "")
+	appealID, err := pm.AddProposal(ctx, user1, appeal, 10, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	_, err = pm.UpdateProposalPassStatus(ctx, types.ContentAppeal, appealID)
+	assert.Nil(t, err)
+	event = DecideProposalEvent{ProposalType: types.ContentAppeal, ProposalID: appealID}
+	err = event.ExecuteContentAppeal(ctx, appealID, am, pm, postManager, &gm)
+	assert.Nil(t, err)
+	isDeleted, err = postManager.IsDeleted(ctx, permlink)
+	assert.Nil(t, err)
+	assert.False(t, isDeleted)
+	_, err = postManager.GetCensoredContent(ctx, permlink, 0)
+	assert.NotNil(t, err)
+}
+
+func TestExecuteAccountFreeze(t *testing.T) {
+	ctx, am, pm, _, _, _, _ := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+	curTime := ctx.BlockHeader().Time.Unix()
+
+	user1 := createTestAccount(ctx, am, "user1", c4600)
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+
+	freeze := pm.CreateAccountFreezeProposal(ctx, user2, "stolen key")
+	freezeID, err := pm.AddProposal(ctx, user1, freeze, 10, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	_, err = pm.UpdateProposalPassStatus(ctx, types.AccountFreeze, freezeID)
+	assert.Nil(t, err)
+	event := DecideProposalEvent{ProposalType: types.AccountFreeze, ProposalID: freezeID}
+	err = event.ExecuteAccountFreeze(ctx, freezeID, pm)
+	assert.Nil(t, err)
+	frozen, err := pm.IsAccountFrozen(ctx, user2)
+	assert.Nil(t, err)
+	assert.True(t, frozen)
+	accountFreeze, err := pm.GetAccountFreeze(ctx, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, model.AccountFreeze{
+		Username:    user2,
+		ProposalID:  freezeID,
+		FrozenAt:    curTime,
+		FrozenUntil: curTime + proposalParam.AccountFreezeSec,
+	}, *accountFreeze)
+	freezes, err := pm.GetAccountFreezes(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.AccountFreeze{*accountFreeze}, freezes)
+
+	unfreeze := pm.CreateAccountUnfreezeProposal(ctx, user2, "key recovered")
+	unfreezeID, err := pm.AddProposal(ctx, user1, unfreeze, 10, types.NewCoinFromInt64(0))
+	assert.Nil(t, err)
+	_, err = pm.UpdateProposalPassStatus(ctx, types.AccountUnfreeze, unfreezeID)
+	assert.Nil(t, err)
+	event = DecideProposalEvent{ProposalType: types.AccountUnfreeze, ProposalID: unfreezeID}
+	err = event.ExecuteAccountUnfreeze(ctx, unfreezeID, pm)
+	assert.Nil(t, err)
+	frozen, err = pm.IsAccountFrozen(ctx, user2)
+	assert.Nil(t, err)
+	assert.False(t, frozen)
+	freezes, err = pm.GetAccountFreezes(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(freezes))
+
+	// unfreezing an account which is not frozen does nothing
+	err = event.ExecuteAccountUnfreeze(ctx, unfreezeID, pm)
+	assert.Nil(t, err)
+
+	// freeze ends after freeze period
+	err = pm.storage.SetAccountFreeze(ctx, &model.AccountFreeze{
+		Username: user2, ProposalID: freezeID, FrozenAt: curTime - 10, FrozenUntil: curTime})
+	assert.Nil(t, err)
+	frozen, err = pm.IsAccountFrozen(ctx, user2)
+	assert.Nil(t, err)
+	assert.False(t, frozen)
+}
+
+func TestExpireProposalDepositEvent(t *testing.T) {
+	ctx, am, pm, _, _, _, gm := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+
+	c100, c200 := types.NewCoinFromInt64(100), types.NewCoinFromInt64(200)
+	user1 := createTestAccount(ctx, am, "user1", types.NewCoinFromInt64(0))
+	user2 := createTestAccount(ctx, am, "user2", types.NewCoinFromInt64(0))
+
+	p1 := pm.CreateChangeParamProposal(ctx, param.GlobalAllocationParam{}, "")
+	p2 := pm.CreateChangeParamProposal(ctx, param.GlobalAllocationParam{}, "")
+	id1, _ := pm.AddDepositingProposal(ctx, user1, p1)
+	id2, _ := pm.AddDepositingProposal(ctx, user1, p2)
+	pm.AddProposalDeposit(ctx, id1, user1, c100)
+	pm.AddProposalDeposit(ctx, id1, user2, c200)
+	isMet, err := pm.AddProposalDeposit(ctx, id2, user1, proposalParam.ChangeParamMinDeposit)
+	assert.Nil(t, err)
+	assert.True(t, isMet)
+	_, _, err = pm.StartProposalVoting(ctx, id2)
+	assert.Nil(t, err)
+
+	cases := []struct {
+		testName      string
+		proposalID    types.ProposalKey
+		expectExpired bool
+		expectRefunds []types.Event
+		expectOngoing bool
+	}{
+		{
+			testName:      "proposal in voting period doesn't expire",
+			proposalID:    id2,
+			expectExpired: false,
+			expectRefunds: nil,
+			expectOngoing: true,
+		},
+		{
+			testName:      "under-funded proposal expires and refunds all depositors",
+			proposalID:    id1,
+			expectExpired: true,
+			expectRefunds: []types.Event{
+				acc.ReturnCoinEvent{Username: user1, Amount: c100, ReturnType: types.ProposalReturnCoin},
+				acc.ReturnCoinEvent{Username: user2, Amount: c200, ReturnType: types.ProposalReturnCoin},
+			},
+			expectOngoing: false,
+		},
+	}
+
+	for _, cs := range cases {
+		event := ExpireProposalDepositEvent{ProposalID: cs.proposalID}
+		err := event.Execute(ctx, am, pm, &gm)
+		assert.Nil(t, err, cs.testName)
+		assert.Nil(t, gm.CommitEventCache(ctx))
+
+		assert.False(t, pm.IsDepositingProposal(ctx, cs.proposalID), cs.testName)
+		assert.Equal(t, cs.expectOngoing, pm.IsOngoingProposal(ctx, cs.proposalID), cs.testName)
+		proposal, err := pm.storage.GetExpiredProposal(ctx, cs.proposalID)
+		if cs.expectExpired {
+			assert.Nil(t, err, cs.testName)
+			assert.Equal(t, types.ProposalDepositNotMet, proposal.GetProposalInfo().Result, cs.testName)
+		} else {
+			assert.NotNil(t, err, cs.testName)
+		}
+
+		eventList := gm.GetTimeEventListAtTime(ctx, ctx.BlockHeader().Time.Unix())
+		if cs.expectRefunds == nil {
+			assert.Nil(t, eventList, cs.testName)
+		} else {
+			assert.Equal(t, cs.expectRefunds, eventList.Events, cs.testName)
+		}
+	}
+}
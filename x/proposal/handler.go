@@ -7,6 +7,7 @@ import (
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/global"
 	"github.com/lino-network/lino/x/post"
+	"github.com/lino-network/lino/x/proposal/model"
 	"github.com/lino-network/lino/x/vote"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -25,8 +26,18 @@ func NewHandler(
 			return handleContentCensorshipMsg(ctx, am, proposalManager, postManager, gm, msg)
 		case ProtocolUpgradeMsg:
 			return handleProtocolUpgradeMsg(ctx, am, proposalManager, gm, msg)
+		case TreasurySpendMsg:
+			return handleTreasurySpendMsg(ctx, am, proposalManager, gm, msg)
+		case ContentAppealMsg:
+			return handleContentAppealMsg(ctx, am, proposalManager, postManager, gm, msg)
+		case AccountFreezeMsg:
+			return handleAccountFreezeMsg(ctx, am, proposalManager, gm, msg)
+		case AccountUnfreezeMsg:
+			return handleAccountUnfreezeMsg(ctx, am, proposalManager, gm, msg)
 		case VoteProposalMsg:
 			return handleVoteProposalMsg(ctx, proposalManager, vm, msg)
+		case DepositProposalMsg:
+			return handleDepositProposalMsg(ctx, am, proposalManager, gm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized proposal Msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -41,122 +52,257 @@ func handleChangeParamMsg(
 		return ErrAccountNotFound().Result()
 	}
 
-	param, err := pm.paramHolder.GetProposalParam(ctx)
-	if err != nil {
+	proposal := pm.CreateChangeParamProposal(ctx, msg.GetParameter(), msg.GetReason())
+	if _, err := addDepositingProposal(ctx, am, pm, gm, msg.GetCreator(), proposal); err != nil {
 		return err.Result()
 	}
+	return sdk.Result{}
+}
 
-	proposal := pm.CreateChangeParamProposal(ctx, msg.GetParameter(), msg.GetReason())
-	proposalID, err := pm.AddProposal(ctx, msg.GetCreator(), proposal, param.ChangeParamDecideSec)
-	if err != nil {
-		return err.Result()
+func handleProtocolUpgradeMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	msg ProtocolUpgradeMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.GetCreator()) {
+		return ErrAccountNotFound().Result()
 	}
-	//  set a time event to decide the proposal
-	event := pm.CreateDecideProposalEvent(ctx, types.ChangeParam, proposalID)
 
-	if err := gm.RegisterProposalDecideEvent(ctx, param.ChangeParamDecideSec, event); err != nil {
+	proposal := pm.CreateProtocolUpgradeProposal(ctx, msg.GetLink(), msg.GetReason())
+	if _, err := addDepositingProposal(ctx, am, pm, gm, msg.GetCreator(), proposal); err != nil {
 		return err.Result()
 	}
+	return sdk.Result{}
+}
+
+func handleTreasurySpendMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	msg TreasurySpendMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Creator) || !am.DoesAccountExist(ctx, msg.Recipient) {
+		return ErrAccountNotFound().Result()
+	}
 
-	// minus coin from account and return when deciding the proposal
-	if err = am.MinusSavingCoin(
-		ctx, msg.GetCreator(), param.ChangeParamMinDeposit, "",
-		string(proposalID), types.ProposalDeposit); err != nil {
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
 		return err.Result()
 	}
+	treasury, err := gm.GetTreasuryPool(ctx)
+	if err != nil {
+		return err.Result()
+	}
+	if !treasury.IsGTE(coin) {
+		return global.ErrTreasuryNotEnough().Result()
+	}
 
-	if err := returnCoinTo(
-		ctx, msg.GetCreator(), gm, am, int64(1),
-		param.ChangeParamDecideSec, param.ChangeParamMinDeposit); err != nil {
+	proposal := pm.CreateTreasurySpendProposal(
+		ctx, msg.Recipient, coin, msg.Times, msg.IntervalSec, msg.Reason)
+	if _, err := addDepositingProposal(ctx, am, pm, gm, msg.Creator, proposal); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
 }
 
-func handleProtocolUpgradeMsg(
-	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
-	msg ProtocolUpgradeMsg) sdk.Result {
+func handleContentCensorshipMsg(
+	ctx sdk.Context, am acc.AccountManager, proposalManager ProposalManager,
+	postManager post.PostManager, gm *global.GlobalManager, msg ContentCensorshipMsg) sdk.Result {
 	if !am.DoesAccountExist(ctx, msg.GetCreator()) {
 		return ErrAccountNotFound().Result()
 	}
 
+	if !postManager.DoesPostExist(ctx, msg.GetPermlink()) {
+		return ErrPostNotFound().Result()
+	}
+
+	if isDeleted, err := postManager.IsDeleted(ctx, msg.GetPermlink()); isDeleted || err != nil {
+		return ErrCensorshipPostIsDeleted(msg.GetPermlink()).Result()
+	}
+
+	if msg.GetRevision() != 0 &&
+		!postManager.DoesPostRevisionExist(ctx, msg.GetPermlink(), msg.GetRevision()) {
+		return ErrCensorshipRevisionNotFound(msg.GetPermlink(), msg.GetRevision()).Result()
+	}
+
+	proposal :=
+		proposalManager.CreateContentCensorshipProposal(
+			ctx, msg.GetPermlink(), msg.GetRevision(), msg.GetReason())
+	if _, err := addDepositingProposal(ctx, am, proposalManager, gm, msg.GetCreator(), proposal); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleContentAppealMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager,
+	postManager post.PostManager, gm *global.GlobalManager, msg ContentAppealMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Author) {
+		return ErrAccountNotFound().Result()
+	}
+
+	censorship, err := pm.GetContentCensorshipProposal(ctx, msg.ProposalID)
+	if err != nil {
+		return err.Result()
+	}
+	if censorship.Result != types.ProposalPass {
+		return ErrCensorshipNotPassed(msg.ProposalID).Result()
+	}
 	param, err := pm.paramHolder.GetProposalParam(ctx)
 	if err != nil {
 		return err.Result()
 	}
+	if ctx.BlockHeader().Time.Unix() > censorship.ExpiredAt+param.ContentAppealWindowSec {
+		return ErrAppealWindowExpired(msg.ProposalID).Result()
+	}
 
-	proposal := pm.CreateProtocolUpgradeProposal(ctx, msg.GetLink(), msg.GetReason())
-	proposalID, err := pm.AddProposal(ctx, msg.GetCreator(), proposal, param.ProtocolUpgradeDecideSec)
+	// only author of censored post can appeal
+	censored, err := postManager.GetCensoredContent(ctx, censorship.Permlink, censorship.Revision)
 	if err != nil {
 		return err.Result()
 	}
-	//  set a time event to decide the proposal
-	event := pm.CreateDecideProposalEvent(ctx, types.ProtocolUpgrade, proposalID)
-
-	if err := gm.RegisterProposalDecideEvent(ctx, param.ProtocolUpgradeDecideSec, event); err != nil {
+	if censored.Author != msg.Author {
+		return ErrAppealNotAllowed(msg.Author).Result()
+	}
+	appealed, err := pm.IsContentAppealed(ctx, msg.ProposalID)
+	if err != nil {
 		return err.Result()
 	}
+	if appealed {
+		return ErrAppealAlreadyExist(msg.ProposalID).Result()
+	}
 
-	// minus coin from account and return when deciding the proposal
-	if err = am.MinusSavingCoin(
-		ctx, msg.GetCreator(), param.ProtocolUpgradeMinDeposit,
-		"", string(proposalID), types.ProposalDeposit); err != nil {
+	proposal := pm.CreateContentAppealProposal(
+		ctx, msg.ProposalID, censorship.Permlink, censorship.Revision, msg.Reason)
+	appealID, err := addDepositingProposal(ctx, am, pm, gm, msg.Author, proposal)
+	if err != nil {
+		return err.Result()
+	}
+	if err := pm.SetContentAppeal(ctx, msg.ProposalID, appealID); err != nil {
 		return err.Result()
 	}
+	return sdk.Result{}
+}
 
-	if err := returnCoinTo(
-		ctx, msg.GetCreator(), gm, am, int64(1),
-		param.ProtocolUpgradeDecideSec, param.ProtocolUpgradeMinDeposit); err != nil {
+func handleAccountFreezeMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	msg AccountFreezeMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Creator) || !am.DoesAccountExist(ctx, msg.Username) {
+		return ErrAccountNotFound().Result()
+	}
+
+	proposal := pm.CreateAccountFreezeProposal(ctx, msg.Username, msg.Reason)
+	if _, err := addDepositingProposal(ctx, am, pm, gm, msg.Creator, proposal); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
 }
 
-func handleContentCensorshipMsg(
-	ctx sdk.Context, am acc.AccountManager, proposalManager ProposalManager,
-	postManager post.PostManager, gm *global.GlobalManager, msg ContentCensorshipMsg) sdk.Result {
-	if !am.DoesAccountExist(ctx, msg.GetCreator()) {
+func handleAccountUnfreezeMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	msg AccountUnfreezeMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Creator) || !am.DoesAccountExist(ctx, msg.Username) {
 		return ErrAccountNotFound().Result()
 	}
+	frozen, err := pm.IsAccountFrozen(ctx, msg.Username)
+	if err != nil {
+		return err.Result()
+	}
+	if !frozen {
+		return ErrAccountNotFrozen(msg.Username).Result()
+	}
 
-	if !postManager.DoesPostExist(ctx, msg.GetPermlink()) {
-		return ErrPostNotFound().Result()
+	proposal := pm.CreateAccountUnfreezeProposal(ctx, msg.Username, msg.Reason)
+	if _, err := addDepositingProposal(ctx, am, pm, gm, msg.Creator, proposal); err != nil {
+		return err.Result()
 	}
+	return sdk.Result{}
+}
 
-	if isDeleted, err := postManager.IsDeleted(ctx, msg.GetPermlink()); isDeleted || err != nil {
-		return ErrCensorshipPostIsDeleted(msg.GetPermlink()).Result()
+// addDepositingProposal - take minimum initial deposit from creator, add proposal in deposit period
+// and set a time event to expire its deposit period
+func addDepositingProposal(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	creator types.AccountKey, proposal model.Proposal) (types.ProposalKey, sdk.Error) {
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return "", err
+	}
+	initialDeposit := param.ProposalMinInitialDeposit
+	if initialDeposit.IsPositive() {
+		nextID, err := pm.GetNextProposalID(ctx)
+		if err != nil {
+			return "", err
+		}
+		// minus coin from creator and return when deciding the proposal
+		if err := am.MinusSavingCoin(
+			ctx, creator, initialDeposit, "", string(nextID), types.ProposalDeposit); err != nil {
+			return "", err
+		}
 	}
 
-	param, err := proposalManager.paramHolder.GetProposalParam(ctx)
+	proposalID, err := pm.AddDepositingProposal(ctx, creator, proposal)
 	if err != nil {
-		return err.Result()
+		return "", err
+	}
+	if initialDeposit.IsPositive() {
+		isMet, err := pm.AddProposalDeposit(ctx, proposalID, creator, initialDeposit)
+		if err != nil {
+			return "", err
+		}
+		if isMet {
+			if err := startProposalVoting(ctx, pm, gm, proposalID); err != nil {
+				return "", err
+			}
+			return proposalID, nil
+		}
 	}
 
-	proposal :=
-		proposalManager.CreateContentCensorshipProposal(
-			ctx, msg.GetPermlink(), msg.GetReason())
-	proposalID, err :=
-		proposalManager.AddProposal(
-			ctx, msg.GetCreator(), proposal, param.ContentCensorshipDecideSec)
+	event := pm.CreateExpireProposalDepositEvent(ctx, proposalID)
+	if err := gm.RegisterProposalDecideEvent(ctx, param.ProposalDepositSec, event); err != nil {
+		return "", err
+	}
+	return proposalID, nil
+}
+
+// startProposalVoting - start voting of a proposal whose minimum deposit is met
+// and set a time event to decide the proposal
+func startProposalVoting(
+	ctx sdk.Context, pm ProposalManager, gm *global.GlobalManager, proposalID types.ProposalKey) sdk.Error {
+	proposalType, decideSec, err := pm.StartProposalVoting(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	event := pm.CreateDecideProposalEvent(ctx, proposalType, proposalID)
+	return gm.RegisterProposalDecideEvent(ctx, decideSec, event)
+}
+
+func handleDepositProposalMsg(
+	ctx sdk.Context, am acc.AccountManager, pm ProposalManager, gm *global.GlobalManager,
+	msg DepositProposalMsg) sdk.Result {
+	if !am.DoesAccountExist(ctx, msg.Depositor) {
+		return ErrAccountNotFound().Result()
+	}
+
+	if !pm.IsDepositingProposal(ctx, msg.ProposalID) {
+		return ErrNotDepositingProposal().Result()
+	}
+
+	coin, err := types.LinoToCoin(msg.Amount)
 	if err != nil {
 		return err.Result()
 	}
-	//  set a time event to decide the proposal
-	event := proposalManager.CreateDecideProposalEvent(ctx, types.ContentCensorship, proposalID)
+
 	// minus coin from account and return when deciding the proposal
-	if err = am.MinusSavingCoin(
-		ctx, msg.GetCreator(), param.ContentCensorshipMinDeposit,
-		"", string(proposalID), types.ProposalDeposit); err != nil {
+	if err := am.MinusSavingCoin(
+		ctx, msg.Depositor, coin, "", string(msg.ProposalID), types.ProposalDeposit); err != nil {
 		return err.Result()
 	}
 
-	if err := gm.RegisterProposalDecideEvent(ctx, param.ContentCensorshipDecideSec, event); err != nil {
+	isMet, err := pm.AddProposalDeposit(ctx, msg.ProposalID, msg.Depositor, coin)
+	if err != nil {
 		return err.Result()
 	}
+	if !isMet {
+		return sdk.Result{}
+	}
 
-	if err := returnCoinTo(
-		ctx, msg.GetCreator(), gm, am, int64(1),
-		param.ContentCensorshipDecideSec, param.ContentCensorshipMinDeposit); err != nil {
+	if err := startProposalVoting(ctx, pm, gm, msg.ProposalID); err != nil {
 		return err.Result()
 	}
 	return sdk.Result{}
@@ -171,7 +317,8 @@ func handleVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm
 		return ErrNotOngoingProposal().Result()
 	}
 
-	if err := vm.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Result); err != nil {
+	prevVote, err := vm.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option)
+	if err != nil {
 		return err.Result()
 	}
 
@@ -180,7 +327,8 @@ func handleVoteProposalMsg(ctx sdk.Context, proposalManager ProposalManager, vm
 		return err.Result()
 	}
 
-	err = proposalManager.UpdateProposalVotingStatus(ctx, msg.ProposalID, msg.Voter, v.Result, v.VotingPower)
+	err = proposalManager.UpdateProposalVotingStatus(
+		ctx, msg.ProposalID, msg.Voter, v.GetOption(), v.VotingPower, prevVote)
 	if err != nil {
 		return err.Result()
 	}
@@ -192,7 +340,7 @@ func returnCoinTo(
 	ctx sdk.Context, name types.AccountKey, gm *global.GlobalManager, am acc.AccountManager,
 	times int64, interval int64, coin types.Coin) sdk.Error {
 	if err := am.AddFrozenMoney(
-		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times); err != nil {
+		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times, types.ProposalReturnCoin); err != nil {
 		return err
 	}
 
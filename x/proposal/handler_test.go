@@ -4,10 +4,12 @@ import (
 	"strconv"
 	"testing"
 
+	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	acc "github.com/lino-network/lino/x/account"
+	"github.com/lino-network/lino/x/global"
 	"github.com/lino-network/lino/x/proposal/model"
 	"github.com/lino-network/lino/x/vote"
 	"github.com/stretchr/testify/assert"
@@ -17,6 +19,7 @@ var (
 	c460000 = types.NewCoinFromInt64(460000 * types.Decimals)
 	c4600   = types.NewCoinFromInt64(4600 * types.Decimals)
 	c46     = types.NewCoinFromInt64(46 * types.Decimals)
+	c10     = types.NewCoinFromInt64(10 * types.Decimals)
 )
 
 func TestChangeParamProposal(t *testing.T) {
@@ -28,6 +31,7 @@ func TestChangeParamProposal(t *testing.T) {
 		GlobalGrowthRate:         types.NewDecFromRat(98, 1000),
 		DeveloperAllocation:      sdk.ZeroDec(),
 		ValidatorAllocation:      sdk.ZeroDec(),
+		TreasuryAllocation:       sdk.ZeroDec(),
 		InfraAllocation:          sdk.ZeroDec(),
 		ContentCreatorAllocation: types.NewDecFromRat(5, 10),
 	}
@@ -36,6 +40,7 @@ func TestChangeParamProposal(t *testing.T) {
 
 	user1 := createTestAccount(ctx, am, "user1", c460000)
 	user2 := createTestAccount(ctx, am, "user2", c4600)
+	user3 := createTestAccount(ctx, am, "user3", types.NewCoinFromInt64(1))
 
 	curTime := ctx.BlockHeader().Time.Unix()
 	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
@@ -46,22 +51,40 @@ func TestChangeParamProposal(t *testing.T) {
 			ProposalID:    proposalID1,
 			AgreeVotes:    types.NewCoinFromInt64(0),
 			DisagreeVotes: types.NewCoinFromInt64(0),
+			AbstainVotes:  types.NewCoinFromInt64(0),
+			VetoVotes:     types.NewCoinFromInt64(0),
+			Deposit:       c10,
 			Result:        types.ProposalNotPass,
 			CreatedAt:     curTime,
-			ExpiredAt:     curTime + proposalParam.ChangeParamDecideSec,
+			ExpiredAt:     curTime + proposalParam.ProposalDepositSec,
+		},
+		Param:  allocation,
+		Reason: ""}
+	proposal2 := &model.ChangeParamProposal{
+		ProposalInfo: model.ProposalInfo{
+			Creator:       user2,
+			ProposalID:    proposalID2,
+			AgreeVotes:    types.NewCoinFromInt64(0),
+			DisagreeVotes: types.NewCoinFromInt64(0),
+			AbstainVotes:  types.NewCoinFromInt64(0),
+			VetoVotes:     types.NewCoinFromInt64(0),
+			Deposit:       c10,
+			Result:        types.ProposalNotPass,
+			CreatedAt:     curTime,
+			ExpiredAt:     curTime + proposalParam.ProposalDepositSec,
 		},
 		Param:  allocation,
 		Reason: ""}
 
 	testCases := []struct {
-		testName            string
-		msg                 ChangeGlobalAllocationParamMsg
-		proposalID          types.ProposalKey
-		wantOK              bool
-		wantRes             sdk.Result
-		wantCreatorBalance  types.Coin
-		wantOngoingProposal []model.Proposal
-		wantProposal        model.Proposal
+		testName               string
+		msg                    ChangeGlobalAllocationParamMsg
+		proposalID             types.ProposalKey
+		wantOK                 bool
+		wantRes                sdk.Result
+		wantCreatorBalance     types.Coin
+		wantDepositingProposal []model.Proposal
+		wantProposal           model.Proposal
 	}{
 		{
 			testName: "user1 creates change param msg successfully",
@@ -69,26 +92,36 @@ func TestChangeParamProposal(t *testing.T) {
 				Creator:   user1,
 				Parameter: allocation,
 			},
-			proposalID:          proposalID1,
-			wantOK:              true,
-			wantRes:             sdk.Result{},
-			wantCreatorBalance:  c460000.Minus(proposalParam.ChangeParamMinDeposit),
-			wantOngoingProposal: []model.Proposal{proposal1},
-			wantProposal:        proposal1,
+			proposalID:             proposalID1,
+			wantOK:                 true,
+			wantRes:                sdk.Result{},
+			wantCreatorBalance:     c460000.Minus(c10),
+			wantDepositingProposal: []model.Proposal{proposal1},
+			wantProposal:           proposal1,
 		},
 
 		{
-			testName: "user2 doesn't have enough money to create proposal",
+			testName: "user2 creates proposal without enough money for minimum deposit",
 			msg: ChangeGlobalAllocationParamMsg{
 				Creator:   user2,
 				Parameter: allocation,
 			},
-			proposalID:          proposalID2,
-			wantOK:              false,
-			wantRes:             acc.ErrAccountSavingCoinNotEnough().Result(),
-			wantCreatorBalance:  c4600,
-			wantOngoingProposal: []model.Proposal{proposal1},
-			wantProposal:        nil,
+			proposalID:             proposalID2,
+			wantOK:                 true,
+			wantRes:                sdk.Result{},
+			wantCreatorBalance:     c4600.Minus(c10),
+			wantDepositingProposal: []model.Proposal{proposal1, proposal2},
+			wantProposal:           proposal2,
+		},
+		{
+			testName: "user3 doesn't have enough money for minimum initial deposit",
+			msg: ChangeGlobalAllocationParamMsg{
+				Creator:   user3,
+				Parameter: allocation,
+			},
+			proposalID: types.ProposalKey(strconv.FormatInt(int64(3), 10)),
+			wantOK:     false,
+			wantRes:    acc.ErrAccountSavingCoinNotEnough().Result(),
 		},
 	}
 	for _, tc := range testCases {
@@ -106,15 +139,15 @@ func TestChangeParamProposal(t *testing.T) {
 			t.Errorf("%s: diff bank balance: got %v, want %v", tc.testName, creatorBalance, tc.wantCreatorBalance)
 		}
 
-		ongoingList, err := proposalManager.GetOngoingProposalList(ctx)
+		depositingList, err := proposalManager.storage.GetDepositingProposalList(ctx)
 		if err != nil {
 			t.Errorf("%s: failed to get proposal list, get err %v", tc.testName, err)
 		}
-		if !assert.Equal(t, tc.wantOngoingProposal, ongoingList) {
-			t.Errorf("%s: diff ongoing proposal, got %v, want %v", tc.testName, ongoingList, tc.wantOngoingProposal)
+		if !assert.Equal(t, tc.wantDepositingProposal, depositingList) {
+			t.Errorf("%s: diff depositing proposal, got %v, want %v", tc.testName, depositingList, tc.wantDepositingProposal)
 		}
 
-		proposal, err := proposalManager.storage.GetOngoingProposal(ctx, tc.proposalID)
+		proposal, err := proposalManager.storage.GetDepositingProposal(ctx, tc.proposalID)
 		if err != nil {
 			t.Errorf("%s: failed to get proposal, get err %v", tc.testName, err)
 		}
@@ -136,8 +169,6 @@ func TestContentCensorshipProposal(t *testing.T) {
 
 	user1, postID1 := createTestPost(t, ctx, "user1", "postID", c460000, am, postManager, "0")
 	user2, postID2 := createTestPost(t, ctx, "user2", "postID", c4600, am, postManager, "0")
-	user3 := createTestAccount(
-		ctx, am, "user3", proposalParam.ContentCensorshipMinDeposit.Minus(types.NewCoinFromInt64((1))))
 	postManager.DeletePost(ctx, types.GetPermlink(user2, postID2))
 	censorshipReason := "reason"
 	proposal1 := &model.ContentCensorshipProposal{
@@ -146,82 +177,87 @@ func TestContentCensorshipProposal(t *testing.T) {
 			ProposalID:    proposalID1,
 			AgreeVotes:    types.NewCoinFromInt64(0),
 			DisagreeVotes: types.NewCoinFromInt64(0),
+			AbstainVotes:  types.NewCoinFromInt64(0),
+			VetoVotes:     types.NewCoinFromInt64(0),
+			Deposit:       c10,
 			Result:        types.ProposalNotPass,
 			CreatedAt:     curTime,
-			ExpiredAt:     curTime + proposalParam.ChangeParamDecideSec,
+			ExpiredAt:     curTime + proposalParam.ProposalDepositSec,
 		},
 		Permlink: types.GetPermlink(user1, postID1),
 		Reason:   censorshipReason}
 
 	testCases := []struct {
-		testName            string
-		creator             types.AccountKey
-		permlink            types.Permlink
-		proposalID          types.ProposalKey
-		wantOK              bool
-		wantRes             sdk.Result
-		wantCreatorBalance  types.Coin
-		wantOngoingProposal []model.Proposal
-		wantProposal        model.Proposal
+		testName               string
+		creator                types.AccountKey
+		permlink               types.Permlink
+		revision               int64
+		proposalID             types.ProposalKey
+		wantOK                 bool
+		wantRes                sdk.Result
+		wantCreatorBalance     types.Coin
+		wantDepositingProposal []model.Proposal
+		wantProposal           model.Proposal
 	}{
 		{
-			testName:            "user2 censorship user1's post successfully",
-			creator:             user2,
-			permlink:            types.GetPermlink(user1, postID1),
-			proposalID:          proposalID1,
-			wantOK:              true,
-			wantRes:             sdk.Result{},
-			wantCreatorBalance:  c4600.Minus(proposalParam.ContentCensorshipMinDeposit),
-			wantOngoingProposal: []model.Proposal{proposal1},
-			wantProposal:        proposal1,
-		},
-		{
-			testName:            "target post is not exist",
-			creator:             user2,
-			permlink:            types.GetPermlink(user1, "invalid"),
-			proposalID:          proposalID1,
-			wantOK:              false,
-			wantRes:             ErrPostNotFound().Result(),
-			wantCreatorBalance:  c4600.Minus(proposalParam.ContentCensorshipMinDeposit),
-			wantOngoingProposal: []model.Proposal{proposal1},
-			wantProposal:        proposal1,
-		},
-		{
-			testName:            "target post is deleted",
-			creator:             user1,
-			permlink:            types.GetPermlink(user2, postID2),
-			proposalID:          proposalID1,
-			wantOK:              false,
-			wantRes:             ErrCensorshipPostIsDeleted(types.GetPermlink(user2, postID2)).Result(),
-			wantCreatorBalance:  c4600.Minus(proposalParam.ContentCensorshipMinDeposit),
-			wantOngoingProposal: []model.Proposal{proposal1},
-			wantProposal:        proposal1,
-		},
-		{
-			testName:            "proposal is invalid",
-			creator:             "invalid",
-			permlink:            types.GetPermlink(user1, postID1),
-			proposalID:          proposalID1,
-			wantOK:              false,
-			wantRes:             ErrAccountNotFound().Result(),
-			wantCreatorBalance:  c4600.Minus(proposalParam.ContentCensorshipMinDeposit),
-			wantOngoingProposal: []model.Proposal{proposal1},
-			wantProposal:        proposal1,
-		},
-		{
-			testName:            "user3 doesn't have enough money to create proposal",
-			creator:             user3,
-			permlink:            types.GetPermlink(user1, postID1),
-			proposalID:          proposalID1,
-			wantOK:              false,
-			wantRes:             acc.ErrAccountSavingCoinNotEnough().Result(),
-			wantCreatorBalance:  c4600.Minus(proposalParam.ContentCensorshipMinDeposit),
-			wantOngoingProposal: []model.Proposal{proposal1},
-			wantProposal:        proposal1,
+			testName:               "user2 censorship user1's post successfully",
+			creator:                user2,
+			permlink:               types.GetPermlink(user1, postID1),
+			proposalID:             proposalID1,
+			wantOK:                 true,
+			wantRes:                sdk.Result{},
+			wantCreatorBalance:     c4600.Minus(c10),
+			wantDepositingProposal: []model.Proposal{proposal1},
+			wantProposal:           proposal1,
+		},
+		{
+			testName:               "target post is not exist",
+			creator:                user2,
+			permlink:               types.GetPermlink(user1, "invalid"),
+			proposalID:             proposalID1,
+			wantOK:                 false,
+			wantRes:                ErrPostNotFound().Result(),
+			wantCreatorBalance:     c4600.Minus(c10),
+			wantDepositingProposal: []model.Proposal{proposal1},
+			wantProposal:           proposal1,
+		},
+		{
+			testName:               "target post is deleted",
+			creator:                user1,
+			permlink:               types.GetPermlink(user2, postID2),
+			proposalID:             proposalID1,
+			wantOK:                 false,
+			wantRes:                ErrCensorshipPostIsDeleted(types.GetPermlink(user2, postID2)).Result(),
+			wantCreatorBalance:     c4600.Minus(c10),
+			wantDepositingProposal: []model.Proposal{proposal1},
+			wantProposal:           proposal1,
+		},
+		{
+			testName:               "target revision is not exist",
+			creator:                user2,
+			permlink:               types.GetPermlink(user1, postID1),
+			revision:               1,
+			proposalID:             proposalID1,
+			wantOK:                 false,
+			wantRes:                ErrCensorshipRevisionNotFound(types.GetPermlink(user1, postID1), 1).Result(),
+			wantCreatorBalance:     c4600.Minus(c10),
+			wantDepositingProposal: []model.Proposal{proposal1},
+			wantProposal:           proposal1,
+		},
+		{
+			testName:               "proposal is invalid",
+			creator:                "invalid",
+			permlink:               types.GetPermlink(user1, postID1),
+			proposalID:             proposalID1,
+			wantOK:                 false,
+			wantRes:                ErrAccountNotFound().Result(),
+			wantCreatorBalance:     c4600.Minus(c10),
+			wantDepositingProposal: []model.Proposal{proposal1},
+			wantProposal:           proposal1,
 		},
 	}
 	for _, tc := range testCases {
-		msg := NewDeletePostContentMsg(string(tc.creator), tc.permlink, censorshipReason)
+		msg := NewDeletePostContentMsg(string(tc.creator), tc.permlink, tc.revision, censorshipReason)
 		result := handler(ctx, msg)
 		if !assert.Equal(t, tc.wantRes, result) {
 			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
@@ -237,15 +273,15 @@ func TestContentCensorshipProposal(t *testing.T) {
 				tc.testName, creatorBalance, tc.wantCreatorBalance)
 		}
 
-		ongoingList, err := proposalManager.GetOngoingProposalList(ctx)
+		depositingList, err := proposalManager.storage.GetDepositingProposalList(ctx)
 		if err != nil {
 			t.Errorf("%s: failed to get proposal list, get err %v", tc.testName, err)
 		}
-		if !assert.Equal(t, tc.wantOngoingProposal, ongoingList) {
-			t.Errorf("%s: diff ongoing proposal, got %v, want %v", tc.testName, ongoingList, tc.wantOngoingProposal)
+		if !assert.Equal(t, tc.wantDepositingProposal, depositingList) {
+			t.Errorf("%s: diff depositing proposal, got %v, want %v", tc.testName, depositingList, tc.wantDepositingProposal)
 		}
 
-		proposal, err := proposalManager.storage.GetOngoingProposal(ctx, tc.proposalID)
+		proposal, err := proposalManager.storage.GetDepositingProposal(ctx, tc.proposalID)
 		if err != nil {
 			t.Errorf("%s: failed to get proposal, get err %v", tc.testName, err)
 		}
@@ -260,6 +296,495 @@ func TestContentCensorshipProposal(t *testing.T) {
 	}
 }
 
+func TestProposalWithParamStoredBeforeDepositPeriod(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+	curTime := ctx.BlockHeader().Time.Unix()
+
+	// layout of proposal param before deposit period was added
+	type oldProposalParam struct {
+		ContentCensorshipDecideSec  int64
+		ContentCensorshipMinDeposit types.Coin
+		ContentCensorshipPassRatio  sdk.Dec
+		ContentCensorshipPassVotes  types.Coin
+		ChangeParamDecideSec        int64
+		ChangeParamExecutionSec     int64
+		ChangeParamMinDeposit       types.Coin
+		ChangeParamPassRatio        sdk.Dec
+		ChangeParamPassVotes        types.Coin
+		ProtocolUpgradeDecideSec    int64
+		ProtocolUpgradeMinDeposit   types.Coin
+		ProtocolUpgradePassRatio    sdk.Dec
+		ProtocolUpgradePassVotes    types.Coin
+	}
+	paramBytes, err := wire.New().MarshalBinaryLengthPrefixed(oldProposalParam{
+		ContentCensorshipDecideSec:  int64(7 * 24 * 3600),
+		ContentCensorshipMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+		ContentCensorshipPassRatio:  types.NewDecFromRat(50, 100),
+		ContentCensorshipPassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		ChangeParamDecideSec:        int64(7 * 24 * 3600),
+		ChangeParamExecutionSec:     int64(24 * 3600),
+		ChangeParamMinDeposit:       types.NewCoinFromInt64(100000 * types.Decimals),
+		ChangeParamPassRatio:        types.NewDecFromRat(70, 100),
+		ChangeParamPassVotes:        types.NewCoinFromInt64(1000000 * types.Decimals),
+		ProtocolUpgradeDecideSec:    int64(7 * 24 * 3600),
+		ProtocolUpgradeMinDeposit:   types.NewCoinFromInt64(1000000 * types.Decimals),
+		ProtocolUpgradePassRatio:    types.NewDecFromRat(80, 100),
+		ProtocolUpgradePassVotes:    types.NewCoinFromInt64(10000000 * types.Decimals),
+	})
+	assert.Nil(t, err)
+	ctx.KVStore(testParamKVStoreKey).Set(param.GetProposalParamKey(), paramBytes)
+
+	user1 := createTestAccount(ctx, am, "user1", c460000)
+	proposalID := types.ProposalKey("1")
+	result := handler(ctx, ChangeGlobalAllocationParamMsg{
+		Creator:   user1,
+		Parameter: param.GlobalAllocationParam{},
+	})
+	assert.Equal(t, sdk.Result{}, result)
+	assert.True(t, proposalManager.IsDepositingProposal(ctx, proposalID))
+
+	// deposit period doesn't end right away
+	assert.Nil(t, gm.CommitEventCache(ctx))
+	assert.Nil(t, gm.GetTimeEventListAtTime(ctx, curTime))
+	assert.Equal(t, []types.Event{ExpireProposalDepositEvent{ProposalID: proposalID}},
+		gm.GetTimeEventListAtTime(ctx, curTime+7*24*3600).Events)
+
+	// proposal can still reach voting
+	result = handler(ctx, NewDepositProposalMsg("user1", 1, "100000"))
+	assert.Equal(t, sdk.Result{}, result)
+	assert.True(t, proposalManager.IsOngoingProposal(ctx, proposalID))
+}
+
+func TestDepositProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+	curTime := ctx.BlockHeader().Time.Unix()
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+
+	proposalID1 := types.ProposalKey(strconv.FormatInt(int64(1), 10))
+	user1 := createTestAccount(ctx, am, "user1", c460000)
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+
+	result := handler(ctx, ChangeGlobalAllocationParamMsg{
+		Creator:   user1,
+		Parameter: param.GlobalAllocationParam{},
+	})
+	assert.Equal(t, sdk.Result{}, result)
+
+	c100 := types.NewCoinFromInt64(100 * types.Decimals)
+	c99890 := types.NewCoinFromInt64(99890 * types.Decimals)
+	c99900 := types.NewCoinFromInt64(99900 * types.Decimals)
+
+	testCases := []struct {
+		testName         string
+		msg              DepositProposalMsg
+		wantRes          sdk.Result
+		wantBalance      types.Coin
+		wantDepositing   bool
+		wantTotalDeposit types.Coin
+		wantDeposits     []model.ProposalDeposit
+	}{
+		{
+			testName:         "user2 deposits to proposal",
+			msg:              NewDepositProposalMsg("user2", 1, "100"),
+			wantRes:          sdk.Result{},
+			wantBalance:      c4600.Minus(c100),
+			wantDepositing:   true,
+			wantTotalDeposit: c10.Plus(c100),
+			wantDeposits: []model.ProposalDeposit{
+				{Depositor: user1, Amount: c10}, {Depositor: user2, Amount: c100}},
+		},
+		{
+			testName:         "depositor doesn't exist",
+			msg:              NewDepositProposalMsg("invalid", 1, "100"),
+			wantRes:          ErrAccountNotFound().Result(),
+			wantDepositing:   true,
+			wantTotalDeposit: c10.Plus(c100),
+			wantDeposits: []model.ProposalDeposit{
+				{Depositor: user1, Amount: c10}, {Depositor: user2, Amount: c100}},
+		},
+		{
+			testName:         "user2 doesn't have enough money to deposit",
+			msg:              NewDepositProposalMsg("user2", 1, "5000"),
+			wantRes:          acc.ErrAccountSavingCoinNotEnough().Result(),
+			wantBalance:      c4600.Minus(c100),
+			wantDepositing:   true,
+			wantTotalDeposit: c10.Plus(c100),
+			wantDeposits: []model.ProposalDeposit{
+				{Depositor: user1, Amount: c10}, {Depositor: user2, Amount: c100}},
+		},
+		{
+			testName:         "user1 deposit meets minimum deposit and voting starts",
+			msg:              NewDepositProposalMsg("user1", 1, "99890"),
+			wantRes:          sdk.Result{},
+			wantBalance:      c460000.Minus(c99900),
+			wantDepositing:   false,
+			wantTotalDeposit: proposalParam.ChangeParamMinDeposit,
+			wantDeposits: []model.ProposalDeposit{
+				{Depositor: user1, Amount: c99900}, {Depositor: user2, Amount: c100}},
+		},
+		{
+			testName:         "deposit to proposal in voting period",
+			msg:              NewDepositProposalMsg("user2", 1, "100"),
+			wantRes:          ErrNotDepositingProposal().Result(),
+			wantBalance:      c4600.Minus(c100),
+			wantDepositing:   false,
+			wantTotalDeposit: proposalParam.ChangeParamMinDeposit,
+			wantDeposits: []model.ProposalDeposit{
+				{Depositor: user1, Amount: c99900}, {Depositor: user2, Amount: c100}},
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+
+		if am.DoesAccountExist(ctx, tc.msg.Depositor) {
+			balance, _ := am.GetSavingFromBank(ctx, tc.msg.Depositor)
+			if !balance.IsEqual(tc.wantBalance) {
+				t.Errorf("%s: diff bank balance: got %v, want %v", tc.testName, balance, tc.wantBalance)
+			}
+		}
+
+		isDepositing := proposalManager.IsDepositingProposal(ctx, proposalID1)
+		if isDepositing != tc.wantDepositing {
+			t.Errorf("%s: diff depositing status, got %v, want %v", tc.testName, isDepositing, tc.wantDepositing)
+		}
+		var proposal model.Proposal
+		var err sdk.Error
+		if tc.wantDepositing {
+			proposal, err = proposalManager.storage.GetDepositingProposal(ctx, proposalID1)
+		} else {
+			proposal, err = proposalManager.storage.GetOngoingProposal(ctx, proposalID1)
+		}
+		if err != nil {
+			t.Errorf("%s: failed to get proposal, get err %v", tc.testName, err)
+			continue
+		}
+		proposalInfo := proposal.GetProposalInfo()
+		if !proposalInfo.Deposit.IsEqual(tc.wantTotalDeposit) {
+			t.Errorf("%s: diff total deposit, got %v, want %v", tc.testName, proposalInfo.Deposit, tc.wantTotalDeposit)
+		}
+		if !tc.wantDepositing && proposalInfo.ExpiredAt != curTime+proposalParam.ChangeParamDecideSec {
+			t.Errorf("%s: diff expired at, got %v, want %v",
+				tc.testName, proposalInfo.ExpiredAt, curTime+proposalParam.ChangeParamDecideSec)
+		}
+
+		deposits, err := proposalManager.GetProposalDeposits(ctx, proposalID1)
+		if err != nil {
+			t.Errorf("%s: failed to get deposits, get err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.wantDeposits, deposits) {
+			t.Errorf("%s: diff deposits, got %v, want %v", tc.testName, deposits, tc.wantDeposits)
+		}
+	}
+}
+
+func TestTreasurySpendProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+	curTime := ctx.BlockHeader().Time.Unix()
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", c4600)
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+	err := gm.AddToTreasuryPool(ctx, types.NewCoinFromInt64(1000*types.Decimals))
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		testName       string
+		msg            TreasurySpendMsg
+		wantRes        sdk.Result
+		wantProposalID types.ProposalKey
+		wantProposal   model.Proposal
+	}{
+		{
+			testName: "creator doesn't exist",
+			msg:      NewTreasurySpendMsg("invalid", "user2", "100", 1, 0, ""),
+			wantRes:  ErrAccountNotFound().Result(),
+		},
+		{
+			testName: "recipient doesn't exist",
+			msg:      NewTreasurySpendMsg("user1", "invalid", "100", 1, 0, ""),
+			wantRes:  ErrAccountNotFound().Result(),
+		},
+		{
+			testName: "treasury doesn't have enough coin",
+			msg:      NewTreasurySpendMsg("user1", "user2", "1001", 1, 0, ""),
+			wantRes:  global.ErrTreasuryNotEnough().Result(),
+		},
+		{
+			testName:       "vesting spend is added to depositing proposals",
+			msg:            NewTreasurySpendMsg("user1", "user2", "1000", 10, 3600, "grant"),
+			wantRes:        sdk.Result{},
+			wantProposalID: types.ProposalKey("1"),
+			wantProposal: &model.TreasurySpendProposal{
+				ProposalInfo: model.ProposalInfo{
+					Creator:       user1,
+					ProposalID:    types.ProposalKey("1"),
+					AgreeVotes:    types.NewCoinFromInt64(0),
+					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       c10,
+					Result:        types.ProposalNotPass,
+					CreatedAt:     curTime,
+					ExpiredAt:     curTime + proposalParam.ProposalDepositSec,
+				},
+				Recipient:   user2,
+				Amount:      types.NewCoinFromInt64(1000 * types.Decimals),
+				Times:       10,
+				IntervalSec: 3600,
+				Reason:      "grant",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+		if tc.wantProposal == nil {
+			continue
+		}
+		proposal, err := proposalManager.storage.GetDepositingProposal(ctx, tc.wantProposalID)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.wantProposal, proposal) {
+			t.Errorf("%s: diff proposal, got %v, want %v", tc.testName, proposal, tc.wantProposal)
+		}
+	}
+}
+
+func TestContentAppealProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+	curTime := ctx.BlockHeader().Time.Unix()
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+
+	user1, postID1 := createTestPost(t, ctx, "user1", "postID", c4600, am, postManager, "0")
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+	permlink := types.GetPermlink(user1, postID1)
+	assert.Nil(t, postManager.CensorPost(ctx, permlink))
+
+	// passed, not passed and long passed censorship of the post
+	addCensorship := func(result types.ProposalResult, expiredAt int64) types.ProposalKey {
+		p := proposalManager.CreateContentCensorshipProposal(ctx, permlink, 0, "")
+		id, err := proposalManager.AddProposal(ctx, user2, p, 0, types.NewCoinFromInt64(0))
+		assert.Nil(t, err)
+		_, err = proposalManager.UpdateProposalPassStatus(ctx, types.ContentCensorship, id)
+		assert.Nil(t, err)
+		expired, err := proposalManager.storage.GetExpiredProposal(ctx, id)
+		assert.Nil(t, err)
+		info := expired.GetProposalInfo()
+		info.Result = result
+		info.ExpiredAt = expiredAt
+		expired.SetProposalInfo(info)
+		assert.Nil(t, proposalManager.storage.SetExpiredProposal(ctx, id, expired))
+		return id
+	}
+	passedID := addCensorship(types.ProposalPass, curTime)
+	notPassedID := addCensorship(types.ProposalNotPass, curTime)
+	oldPassedID := addCensorship(types.ProposalPass, curTime-proposalParam.ContentAppealWindowSec-1)
+
+	wantAppeal := func(id types.ProposalKey) model.Proposal {
+		return &model.ContentAppealProposal{
+			ProposalInfo: model.ProposalInfo{
+				Creator:       user1,
+				ProposalID:    id,
+				AgreeVotes:    types.NewCoinFromInt64(0),
+				DisagreeVotes: types.NewCoinFromInt64(0),
+				AbstainVotes:  types.NewCoinFromInt64(0),
+				VetoVotes:     types.NewCoinFromInt64(0),
+				Deposit:       c10,
+				Result:        types.ProposalNotPass,
+				CreatedAt:     curTime,
+				ExpiredAt:     curTime + proposalParam.ProposalDepositSec,
+			},
+			CensorshipID: passedID,
+			Permlink:     permlink,
+			Reason:       "appeal",
+		}
+	}
+
+	testCases := []struct {
+		testName       string
+		msg            ContentAppealMsg
+		expireAppeal   types.ProposalKey
+		wantRes        sdk.Result
+		wantProposalID types.ProposalKey
+		wantProposal   model.Proposal
+	}{
+		{
+			testName: "author doesn't exist",
+			msg:      ContentAppealMsg{Author: "invalid", ProposalID: passedID},
+			wantRes:  ErrAccountNotFound().Result(),
+		},
+		{
+			testName: "censorship proposal doesn't exist",
+			msg:      ContentAppealMsg{Author: user1, ProposalID: "100"},
+			wantRes:  model.ErrProposalNotFound().Result(),
+		},
+		{
+			testName: "censorship didn't pass",
+			msg:      ContentAppealMsg{Author: user1, ProposalID: notPassedID},
+			wantRes:  ErrCensorshipNotPassed(notPassedID).Result(),
+		},
+		{
+			testName: "appeal window expired",
+			msg:      ContentAppealMsg{Author: user1, ProposalID: oldPassedID},
+			wantRes:  ErrAppealWindowExpired(oldPassedID).Result(),
+		},
+		{
+			testName: "only post author can appeal",
+			msg:      ContentAppealMsg{Author: user2, ProposalID: passedID},
+			wantRes:  ErrAppealNotAllowed(user2).Result(),
+		},
+		{
+			testName:       "author appeals successfully",
+			msg:            ContentAppealMsg{Author: user1, ProposalID: passedID, Reason: "appeal"},
+			wantRes:        sdk.Result{},
+			wantProposalID: types.ProposalKey("4"),
+			wantProposal:   wantAppeal(types.ProposalKey("4")),
+		},
+		{
+			testName: "censorship can't be appealed twice",
+			msg:      ContentAppealMsg{Author: user1, ProposalID: passedID, Reason: "appeal"},
+			wantRes:  ErrAppealAlreadyExist(passedID).Result(),
+		},
+		{
+			testName:       "appeal again after deposit of previous appeal is not met",
+			msg:            ContentAppealMsg{Author: user1, ProposalID: passedID, Reason: "appeal"},
+			expireAppeal:   types.ProposalKey("4"),
+			wantRes:        sdk.Result{},
+			wantProposalID: types.ProposalKey("5"),
+			wantProposal:   wantAppeal(types.ProposalKey("5")),
+		},
+	}
+	for _, tc := range testCases {
+		if tc.expireAppeal != "" {
+			assert.Nil(t, proposalManager.ExpireDepositingProposal(ctx, tc.expireAppeal))
+		}
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+		if tc.wantProposal == nil {
+			continue
+		}
+		proposal, err := proposalManager.storage.GetDepositingProposal(ctx, tc.wantProposalID)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.wantProposal, proposal) {
+			t.Errorf("%s: diff proposal, got %v, want %v", tc.testName, proposal, tc.wantProposal)
+		}
+		appeal, err := proposalManager.storage.GetContentAppeal(ctx, passedID)
+		assert.Nil(t, err)
+		assert.Equal(t, &model.ContentAppeal{CensorshipID: passedID, AppealID: tc.wantProposalID}, appeal)
+	}
+}
+
+func TestAccountFreezeProposal(t *testing.T) {
+	ctx, am, proposalManager, postManager, vm, _, gm := setupTest(t, 0)
+	handler := NewHandler(am, proposalManager, postManager, &gm, vm)
+	proposalManager.InitGenesis(ctx)
+	curTime := ctx.BlockHeader().Time.Unix()
+	proposalParam, _ := proposalManager.paramHolder.GetProposalParam(ctx)
+
+	user1 := createTestAccount(ctx, am, "user1", c4600)
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+	wantProposalInfo := func(proposalID types.ProposalKey) model.ProposalInfo {
+		return model.ProposalInfo{
+			Creator:       user1,
+			ProposalID:    proposalID,
+			AgreeVotes:    types.NewCoinFromInt64(0),
+			DisagreeVotes: types.NewCoinFromInt64(0),
+			AbstainVotes:  types.NewCoinFromInt64(0),
+			VetoVotes:     types.NewCoinFromInt64(0),
+			Deposit:       c10,
+			Result:        types.ProposalNotPass,
+			CreatedAt:     curTime,
+			ExpiredAt:     curTime + proposalParam.ProposalDepositSec,
+		}
+	}
+
+	testCases := []struct {
+		testName       string
+		msg            sdk.Msg
+		freeze         bool
+		wantRes        sdk.Result
+		wantProposalID types.ProposalKey
+		wantProposal   model.Proposal
+	}{
+		{
+			testName: "creator doesn't exist",
+			msg:      NewAccountFreezeMsg("invalid", "user2", ""),
+			wantRes:  ErrAccountNotFound().Result(),
+		},
+		{
+			testName: "account to freeze doesn't exist",
+			msg:      NewAccountFreezeMsg("user1", "invalid", ""),
+			wantRes:  ErrAccountNotFound().Result(),
+		},
+		{
+			testName:       "freeze is added to depositing proposals",
+			msg:            NewAccountFreezeMsg("user1", "user2", "stolen key"),
+			wantRes:        sdk.Result{},
+			wantProposalID: types.ProposalKey("1"),
+			wantProposal: &model.AccountFreezeProposal{
+				ProposalInfo: wantProposalInfo(types.ProposalKey("1")),
+				Username:     user2,
+				Reason:       "stolen key",
+			},
+		},
+		{
+			testName: "account to unfreeze is not frozen",
+			msg:      NewAccountUnfreezeMsg("user1", "user2", ""),
+			wantRes:  ErrAccountNotFrozen(user2).Result(),
+		},
+		{
+			testName:       "unfreeze is added to depositing proposals",
+			msg:            NewAccountUnfreezeMsg("user1", "user2", "key recovered"),
+			freeze:         true,
+			wantRes:        sdk.Result{},
+			wantProposalID: types.ProposalKey("2"),
+			wantProposal: &model.AccountUnfreezeProposal{
+				ProposalInfo: wantProposalInfo(types.ProposalKey("2")),
+				Username:     user2,
+				Reason:       "key recovered",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		if tc.freeze {
+			err := proposalManager.FreezeAccount(ctx, user2, types.ProposalKey("1"))
+			assert.Nil(t, err)
+		}
+		result := handler(ctx, tc.msg)
+		if !assert.Equal(t, tc.wantRes, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.wantRes)
+		}
+		if tc.wantProposal == nil {
+			continue
+		}
+		proposal, err := proposalManager.storage.GetDepositingProposal(ctx, tc.wantProposalID)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.wantProposal, proposal) {
+			t.Errorf("%s: diff proposal, got %v, want %v", tc.testName, proposal, tc.wantProposal)
+		}
+	}
+}
+
 func TestAddFrozenMoney(t *testing.T) {
 	ctx, am, proposalManager, _, _, _, gm := setupTest(t, 0)
 	proposalManager.InitGenesis(ctx)
@@ -346,7 +871,7 @@ func TestVoteProposalBasic(t *testing.T) {
 		Reason:   censorshipReason,
 	}
 	decideSec := int64(100)
-	proposalID1, _ := proposalManager.AddProposal(ctx, user1, proposal1, decideSec)
+	proposalID1, _ := proposalManager.AddProposal(ctx, user1, proposal1, decideSec, types.NewCoinFromInt64(0))
 
 	testCases := []struct {
 		testName     string
@@ -360,7 +885,7 @@ func TestVoteProposalBasic(t *testing.T) {
 			msg: VoteProposalMsg{
 				Voter:      user2,
 				ProposalID: proposalID1,
-				Result:     true,
+				Option:     types.VoteOptionYes,
 			},
 			wantRes: ErrVoterNotFound().Result(),
 			wantOK:  true,
@@ -370,6 +895,9 @@ func TestVoteProposalBasic(t *testing.T) {
 					ProposalID:    proposalID1,
 					AgreeVotes:    types.NewCoinFromInt64(0),
 					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       types.NewCoinFromInt64(0),
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -381,7 +909,7 @@ func TestVoteProposalBasic(t *testing.T) {
 			msg: VoteProposalMsg{
 				Voter:      user1,
 				ProposalID: types.ProposalKey(100),
-				Result:     true,
+				Option:     types.VoteOptionYes,
 			},
 			wantRes: ErrNotOngoingProposal().Result(),
 			wantProposal: &model.ContentCensorshipProposal{
@@ -390,6 +918,9 @@ func TestVoteProposalBasic(t *testing.T) {
 					ProposalID:    proposalID1,
 					AgreeVotes:    types.NewCoinFromInt64(0),
 					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       types.NewCoinFromInt64(0),
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -402,7 +933,7 @@ func TestVoteProposalBasic(t *testing.T) {
 			msg: VoteProposalMsg{
 				Voter:      user1,
 				ProposalID: proposalID1,
-				Result:     true,
+				Option:     types.VoteOptionYes,
 			},
 			wantRes: sdk.Result{},
 			wantOK:  true,
@@ -412,6 +943,34 @@ func TestVoteProposalBasic(t *testing.T) {
 					ProposalID:    proposalID1,
 					AgreeVotes:    c4600,
 					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       types.NewCoinFromInt64(0),
+					Result:        types.ProposalNotPass,
+					CreatedAt:     curTime,
+					ExpiredAt:     curTime + decideSec,
+				},
+				Permlink: permlink,
+				Reason:   censorshipReason},
+		},
+		{
+			testName: "user can change vote while proposal is ongoing",
+			msg: VoteProposalMsg{
+				Voter:      user1,
+				ProposalID: proposalID1,
+				Option:     types.VoteOptionNo,
+			},
+			wantRes: sdk.Result{},
+			wantOK:  true,
+			wantProposal: &model.ContentCensorshipProposal{
+				ProposalInfo: model.ProposalInfo{
+					Creator:       user1,
+					ProposalID:    proposalID1,
+					AgreeVotes:    types.NewCoinFromInt64(0),
+					DisagreeVotes: c4600,
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       types.NewCoinFromInt64(0),
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -420,11 +979,11 @@ func TestVoteProposalBasic(t *testing.T) {
 				Reason:   censorshipReason},
 		},
 		{
-			testName: "user can't double-vote",
+			testName: "user can't vote the same option twice",
 			msg: VoteProposalMsg{
 				Voter:      user1,
 				ProposalID: proposalID1,
-				Result:     false,
+				Option:     types.VoteOptionNo,
 			},
 			wantRes: vote.ErrVoteAlreadyExist().Result(),
 			wantOK:  true,
@@ -432,8 +991,11 @@ func TestVoteProposalBasic(t *testing.T) {
 				ProposalInfo: model.ProposalInfo{
 					Creator:       user1,
 					ProposalID:    proposalID1,
-					AgreeVotes:    c4600,
-					DisagreeVotes: types.NewCoinFromInt64(0),
+					AgreeVotes:    types.NewCoinFromInt64(0),
+					DisagreeVotes: c4600,
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       types.NewCoinFromInt64(0),
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
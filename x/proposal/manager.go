@@ -6,8 +6,11 @@ import (
 	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/proposal/model"
+	"github.com/lino-network/lino/x/vote"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	val "github.com/lino-network/lino/x/validator"
+	votemodel "github.com/lino-network/lino/x/vote/model"
 )
 
 // ProposalManager - proposal manager
@@ -43,11 +46,18 @@ func (pm ProposalManager) IsOngoingProposal(ctx sdk.Context, proposalID types.Pr
 	return err == nil
 }
 
+// IsDepositingProposal - check given proposal ID is in depositing proposal list
+func (pm ProposalManager) IsDepositingProposal(ctx sdk.Context, proposalID types.ProposalKey) bool {
+	_, err := pm.storage.GetDepositingProposal(ctx, proposalID)
+	return err == nil
+}
+
 // CreateContentCensorshipProposal - create a content censorship proposal
 func (pm ProposalManager) CreateContentCensorshipProposal(
-	ctx sdk.Context, permlink types.Permlink, reason string) model.Proposal {
+	ctx sdk.Context, permlink types.Permlink, revision int64, reason string) model.Proposal {
 	return &model.ContentCensorshipProposal{
 		Permlink: permlink,
+		Revision: revision,
 		Reason:   reason,
 	}
 }
@@ -69,6 +79,49 @@ func (pm ProposalManager) CreateChangeParamProposal(
 	}
 }
 
+// CreateTreasurySpendProposal - create a treasury spend proposal
+func (pm ProposalManager) CreateTreasurySpendProposal(
+	ctx sdk.Context, recipient types.AccountKey, amount types.Coin,
+	times, intervalSec int64, reason string) model.Proposal {
+	return &model.TreasurySpendProposal{
+		Recipient:   recipient,
+		Amount:      amount,
+		Times:       times,
+		IntervalSec: intervalSec,
+		Reason:      reason,
+	}
+}
+
+// CreateContentAppealProposal - create a content appeal proposal against a censorship proposal
+func (pm ProposalManager) CreateContentAppealProposal(
+	ctx sdk.Context, censorshipID types.ProposalKey, permlink types.Permlink,
+	revision int64, reason string) model.Proposal {
+	return &model.ContentAppealProposal{
+		CensorshipID: censorshipID,
+		Permlink:     permlink,
+		Revision:     revision,
+		Reason:       reason,
+	}
+}
+
+// CreateAccountFreezeProposal - create an account freeze proposal
+func (pm ProposalManager) CreateAccountFreezeProposal(
+	ctx sdk.Context, username types.AccountKey, reason string) model.Proposal {
+	return &model.AccountFreezeProposal{
+		Username: username,
+		Reason:   reason,
+	}
+}
+
+// CreateAccountUnfreezeProposal - create an account unfreeze proposal
+func (pm ProposalManager) CreateAccountUnfreezeProposal(
+	ctx sdk.Context, username types.AccountKey, reason string) model.Proposal {
+	return &model.AccountUnfreezeProposal{
+		Username: username,
+		Reason:   reason,
+	}
+}
+
 // GetNextProposalID - get next proposal ID from KV store
 func (pm ProposalManager) GetNextProposalID(ctx sdk.Context) (types.ProposalKey, sdk.Error) {
 	nextProposalID, err := pm.storage.GetNextProposalID(ctx)
@@ -94,34 +147,213 @@ func (pm ProposalManager) IncreaseNextProposalID(ctx sdk.Context) sdk.Error {
 	return nil
 }
 
-// AddProposal - add a new proposal to ongoing proposal list
+// AddProposal - add a new proposal to ongoing proposal list,
+// deposit is kept in proposal and settled when proposal is decided
 func (pm ProposalManager) AddProposal(
-	ctx sdk.Context, creator types.AccountKey, proposal model.Proposal, decideSec int64) (types.ProposalKey, sdk.Error) {
+	ctx sdk.Context, creator types.AccountKey, proposal model.Proposal,
+	decideSec int64, deposit types.Coin) (types.ProposalKey, sdk.Error) {
+	newID, err := pm.GetNextProposalID(ctx)
+	if err != nil {
+		return newID, err
+	}
+
+	proposal.SetProposalInfo(newProposalInfo(ctx, creator, newID, decideSec, deposit))
+	if err := pm.storage.SetOngoingProposal(ctx, newID, proposal); err != nil {
+		return newID, err
+	}
+	if deposit.IsPositive() {
+		if err := pm.storage.SetProposalDeposit(ctx, newID, &model.ProposalDeposit{
+			Depositor: creator,
+			Amount:    deposit,
+		}); err != nil {
+			return newID, err
+		}
+	}
+
+	if err := pm.IncreaseNextProposalID(ctx); err != nil {
+		return newID, err
+	}
+
+	return newID, nil
+}
+
+// AddDepositingProposal - add a new proposal to depositing proposal list,
+// voting starts once its deposit meets minimum deposit in deposit period
+func (pm ProposalManager) AddDepositingProposal(
+	ctx sdk.Context, creator types.AccountKey, proposal model.Proposal) (types.ProposalKey, sdk.Error) {
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return types.ProposalKey(""), err
+	}
 	newID, err := pm.GetNextProposalID(ctx)
 	if err != nil {
 		return newID, err
 	}
 
-	info := model.ProposalInfo{
+	proposal.SetProposalInfo(
+		newProposalInfo(ctx, creator, newID, param.ProposalDepositSec, types.NewCoinFromInt64(0)))
+	if err := pm.storage.SetDepositingProposal(ctx, newID, proposal); err != nil {
+		return newID, err
+	}
+
+	if err := pm.IncreaseNextProposalID(ctx); err != nil {
+		return newID, err
+	}
+
+	return newID, nil
+}
+
+func newProposalInfo(
+	ctx sdk.Context, creator types.AccountKey, proposalID types.ProposalKey,
+	expireSec int64, deposit types.Coin) model.ProposalInfo {
+	return model.ProposalInfo{
 		Creator:       creator,
-		ProposalID:    newID,
+		ProposalID:    proposalID,
 		AgreeVotes:    types.NewCoinFromInt64(0),
 		DisagreeVotes: types.NewCoinFromInt64(0),
+		AbstainVotes:  types.NewCoinFromInt64(0),
+		VetoVotes:     types.NewCoinFromInt64(0),
+		Deposit:       deposit,
 		Result:        types.ProposalNotPass,
 		CreatedAt:     ctx.BlockHeader().Time.Unix(),
-		ExpiredAt:     ctx.BlockHeader().Time.Unix() + decideSec,
+		ExpiredAt:     ctx.BlockHeader().Time.Unix() + expireSec,
 	}
-	proposal.SetProposalInfo(info)
+}
 
-	if err := pm.storage.SetOngoingProposal(ctx, newID, proposal); err != nil {
-		return newID, err
+// AddProposalDeposit - add deposit of a depositor to a depositing proposal,
+// return true if total deposit meets minimum deposit of the proposal
+func (pm ProposalManager) AddProposalDeposit(
+	ctx sdk.Context, proposalID types.ProposalKey, depositor types.AccountKey,
+	coin types.Coin) (bool, sdk.Error) {
+	proposal, err := pm.storage.GetDepositingProposal(ctx, proposalID)
+	if err != nil {
+		return false, err
+	}
+	proposalType, err := getProposalType(proposal)
+	if err != nil {
+		return false, err
+	}
+	_, minDeposit, err := pm.GetProposalDecideParam(ctx, proposalType)
+	if err != nil {
+		return false, err
 	}
 
-	if err := pm.IncreaseNextProposalID(ctx); err != nil {
-		return newID, err
+	deposit, err := pm.storage.GetProposalDeposit(ctx, proposalID, depositor)
+	if err != nil {
+		return false, err
+	}
+	deposit.Amount = deposit.Amount.Plus(coin)
+	if err := pm.storage.SetProposalDeposit(ctx, proposalID, deposit); err != nil {
+		return false, err
 	}
 
-	return newID, nil
+	proposalInfo := proposal.GetProposalInfo()
+	proposalInfo.Deposit = proposalInfo.Deposit.Plus(coin)
+	proposal.SetProposalInfo(proposalInfo)
+	if err := pm.storage.SetDepositingProposal(ctx, proposalID, proposal); err != nil {
+		return false, err
+	}
+	return proposalInfo.Deposit.IsGTE(minDeposit), nil
+}
+
+// StartProposalVoting - move a depositing proposal to ongoing proposal list,
+// return proposal type and seconds till the proposal is decided
+func (pm ProposalManager) StartProposalVoting(
+	ctx sdk.Context, proposalID types.ProposalKey) (types.ProposalType, int64, sdk.Error) {
+	proposal, err := pm.storage.GetDepositingProposal(ctx, proposalID)
+	if err != nil {
+		return 0, 0, err
+	}
+	proposalType, err := getProposalType(proposal)
+	if err != nil {
+		return 0, 0, err
+	}
+	decideSec, _, err := pm.GetProposalDecideParam(ctx, proposalType)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	proposalInfo := proposal.GetProposalInfo()
+	proposalInfo.ExpiredAt = ctx.BlockHeader().Time.Unix() + decideSec
+	proposal.SetProposalInfo(proposalInfo)
+	if err := pm.storage.SetOngoingProposal(ctx, proposalID, proposal); err != nil {
+		return 0, 0, err
+	}
+	if err := pm.storage.DeleteDepositingProposal(ctx, proposalID); err != nil {
+		return 0, 0, err
+	}
+	return proposalType, decideSec, nil
+}
+
+// ExpireDepositingProposal - move a depositing proposal which doesn't meet
+// minimum deposit to expired proposal list
+func (pm ProposalManager) ExpireDepositingProposal(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	proposal, err := pm.storage.GetDepositingProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	proposalInfo := proposal.GetProposalInfo()
+	proposalInfo.Result = types.ProposalDepositNotMet
+	proposal.SetProposalInfo(proposalInfo)
+	if err := pm.storage.SetExpiredProposal(ctx, proposalID, proposal); err != nil {
+		return err
+	}
+	if err := pm.storage.DeleteDepositingProposal(ctx, proposalID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetProposalDeposits - get deposits of all depositors to a proposal
+func (pm ProposalManager) GetProposalDeposits(
+	ctx sdk.Context, proposalID types.ProposalKey) ([]model.ProposalDeposit, sdk.Error) {
+	return pm.storage.GetProposalDeposits(ctx, proposalID)
+}
+
+// GetProposalDecideParam - based on proposal type, get decide seconds and minimum deposit
+func (pm ProposalManager) GetProposalDecideParam(
+	ctx sdk.Context, proposalType types.ProposalType) (int64, types.Coin, sdk.Error) {
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return 0, types.NewCoinFromInt64(0), err
+	}
+	switch proposalType {
+	case types.ChangeParam:
+		return param.ChangeParamDecideSec, param.ChangeParamMinDeposit, nil
+	case types.ContentCensorship:
+		return param.ContentCensorshipDecideSec, param.ContentCensorshipMinDeposit, nil
+	case types.ProtocolUpgrade:
+		return param.ProtocolUpgradeDecideSec, param.ProtocolUpgradeMinDeposit, nil
+	case types.TreasurySpend:
+		return param.TreasurySpendDecideSec, param.TreasurySpendMinDeposit, nil
+	case types.ContentAppeal:
+		return param.ContentAppealDecideSec, param.ContentAppealMinDeposit, nil
+	case types.AccountFreeze, types.AccountUnfreeze:
+		return param.AccountFreezeDecideSec, param.AccountFreezeMinDeposit, nil
+	default:
+		return 0, types.NewCoinFromInt64(0), ErrIncorrectProposalType()
+	}
+}
+
+func getProposalType(proposal model.Proposal) (types.ProposalType, sdk.Error) {
+	switch proposal.(type) {
+	case *model.ChangeParamProposal:
+		return types.ChangeParam, nil
+	case *model.ContentCensorshipProposal:
+		return types.ContentCensorship, nil
+	case *model.ProtocolUpgradeProposal:
+		return types.ProtocolUpgrade, nil
+	case *model.TreasurySpendProposal:
+		return types.TreasurySpend, nil
+	case *model.ContentAppealProposal:
+		return types.ContentAppeal, nil
+	case *model.AccountFreezeProposal:
+		return types.AccountFreeze, nil
+	case *model.AccountUnfreezeProposal:
+		return types.AccountUnfreeze, nil
+	default:
+		return 0, ErrIncorrectProposalType()
+	}
 }
 
 // GetProposalPassParam - based on proposal type, get pass ratio and pass vote requirement
@@ -138,25 +370,36 @@ func (pm ProposalManager) GetProposalPassParam(
 		return param.ContentCensorshipPassRatio, param.ContentCensorshipPassVotes, nil
 	case types.ProtocolUpgrade:
 		return param.ProtocolUpgradePassRatio, param.ProtocolUpgradePassVotes, nil
+	case types.TreasurySpend:
+		return param.TreasurySpendPassRatio, param.TreasurySpendPassVotes, nil
+	case types.ContentAppeal:
+		return param.ContentAppealPassRatio, param.ContentAppealPassVotes, nil
+	case types.AccountFreeze, types.AccountUnfreeze:
+		return param.AccountFreezePassRatio, param.AccountFreezePassVotes, nil
 	default:
 		return sdk.NewDec(1), types.NewCoinFromInt64(0), ErrIncorrectProposalType()
 	}
 }
 
-// UpdateProposalVotingStatus - update proposal status after voting
+// UpdateProposalVotingStatus - update proposal status after voting,
+// if voter changed the vote, previous vote is removed from tally first
 func (pm ProposalManager) UpdateProposalVotingStatus(ctx sdk.Context, proposalID types.ProposalKey,
-	voter types.AccountKey, voteResult bool, votingPower types.Coin) sdk.Error {
+	voter types.AccountKey, voteOption types.VoteOption, votingPower types.Coin,
+	prevVote *votemodel.Vote) sdk.Error {
 	proposal, err := pm.storage.GetOngoingProposal(ctx, proposalID)
 	if err != nil {
 		return err
 	}
 	proposalInfo := proposal.GetProposalInfo()
 
-	if voteResult == true {
-		proposalInfo.AgreeVotes = proposalInfo.AgreeVotes.Plus(votingPower)
-	} else {
-		proposalInfo.DisagreeVotes = proposalInfo.DisagreeVotes.Plus(votingPower)
+	if prevVote != nil {
+		tallyVote(&proposalInfo, prevVote.GetOption(), func(votes types.Coin) types.Coin {
+			return votes.Minus(prevVote.VotingPower)
+		})
 	}
+	tallyVote(&proposalInfo, voteOption, func(votes types.Coin) types.Coin {
+		return votes.Plus(votingPower)
+	})
 
 	proposal.SetProposalInfo(proposalInfo)
 	if err := pm.storage.SetOngoingProposal(ctx, proposalID, proposal); err != nil {
@@ -166,6 +409,54 @@ func (pm ProposalManager) UpdateProposalVotingStatus(ctx sdk.Context, proposalID
 	return nil
 }
 
+// RetallyProposalVotes - recompute tallies of an ongoing proposal from votes
+func (pm ProposalManager) RetallyProposalVotes(
+	ctx sdk.Context, proposalID types.ProposalKey, votes []votemodel.Vote) sdk.Error {
+	proposal, err := pm.storage.GetOngoingProposal(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+	proposalInfo := proposal.GetProposalInfo()
+	tallyVotes(&proposalInfo, votes)
+
+	proposal.SetProposalInfo(proposalInfo)
+	if err := pm.storage.SetOngoingProposal(ctx, proposalID, proposal); err != nil {
+		return err
+	}
+	return nil
+}
+
+// tallyVotes - reset tallies and count all votes with their voting power
+func tallyVotes(proposalInfo *model.ProposalInfo, votes []votemodel.Vote) {
+	proposalInfo.AgreeVotes = types.NewCoinFromInt64(0)
+	proposalInfo.DisagreeVotes = types.NewCoinFromInt64(0)
+	proposalInfo.AbstainVotes = types.NewCoinFromInt64(0)
+	proposalInfo.VetoVotes = types.NewCoinFromInt64(0)
+
+	for _, vote := range votes {
+		votingPower := vote.VotingPower
+		tallyVote(proposalInfo, vote.GetOption(), func(tally types.Coin) types.Coin {
+			return tally.Plus(votingPower)
+		})
+	}
+}
+
+// tallyVote - update votes of the vote option, no with veto is also counted as disagree
+func tallyVote(
+	proposalInfo *model.ProposalInfo, voteOption types.VoteOption, update func(types.Coin) types.Coin) {
+	switch voteOption {
+	case types.VoteOptionYes:
+		proposalInfo.AgreeVotes = update(proposalInfo.AgreeVotes)
+	case types.VoteOptionNo:
+		proposalInfo.DisagreeVotes = update(proposalInfo.DisagreeVotes)
+	case types.VoteOptionAbstain:
+		proposalInfo.AbstainVotes = update(proposalInfo.AbstainVotes)
+	case types.VoteOptionNoWithVeto:
+		proposalInfo.DisagreeVotes = update(proposalInfo.DisagreeVotes)
+		proposalInfo.VetoVotes = update(proposalInfo.VetoVotes)
+	}
+}
+
 // UpdateProposalPassStatus - update proposal pass status when proposal change from ongoing to expired
 func (pm ProposalManager) UpdateProposalPassStatus(
 	ctx sdk.Context, proposalType types.ProposalType,
@@ -189,14 +480,11 @@ func (pm ProposalManager) UpdateProposalStatus(
 	if err != nil {
 		return types.ProposalNotPass, err
 	}
-	totalVotes := proposalInfo.AgreeVotes.Plus(proposalInfo.DisagreeVotes)
-	actualRatio := proposalInfo.AgreeVotes.ToDec().Quo(totalVotes.ToDec())
-
-	if !totalVotes.IsGT(minVotes) || !ratio.LT(actualRatio) {
-		proposalInfo.Result = types.ProposalNotPass
-	} else {
-		proposalInfo.Result = types.ProposalPass
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return types.ProposalNotPass, err
 	}
+	proposalInfo.Result = decideProposalResult(proposalInfo, ratio, minVotes, param.ProposalVetoRatio)
 
 	proposal.SetProposalInfo(proposalInfo)
 	if err := pm.storage.SetExpiredProposal(ctx, proposalID, proposal); err != nil {
@@ -209,6 +497,163 @@ func (pm ProposalManager) UpdateProposalStatus(
 	return proposalInfo.Result, nil
 }
 
+// decideProposalResult - decide proposal result based on tallies,
+// abstain votes only count in quorum and veto is checked before pass ratio
+func decideProposalResult(
+	proposalInfo model.ProposalInfo, passRatio sdk.Dec, minVotes types.Coin,
+	vetoRatio sdk.Dec) types.ProposalResult {
+	decisiveVotes := proposalInfo.AgreeVotes.Plus(proposalInfo.DisagreeVotes)
+	totalVotes := decisiveVotes.Plus(proposalInfo.AbstainVotes)
+
+	switch {
+	case !totalVotes.IsGT(minVotes):
+		return types.ProposalNotPass
+	case proposalInfo.VetoVotes.ToDec().GT(vetoRatio.Mul(totalVotes.ToDec())):
+		return types.ProposalVetoed
+	case decisiveVotes.IsZero() ||
+		!passRatio.LT(proposalInfo.AgreeVotes.ToDec().Quo(decisiveVotes.ToDec())):
+		return types.ProposalNotPass
+	default:
+		return types.ProposalPass
+	}
+}
+
+// GetProposalTally - get tally of an ongoing or expired proposal with its pass requirement,
+// tally of ongoing proposal is recomputed from votes and its result is the one if decided now
+func (pm ProposalManager) GetProposalTally(
+	ctx sdk.Context, proposalID types.ProposalKey, votes []votemodel.Vote) (*ProposalTally, sdk.Error) {
+	if pm.IsDepositingProposal(ctx, proposalID) {
+		return nil, ErrNotOngoingProposal()
+	}
+	isOngoing := pm.IsOngoingProposal(ctx, proposalID)
+	var proposal model.Proposal
+	var err sdk.Error
+	if isOngoing {
+		proposal, err = pm.storage.GetOngoingProposal(ctx, proposalID)
+	} else {
+		proposal, err = pm.storage.GetExpiredProposal(ctx, proposalID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	proposalType, err := getProposalType(proposal)
+	if err != nil {
+		return nil, err
+	}
+	passRatio, passVotes, err := pm.GetProposalPassParam(ctx, proposalType)
+	if err != nil {
+		return nil, err
+	}
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalInfo := proposal.GetProposalInfo()
+	timeRemaining := int64(0)
+	if isOngoing {
+		tallyVotes(&proposalInfo, votes)
+		proposalInfo.Result = decideProposalResult(
+			proposalInfo, passRatio, passVotes, param.ProposalVetoRatio)
+		if remaining := proposalInfo.ExpiredAt - ctx.BlockHeader().Time.Unix(); remaining > 0 {
+			timeRemaining = remaining
+		}
+	}
+
+	decisiveVotes := proposalInfo.AgreeVotes.Plus(proposalInfo.DisagreeVotes)
+	totalVotes := decisiveVotes.Plus(proposalInfo.AbstainVotes)
+	agreeRatio, vetoRatio := sdk.ZeroDec(), sdk.ZeroDec()
+	if !decisiveVotes.IsZero() {
+		agreeRatio = proposalInfo.AgreeVotes.ToDec().Quo(decisiveVotes.ToDec())
+	}
+	if !totalVotes.IsZero() {
+		vetoRatio = proposalInfo.VetoVotes.ToDec().Quo(totalVotes.ToDec())
+	}
+	return &ProposalTally{
+		ProposalID:    proposalID,
+		ProposalType:  proposalType,
+		IsOngoing:     isOngoing,
+		AgreeVotes:    proposalInfo.AgreeVotes,
+		DisagreeVotes: proposalInfo.DisagreeVotes,
+		AbstainVotes:  proposalInfo.AbstainVotes,
+		VetoVotes:     proposalInfo.VetoVotes,
+		TotalVotes:    totalVotes,
+		AgreeRatio:    agreeRatio,
+		VetoRatio:     vetoRatio,
+		PassRatio:     passRatio,
+		PassVotes:     passVotes,
+		VetoThreshold: param.ProposalVetoRatio,
+		Result:        proposalInfo.Result,
+		TimeRemaining: timeRemaining,
+	}, nil
+}
+
+// GetProposalList - get ongoing or expired proposals match the filter,
+// skip first offset proposals and return at most limit proposals
+func (pm ProposalManager) GetProposalList(
+	ctx sdk.Context, isExpired bool, filter ProposalFilter, offset, limit int64) ([]model.Proposal, sdk.Error) {
+	var proposals []model.Proposal
+	var err sdk.Error
+	if isExpired {
+		proposals, err = pm.storage.GetExpiredProposalList(ctx)
+	} else {
+		proposals, err = pm.storage.GetOngoingProposalList(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := []model.Proposal{}
+	for _, proposal := range proposals {
+		if !filter.Match(proposal) {
+			continue
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		if int64(len(res)) >= limit {
+			break
+		}
+		res = append(res, proposal)
+	}
+	return res, nil
+}
+
+// GetPendingVotes - get oncall validators who haven't voted on each ongoing proposal
+func (pm ProposalManager) GetPendingVotes(
+	ctx sdk.Context, vm vote.VoteManager, valManager val.ValidatorManager) ([]PendingVotes, sdk.Error) {
+	proposals, err := pm.storage.GetOngoingProposalList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lst, err := valManager.GetValidatorList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []PendingVotes{}
+	for _, proposal := range proposals {
+		proposalInfo := proposal.GetProposalInfo()
+		proposalType, err := getProposalType(proposal)
+		if err != nil {
+			return nil, err
+		}
+		validators, err := vm.GetValidatorsNotVoted(ctx, proposalInfo.ProposalID, lst.OncallValidators)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, PendingVotes{
+			ProposalID:        proposalInfo.ProposalID,
+			ProposalType:      proposalType,
+			ExpiredAt:         proposalInfo.ExpiredAt,
+			VoteRequired:      vote.IsVoteRequired(proposalType),
+			PendingValidators: validators,
+		})
+	}
+	return res, nil
+}
+
 // CreateDecideProposalEvent - create a decide proposal event
 func (pm ProposalManager) CreateDecideProposalEvent(
 	ctx sdk.Context, proposalType types.ProposalType, proposalID types.ProposalKey) types.Event {
@@ -219,6 +664,15 @@ func (pm ProposalManager) CreateDecideProposalEvent(
 	return event
 }
 
+// CreateExpireProposalDepositEvent - create an event to expire proposal at the end of deposit period
+func (pm ProposalManager) CreateExpireProposalDepositEvent(
+	ctx sdk.Context, proposalID types.ProposalKey) types.Event {
+	event := ExpireProposalDepositEvent{
+		ProposalID: proposalID,
+	}
+	return event
+}
+
 // CreateParamChangeEvent - create a parameter change event
 func (pm ProposalManager) CreateParamChangeEvent(
 	ctx sdk.Context, proposalID types.ProposalKey) (types.Event, sdk.Error) {
@@ -252,7 +706,217 @@ func (pm ProposalManager) GetPermlink(ctx sdk.Context, proposalID types.Proposal
 	return p.Permlink, nil
 }
 
+// GetRevision - get censored revision from expired proposal list
+func (pm ProposalManager) GetRevision(ctx sdk.Context, proposalID types.ProposalKey) (int64, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return 0, err
+	}
+
+	p, ok := proposal.(*model.ContentCensorshipProposal)
+	if !ok {
+		return 0, ErrIncorrectProposalType()
+	}
+	return p.Revision, nil
+}
+
+// GetContentCensorshipProposal - get content censorship proposal from expired proposal list
+func (pm ProposalManager) GetContentCensorshipProposal(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.ContentCensorshipProposal, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := proposal.(*model.ContentCensorshipProposal)
+	if !ok {
+		return nil, ErrIncorrectProposalType()
+	}
+	return p, nil
+}
+
+// GetContentAppealProposal - get content appeal proposal from expired proposal list
+func (pm ProposalManager) GetContentAppealProposal(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.ContentAppealProposal, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := proposal.(*model.ContentAppealProposal)
+	if !ok {
+		return nil, ErrIncorrectProposalType()
+	}
+	return p, nil
+}
+
+// IsContentAppealed - check if a content censorship is appealed,
+// an appeal which didn't meet minimum deposit doesn't count
+func (pm ProposalManager) IsContentAppealed(
+	ctx sdk.Context, censorshipID types.ProposalKey) (bool, sdk.Error) {
+	appeal, err := pm.storage.GetContentAppeal(ctx, censorshipID)
+	if err != nil {
+		return false, err
+	}
+	if appeal == nil {
+		return false, nil
+	}
+	if pm.IsDepositingProposal(ctx, appeal.AppealID) || pm.IsOngoingProposal(ctx, appeal.AppealID) {
+		return true, nil
+	}
+	proposal, err := pm.storage.GetExpiredProposal(ctx, appeal.AppealID)
+	if err != nil {
+		return false, err
+	}
+	return proposal.GetProposalInfo().Result != types.ProposalDepositNotMet, nil
+}
+
+// SetContentAppeal - link a content censorship to the appeal against it
+func (pm ProposalManager) SetContentAppeal(
+	ctx sdk.Context, censorshipID, appealID types.ProposalKey) sdk.Error {
+	return pm.storage.SetContentAppeal(ctx, &model.ContentAppeal{
+		CensorshipID: censorshipID,
+		AppealID:     appealID,
+	})
+}
+
+// GetAccountFreezeProposal - get account freeze proposal from expired proposal list
+func (pm ProposalManager) GetAccountFreezeProposal(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.AccountFreezeProposal, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := proposal.(*model.AccountFreezeProposal)
+	if !ok {
+		return nil, ErrIncorrectProposalType()
+	}
+	return p, nil
+}
+
+// GetAccountUnfreezeProposal - get account unfreeze proposal from expired proposal list
+func (pm ProposalManager) GetAccountUnfreezeProposal(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.AccountUnfreezeProposal, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := proposal.(*model.AccountUnfreezeProposal)
+	if !ok {
+		return nil, ErrIncorrectProposalType()
+	}
+	return p, nil
+}
+
+// FreezeAccount - freeze an account for AccountFreezeSec from now,
+// freezing a frozen account restarts its freeze period
+func (pm ProposalManager) FreezeAccount(
+	ctx sdk.Context, username types.AccountKey, proposalID types.ProposalKey) sdk.Error {
+	param, err := pm.paramHolder.GetProposalParam(ctx)
+	if err != nil {
+		return err
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	return pm.storage.SetAccountFreeze(ctx, &model.AccountFreeze{
+		Username:    username,
+		ProposalID:  proposalID,
+		FrozenAt:    now,
+		FrozenUntil: now + param.AccountFreezeSec,
+	})
+}
+
+// UnfreezeAccount - lift the freeze of an account, do nothing if account is not frozen
+func (pm ProposalManager) UnfreezeAccount(ctx sdk.Context, username types.AccountKey) {
+	pm.storage.DeleteAccountFreeze(ctx, username)
+}
+
+// GetAccountFreeze - get freeze of an account, return nil if account is not frozen
+func (pm ProposalManager) GetAccountFreeze(
+	ctx sdk.Context, username types.AccountKey) (*model.AccountFreeze, sdk.Error) {
+	freeze, err := pm.storage.GetAccountFreeze(ctx, username)
+	if err != nil || freeze == nil {
+		return nil, err
+	}
+	if freeze.FrozenUntil <= ctx.BlockHeader().Time.Unix() {
+		return nil, nil
+	}
+	return freeze, nil
+}
+
+// IsAccountFrozen - check if an account is frozen now
+func (pm ProposalManager) IsAccountFrozen(ctx sdk.Context, username types.AccountKey) (bool, sdk.Error) {
+	freeze, err := pm.GetAccountFreeze(ctx, username)
+	if err != nil {
+		return false, err
+	}
+	return freeze != nil, nil
+}
+
+// GetAccountFreezes - get freezes of all accounts which are frozen now
+func (pm ProposalManager) GetAccountFreezes(ctx sdk.Context) ([]model.AccountFreeze, sdk.Error) {
+	freezes, err := pm.storage.GetAccountFreezes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	res := []model.AccountFreeze{}
+	for _, freeze := range freezes {
+		if freeze.FrozenUntil > now {
+			res = append(res, freeze)
+		}
+	}
+	return res, nil
+}
+
+// GetTreasurySpendProposal - get treasury spend proposal from expired proposal list
+func (pm ProposalManager) GetTreasurySpendProposal(
+	ctx sdk.Context, proposalID types.ProposalKey) (*model.TreasurySpendProposal, sdk.Error) {
+	proposal, err := pm.storage.GetExpiredProposal(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	p, ok := proposal.(*model.TreasurySpendProposal)
+	if !ok {
+		return nil, ErrIncorrectProposalType()
+	}
+	return p, nil
+}
+
+// RecordTreasurySpend - record treasury spend of a passed proposal,
+// skipped is true if the spend wasn't paid because treasury pool is short
+func (pm ProposalManager) RecordTreasurySpend(
+	ctx sdk.Context, proposal *model.TreasurySpendProposal, skipped bool) sdk.Error {
+	spend := &model.TreasurySpend{
+		ProposalID:  proposal.ProposalID,
+		Recipient:   proposal.Recipient,
+		Amount:      proposal.Amount,
+		Times:       proposal.Times,
+		IntervalSec: proposal.IntervalSec,
+		SpentAt:     ctx.BlockHeader().Time.Unix(),
+		Skipped:     skipped,
+	}
+	return pm.storage.SetTreasurySpend(ctx, spend)
+}
+
+// GetTreasurySpends - get all past treasury spends
+func (pm ProposalManager) GetTreasurySpends(ctx sdk.Context) ([]model.TreasurySpend, sdk.Error) {
+	return pm.storage.GetTreasurySpends(ctx)
+}
+
 // GetOngoingProposalList - get ongoing proposal list
 func (pm ProposalManager) GetOngoingProposalList(ctx sdk.Context) ([]model.Proposal, sdk.Error) {
 	return pm.storage.GetOngoingProposalList(ctx)
 }
+
+// Export storage state.
+func (pm ProposalManager) Export(ctx sdk.Context) *model.ProposalTables {
+	return pm.storage.Export(ctx)
+}
+
+// Import storage state.
+func (pm ProposalManager) Import(ctx sdk.Context, tb *model.ProposalTablesIR) {
+	pm.storage.Import(ctx, tb)
+}
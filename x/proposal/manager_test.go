@@ -4,9 +4,13 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lino-network/lino/param"
 	"github.com/lino-network/lino/types"
 	"github.com/lino-network/lino/x/proposal/model"
 	"github.com/stretchr/testify/assert"
+
+	valmodel "github.com/lino-network/lino/x/validator/model"
+	votemodel "github.com/lino-network/lino/x/vote/model"
 )
 
 func TestUpdateProposalVotingStatus(t *testing.T) {
@@ -22,28 +26,33 @@ func TestUpdateProposalVotingStatus(t *testing.T) {
 	pm.InitGenesis(ctx)
 	curTime := ctx.BlockHeader().Time.Unix()
 	decideSec := int64(100)
-	proposalID1, _ := pm.AddProposal(ctx, user1, proposal1, decideSec)
+	proposalID1, _ := pm.AddProposal(ctx, user1, proposal1, decideSec, types.NewCoinFromInt64(0))
 
 	testCases := []struct {
 		testName     string
 		proposalID   types.ProposalKey
 		voter        types.AccountKey
-		voteResult   bool
+		voteOption   types.VoteOption
 		votingPower  types.Coin
+		prevVote     *votemodel.Vote
 		wantProposal model.Proposal
 	}{
 		{
 			testName:    "agree vote",
 			proposalID:  proposalID1,
 			voter:       user1,
-			voteResult:  true,
+			voteOption:  types.VoteOptionYes,
 			votingPower: types.NewCoinFromInt64(1),
+			prevVote:    nil,
 			wantProposal: &model.ContentCensorshipProposal{
 				ProposalInfo: model.ProposalInfo{
 					Creator:       user1,
 					ProposalID:    proposalID1,
 					AgreeVotes:    types.NewCoinFromInt64(1),
 					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       types.NewCoinFromInt64(0),
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
 				},
@@ -54,14 +63,18 @@ func TestUpdateProposalVotingStatus(t *testing.T) {
 			testName:    "one more agree vote",
 			proposalID:  proposalID1,
 			voter:       user1,
-			voteResult:  true,
+			voteOption:  types.VoteOptionYes,
 			votingPower: types.NewCoinFromInt64(2),
+			prevVote:    nil,
 			wantProposal: &model.ContentCensorshipProposal{
 				ProposalInfo: model.ProposalInfo{
 					Creator:       user1,
 					ProposalID:    proposalID1,
 					AgreeVotes:    types.NewCoinFromInt64(3),
 					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       types.NewCoinFromInt64(0),
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
 				},
@@ -72,14 +85,106 @@ func TestUpdateProposalVotingStatus(t *testing.T) {
 			testName:    "one disagree vote",
 			proposalID:  proposalID1,
 			voter:       user1,
-			voteResult:  false,
+			voteOption:  types.VoteOptionNo,
 			votingPower: types.NewCoinFromInt64(5),
+			prevVote:    nil,
 			wantProposal: &model.ContentCensorshipProposal{
 				ProposalInfo: model.ProposalInfo{
 					Creator:       user1,
 					ProposalID:    proposalID1,
 					AgreeVotes:    types.NewCoinFromInt64(3),
 					DisagreeVotes: types.NewCoinFromInt64(5),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       types.NewCoinFromInt64(0),
+					CreatedAt:     curTime,
+					ExpiredAt:     curTime + decideSec,
+				},
+				Permlink: permlink,
+				Reason:   censorshipReason},
+		},
+		{
+			testName:    "one abstain vote",
+			proposalID:  proposalID1,
+			voter:       user1,
+			voteOption:  types.VoteOptionAbstain,
+			votingPower: types.NewCoinFromInt64(7),
+			prevVote:    nil,
+			wantProposal: &model.ContentCensorshipProposal{
+				ProposalInfo: model.ProposalInfo{
+					Creator:       user1,
+					ProposalID:    proposalID1,
+					AgreeVotes:    types.NewCoinFromInt64(3),
+					DisagreeVotes: types.NewCoinFromInt64(5),
+					AbstainVotes:  types.NewCoinFromInt64(7),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       types.NewCoinFromInt64(0),
+					CreatedAt:     curTime,
+					ExpiredAt:     curTime + decideSec,
+				},
+				Permlink: permlink,
+				Reason:   censorshipReason},
+		},
+		{
+			testName:    "one no with veto vote",
+			proposalID:  proposalID1,
+			voter:       user1,
+			voteOption:  types.VoteOptionNoWithVeto,
+			votingPower: types.NewCoinFromInt64(11),
+			prevVote:    nil,
+			wantProposal: &model.ContentCensorshipProposal{
+				ProposalInfo: model.ProposalInfo{
+					Creator:       user1,
+					ProposalID:    proposalID1,
+					AgreeVotes:    types.NewCoinFromInt64(3),
+					DisagreeVotes: types.NewCoinFromInt64(16),
+					AbstainVotes:  types.NewCoinFromInt64(7),
+					VetoVotes:     types.NewCoinFromInt64(11),
+					Deposit:       types.NewCoinFromInt64(0),
+					CreatedAt:     curTime,
+					ExpiredAt:     curTime + decideSec,
+				},
+				Permlink: permlink,
+				Reason:   censorshipReason},
+		},
+		{
+			testName:    "change agree vote to no with veto",
+			proposalID:  proposalID1,
+			voter:       user1,
+			voteOption:  types.VoteOptionNoWithVeto,
+			votingPower: types.NewCoinFromInt64(2),
+			prevVote:    &votemodel.Vote{Voter: user1, VotingPower: types.NewCoinFromInt64(2), Option: types.VoteOptionYes},
+			wantProposal: &model.ContentCensorshipProposal{
+				ProposalInfo: model.ProposalInfo{
+					Creator:       user1,
+					ProposalID:    proposalID1,
+					AgreeVotes:    types.NewCoinFromInt64(1),
+					DisagreeVotes: types.NewCoinFromInt64(18),
+					AbstainVotes:  types.NewCoinFromInt64(7),
+					VetoVotes:     types.NewCoinFromInt64(13),
+					Deposit:       types.NewCoinFromInt64(0),
+					CreatedAt:     curTime,
+					ExpiredAt:     curTime + decideSec,
+				},
+				Permlink: permlink,
+				Reason:   censorshipReason},
+		},
+		{
+			testName:    "change no with veto vote to abstain",
+			proposalID:  proposalID1,
+			voter:       user1,
+			voteOption:  types.VoteOptionAbstain,
+			votingPower: types.NewCoinFromInt64(11),
+			prevVote:    &votemodel.Vote{Voter: user1, VotingPower: types.NewCoinFromInt64(11), Option: types.VoteOptionNoWithVeto},
+			wantProposal: &model.ContentCensorshipProposal{
+				ProposalInfo: model.ProposalInfo{
+					Creator:       user1,
+					ProposalID:    proposalID1,
+					AgreeVotes:    types.NewCoinFromInt64(1),
+					DisagreeVotes: types.NewCoinFromInt64(7),
+					AbstainVotes:  types.NewCoinFromInt64(18),
+					VetoVotes:     types.NewCoinFromInt64(2),
+					Deposit:       types.NewCoinFromInt64(0),
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
 				},
@@ -88,7 +193,8 @@ func TestUpdateProposalVotingStatus(t *testing.T) {
 		},
 	}
 	for _, tc := range testCases {
-		err := pm.UpdateProposalVotingStatus(ctx, tc.proposalID, tc.voter, tc.voteResult, tc.votingPower)
+		err := pm.UpdateProposalVotingStatus(
+			ctx, tc.proposalID, tc.voter, tc.voteOption, tc.votingPower, tc.prevVote)
 		if err != nil {
 			t.Errorf("%s: failed to update proposal voting status, got err %v", tc.testName, err)
 		}
@@ -103,6 +209,37 @@ func TestUpdateProposalVotingStatus(t *testing.T) {
 	}
 }
 
+func TestRetallyProposalVotes(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 0)
+	user1, user2, user3 := types.AccountKey("user1"), types.AccountKey("user2"), types.AccountKey("user3")
+	proposal1 := &model.ContentCensorshipProposal{
+		Permlink: types.Permlink("permlink"),
+		Reason:   "reason",
+	}
+
+	pm.InitGenesis(ctx)
+	proposalID1, _ := pm.AddProposal(ctx, user1, proposal1, 100, types.NewCoinFromInt64(0))
+	err := pm.UpdateProposalVotingStatus(
+		ctx, proposalID1, user1, types.VoteOptionYes, types.NewCoinFromInt64(100), nil)
+	assert.Nil(t, err)
+
+	votes := []votemodel.Vote{
+		{Voter: user1, VotingPower: types.NewCoinFromInt64(40), Option: types.VoteOptionYes},
+		{Voter: user2, VotingPower: types.NewCoinFromInt64(30), Option: types.VoteOptionNoWithVeto},
+		{Voter: user3, VotingPower: types.NewCoinFromInt64(20), Option: types.VoteOptionAbstain},
+	}
+	err = pm.RetallyProposalVotes(ctx, proposalID1, votes)
+	assert.Nil(t, err)
+
+	proposal, err := pm.storage.GetOngoingProposal(ctx, proposalID1)
+	assert.Nil(t, err)
+	proposalInfo := proposal.GetProposalInfo()
+	assert.Equal(t, types.NewCoinFromInt64(40), proposalInfo.AgreeVotes)
+	assert.Equal(t, types.NewCoinFromInt64(30), proposalInfo.DisagreeVotes)
+	assert.Equal(t, types.NewCoinFromInt64(20), proposalInfo.AbstainVotes)
+	assert.Equal(t, types.NewCoinFromInt64(30), proposalInfo.VetoVotes)
+}
+
 func TestUpdateProposalPassStatus(t *testing.T) {
 	ctx, _, pm, _, _, _, _ := setupTest(t, 100000000)
 	permlink := types.Permlink("permlink")
@@ -122,19 +259,40 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 		Permlink: permlink,
 		Reason:   censorshipReason,
 	}
+
+	proposal4 := &model.ContentCensorshipProposal{
+		Permlink: permlink,
+		Reason:   censorshipReason,
+	}
+
+	proposal5 := &model.ContentCensorshipProposal{
+		Permlink: permlink,
+		Reason:   censorshipReason,
+	}
+
+	proposal6 := &model.ContentCensorshipProposal{
+		Permlink: permlink,
+		Reason:   censorshipReason,
+	}
 	pm.InitGenesis(ctx)
 	curTime := ctx.BlockHeader().Time.Unix()
 	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
 	decideSec := proposalParam.ContentCensorshipDecideSec
 
-	proposalID1, _ := pm.AddProposal(ctx, user1, proposal1, decideSec)
-	proposalID2, _ := pm.AddProposal(ctx, user1, proposal2, decideSec)
-	proposalID3, _ := pm.AddProposal(ctx, user1, proposal3, decideSec)
+	deposit := proposalParam.ContentCensorshipMinDeposit
+	proposalID1, _ := pm.AddProposal(ctx, user1, proposal1, decideSec, deposit)
+	proposalID2, _ := pm.AddProposal(ctx, user1, proposal2, decideSec, deposit)
+	proposalID3, _ := pm.AddProposal(ctx, user1, proposal3, decideSec, deposit)
+	proposalID4, _ := pm.AddProposal(ctx, user1, proposal4, decideSec, deposit)
+	proposalID5, _ := pm.AddProposal(ctx, user1, proposal5, decideSec, deposit)
+	proposalID6, _ := pm.AddProposal(ctx, user1, proposal6, decideSec, deposit)
 
 	testCases := []struct {
 		testName        string
 		agreeVotes      types.Coin
 		disagreeVotes   types.Coin
+		abstainVotes    types.Coin
+		vetoVotes       types.Coin
 		proposalType    types.ProposalType
 		proposalID      types.ProposalKey
 		wantProposalRes types.ProposalResult
@@ -144,6 +302,8 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 			testName:        "test passed proposal has historical data",
 			agreeVotes:      proposalParam.ContentCensorshipPassVotes,
 			disagreeVotes:   proposalParam.ContentCensorshipPassVotes,
+			abstainVotes:    types.NewCoinFromInt64(0),
+			vetoVotes:       types.NewCoinFromInt64(0),
 			proposalType:    types.ContentCensorship,
 			proposalID:      proposalID1,
 			wantProposalRes: types.ProposalNotPass,
@@ -153,6 +313,9 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 					ProposalID:    proposalID1,
 					AgreeVotes:    proposalParam.ContentCensorshipPassVotes,
 					DisagreeVotes: proposalParam.ContentCensorshipPassVotes,
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       deposit,
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -165,6 +328,8 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 			testName:        "test votes don't meet min requirement ",
 			agreeVotes:      proposalParam.ContentCensorshipPassVotes.Minus(types.NewCoinFromInt64(10)),
 			disagreeVotes:   types.NewCoinFromInt64(0),
+			abstainVotes:    types.NewCoinFromInt64(0),
+			vetoVotes:       types.NewCoinFromInt64(0),
 			proposalType:    types.ContentCensorship,
 			proposalID:      proposalID2,
 			wantProposalRes: types.ProposalNotPass,
@@ -174,6 +339,9 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 					ProposalID:    proposalID2,
 					AgreeVotes:    proposalParam.ContentCensorshipPassVotes.Minus(types.NewCoinFromInt64(10)),
 					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       deposit,
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -186,6 +354,8 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 			testName:        "test votes ratio doesn't meet requirement ",
 			agreeVotes:      proposalParam.ContentCensorshipPassVotes.Plus(types.NewCoinFromInt64(10)),
 			disagreeVotes:   proposalParam.ContentCensorshipPassVotes.Plus(types.NewCoinFromInt64(11)),
+			abstainVotes:    types.NewCoinFromInt64(0),
+			vetoVotes:       types.NewCoinFromInt64(0),
 			proposalType:    types.ContentCensorship,
 			proposalID:      proposalID3,
 			wantProposalRes: types.ProposalNotPass,
@@ -195,6 +365,9 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 					ProposalID:    proposalID3,
 					AgreeVotes:    proposalParam.ContentCensorshipPassVotes.Plus(types.NewCoinFromInt64(10)),
 					DisagreeVotes: proposalParam.ContentCensorshipPassVotes.Plus(types.NewCoinFromInt64(11)),
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       deposit,
 					Result:        types.ProposalNotPass,
 					CreatedAt:     curTime,
 					ExpiredAt:     curTime + decideSec,
@@ -202,9 +375,87 @@ func TestUpdateProposalPassStatus(t *testing.T) {
 				Permlink: permlink,
 				Reason:   censorshipReason},
 		},
+		{
+			testName:        "test abstain votes count in quorum",
+			agreeVotes:      proposalParam.ContentCensorshipPassVotes.Minus(types.NewCoinFromInt64(10)),
+			disagreeVotes:   types.NewCoinFromInt64(0),
+			abstainVotes:    types.NewCoinFromInt64(20),
+			vetoVotes:       types.NewCoinFromInt64(0),
+			proposalType:    types.ContentCensorship,
+			proposalID:      proposalID4,
+			wantProposalRes: types.ProposalPass,
+			wantProposal: &model.ContentCensorshipProposal{
+				ProposalInfo: model.ProposalInfo{
+					Creator:       user1,
+					ProposalID:    proposalID4,
+					AgreeVotes:    proposalParam.ContentCensorshipPassVotes.Minus(types.NewCoinFromInt64(10)),
+					DisagreeVotes: types.NewCoinFromInt64(0),
+					AbstainVotes:  types.NewCoinFromInt64(20),
+					VetoVotes:     types.NewCoinFromInt64(0),
+					Deposit:       deposit,
+					Result:        types.ProposalPass,
+					CreatedAt:     curTime,
+					ExpiredAt:     curTime + decideSec,
+				},
+				Permlink: permlink,
+				Reason:   censorshipReason},
+		},
+
+		{
+			testName:        "test veto votes exceed veto ratio",
+			agreeVotes:      proposalParam.ContentCensorshipPassVotes,
+			disagreeVotes:   proposalParam.ContentCensorshipPassVotes,
+			abstainVotes:    types.NewCoinFromInt64(0),
+			vetoVotes:       proposalParam.ContentCensorshipPassVotes,
+			proposalType:    types.ContentCensorship,
+			proposalID:      proposalID5,
+			wantProposalRes: types.ProposalVetoed,
+			wantProposal: &model.ContentCensorshipProposal{
+				ProposalInfo: model.ProposalInfo{
+					Creator:       user1,
+					ProposalID:    proposalID5,
+					AgreeVotes:    proposalParam.ContentCensorshipPassVotes,
+					DisagreeVotes: proposalParam.ContentCensorshipPassVotes,
+					AbstainVotes:  types.NewCoinFromInt64(0),
+					VetoVotes:     proposalParam.ContentCensorshipPassVotes,
+					Deposit:       deposit,
+					Result:        types.ProposalVetoed,
+					CreatedAt:     curTime,
+					ExpiredAt:     curTime + decideSec,
+				},
+				Permlink: permlink,
+				Reason:   censorshipReason},
+		},
+
+		{
+			testName:        "test veto votes below veto ratio",
+			agreeVotes:      proposalParam.ContentCensorshipPassVotes.Plus(proposalParam.ContentCensorshipPassVotes),
+			disagreeVotes:   proposalParam.ContentCensorshipPassVotes,
+			abstainVotes:    proposalParam.ContentCensorshipPassVotes,
+			vetoVotes:       proposalParam.ContentCensorshipPassVotes,
+			proposalType:    types.ContentCensorship,
+			proposalID:      proposalID6,
+			wantProposalRes: types.ProposalPass,
+			wantProposal: &model.ContentCensorshipProposal{
+				ProposalInfo: model.ProposalInfo{
+					Creator:       user1,
+					ProposalID:    proposalID6,
+					AgreeVotes:    proposalParam.ContentCensorshipPassVotes.Plus(proposalParam.ContentCensorshipPassVotes),
+					DisagreeVotes: proposalParam.ContentCensorshipPassVotes,
+					AbstainVotes:  proposalParam.ContentCensorshipPassVotes,
+					VetoVotes:     proposalParam.ContentCensorshipPassVotes,
+					Deposit:       deposit,
+					Result:        types.ProposalPass,
+					CreatedAt:     curTime,
+					ExpiredAt:     curTime + decideSec,
+				},
+				Permlink: permlink,
+				Reason:   censorshipReason},
+		},
 	}
 	for _, tc := range testCases {
-		err := addProposalInfo(ctx, pm, tc.proposalID, tc.agreeVotes, tc.disagreeVotes)
+		err := addProposalInfo(
+			ctx, pm, tc.proposalID, tc.agreeVotes, tc.disagreeVotes, tc.abstainVotes, tc.vetoVotes)
 		if err != nil {
 			t.Errorf("%s: failed to add proposal info, got err %v", tc.testName, err)
 		}
@@ -267,6 +518,38 @@ func TestGetProposalPassParam(t *testing.T) {
 			wantPassVotes: proposalParam.ProtocolUpgradePassVotes,
 		},
 
+		{
+			testName:      "test pass param for treasurySpendProposal",
+			proposalType:  types.TreasurySpend,
+			wantError:     nil,
+			wantPassRatio: proposalParam.TreasurySpendPassRatio,
+			wantPassVotes: proposalParam.TreasurySpendPassVotes,
+		},
+
+		{
+			testName:      "test pass param for contentAppealProposal",
+			proposalType:  types.ContentAppeal,
+			wantError:     nil,
+			wantPassRatio: proposalParam.ContentAppealPassRatio,
+			wantPassVotes: proposalParam.ContentAppealPassVotes,
+		},
+
+		{
+			testName:      "test pass param for accountFreezeProposal",
+			proposalType:  types.AccountFreeze,
+			wantError:     nil,
+			wantPassRatio: proposalParam.AccountFreezePassRatio,
+			wantPassVotes: proposalParam.AccountFreezePassVotes,
+		},
+
+		{
+			testName:      "test pass param for accountUnfreezeProposal",
+			proposalType:  types.AccountUnfreeze,
+			wantError:     nil,
+			wantPassRatio: proposalParam.AccountFreezePassRatio,
+			wantPassVotes: proposalParam.AccountFreezePassVotes,
+		},
+
 		{
 			testName:      "test wrong proposal type",
 			proposalType:  23,
@@ -293,3 +576,251 @@ func TestGetProposalPassParam(t *testing.T) {
 	}
 
 }
+
+func TestGetProposalList(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+	user1, user2 := types.AccountKey("user1"), types.AccountKey("user2")
+	zero := types.NewCoinFromInt64(0)
+
+	id1, _ := pm.AddProposal(
+		ctx, user1, pm.CreateContentCensorshipProposal(ctx, "permlink", 0, ""), 100, zero)
+	id2, _ := pm.AddProposal(
+		ctx, user2, pm.CreateChangeParamProposal(ctx, param.GlobalAllocationParam{}, ""), 100, zero)
+	id3, _ := pm.AddProposal(
+		ctx, user1, pm.CreateProtocolUpgradeProposal(ctx, "link", ""), 100, zero)
+	id4, _ := pm.AddProposal(
+		ctx, user1, pm.CreateContentCensorshipProposal(ctx, "permlink", 0, ""), 100, zero)
+	id5, _ := pm.AddProposal(
+		ctx, user2, pm.CreateChangeParamProposal(ctx, param.GlobalAllocationParam{}, ""), 100, zero)
+	_, err := pm.UpdateProposalPassStatus(ctx, types.ContentCensorship, id4)
+	assert.Nil(t, err)
+	err = addProposalInfo(
+		ctx, pm, id5, proposalParam.ChangeParamPassVotes.Plus(types.NewCoinFromInt64(1)), zero, zero, zero)
+	assert.Nil(t, err)
+	res, err := pm.UpdateProposalPassStatus(ctx, types.ChangeParam, id5)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ProposalPass, res)
+
+	changeParam := types.ChangeParam
+	pass := types.ProposalPass
+	testCases := []struct {
+		testName  string
+		isExpired bool
+		filter    ProposalFilter
+		offset    int64
+		limit     int64
+		wantIDs   []types.ProposalKey
+	}{
+		{
+			testName: "all ongoing proposals",
+			limit:    10,
+			wantIDs:  []types.ProposalKey{id1, id2, id3},
+		},
+		{
+			testName: "ongoing proposals with limit",
+			limit:    2,
+			wantIDs:  []types.ProposalKey{id1, id2},
+		},
+		{
+			testName: "ongoing proposals created by user1",
+			filter:   ProposalFilter{Creator: user1},
+			limit:    10,
+			wantIDs:  []types.ProposalKey{id1, id3},
+		},
+		{
+			testName: "ongoing proposals created by user1 with offset",
+			filter:   ProposalFilter{Creator: user1},
+			offset:   1,
+			limit:    10,
+			wantIDs:  []types.ProposalKey{id3},
+		},
+		{
+			testName: "ongoing change param proposals",
+			filter:   ProposalFilter{ProposalType: &changeParam},
+			limit:    10,
+			wantIDs:  []types.ProposalKey{id2},
+		},
+		{
+			testName: "offset exceeds number of proposals",
+			offset:   3,
+			limit:    10,
+			wantIDs:  []types.ProposalKey{},
+		},
+		{
+			testName:  "all expired proposals",
+			isExpired: true,
+			limit:     10,
+			wantIDs:   []types.ProposalKey{id4, id5},
+		},
+		{
+			testName:  "passed expired proposals",
+			isExpired: true,
+			filter:    ProposalFilter{Result: &pass},
+			limit:     10,
+			wantIDs:   []types.ProposalKey{id5},
+		},
+	}
+	for _, tc := range testCases {
+		proposals, err := pm.GetProposalList(ctx, tc.isExpired, tc.filter, tc.offset, tc.limit)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal list, got err %v", tc.testName, err)
+		}
+		ids := []types.ProposalKey{}
+		for _, proposal := range proposals {
+			ids = append(ids, proposal.GetProposalInfo().ProposalID)
+		}
+		if !assert.Equal(t, tc.wantIDs, ids) {
+			t.Errorf("%s: diff proposals, got %v, want %v", tc.testName, ids, tc.wantIDs)
+		}
+	}
+}
+
+func TestGetProposalTally(t *testing.T) {
+	ctx, _, pm, _, _, _, _ := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+	proposalParam, _ := pm.paramHolder.GetProposalParam(ctx)
+	user1, user2 := types.AccountKey("user1"), types.AccountKey("user2")
+	zero := types.NewCoinFromInt64(0)
+	one := types.NewCoinFromInt64(1)
+	passVotes := proposalParam.ContentCensorshipPassVotes
+
+	ongoingID, _ := pm.AddProposal(
+		ctx, user1, pm.CreateContentCensorshipProposal(ctx, "permlink", 0, ""), 100, zero)
+	expiredID, _ := pm.AddProposal(
+		ctx, user1, pm.CreateContentCensorshipProposal(ctx, "permlink", 0, ""), 100, zero)
+	_, err := pm.UpdateProposalPassStatus(ctx, types.ContentCensorship, expiredID)
+	assert.Nil(t, err)
+	depositingID, _ := pm.AddDepositingProposal(
+		ctx, user1, pm.CreateContentCensorshipProposal(ctx, "permlink", 0, ""))
+
+	votes := []votemodel.Vote{
+		{Voter: user1, VotingPower: passVotes, Option: types.VoteOptionYes},
+		{Voter: user2, VotingPower: one, Option: types.VoteOptionNoWithVeto},
+	}
+	totalVotes := passVotes.Plus(one)
+
+	testCases := []struct {
+		testName   string
+		proposalID types.ProposalKey
+		wantErr    sdk.Error
+		wantTally  *ProposalTally
+	}{
+		{
+			testName:   "tally of ongoing proposal is computed from votes",
+			proposalID: ongoingID,
+			wantTally: &ProposalTally{
+				ProposalID:    ongoingID,
+				ProposalType:  types.ContentCensorship,
+				IsOngoing:     true,
+				AgreeVotes:    passVotes,
+				DisagreeVotes: one,
+				AbstainVotes:  zero,
+				VetoVotes:     one,
+				TotalVotes:    totalVotes,
+				AgreeRatio:    passVotes.ToDec().Quo(totalVotes.ToDec()),
+				VetoRatio:     one.ToDec().Quo(totalVotes.ToDec()),
+				PassRatio:     proposalParam.ContentCensorshipPassRatio,
+				PassVotes:     passVotes,
+				VetoThreshold: proposalParam.ProposalVetoRatio,
+				Result:        types.ProposalPass,
+				TimeRemaining: 100,
+			},
+		},
+		{
+			testName:   "tally of expired proposal is the decided one",
+			proposalID: expiredID,
+			wantTally: &ProposalTally{
+				ProposalID:    expiredID,
+				ProposalType:  types.ContentCensorship,
+				IsOngoing:     false,
+				AgreeVotes:    zero,
+				DisagreeVotes: zero,
+				AbstainVotes:  zero,
+				VetoVotes:     zero,
+				TotalVotes:    zero,
+				AgreeRatio:    sdk.ZeroDec(),
+				VetoRatio:     sdk.ZeroDec(),
+				PassRatio:     proposalParam.ContentCensorshipPassRatio,
+				PassVotes:     passVotes,
+				VetoThreshold: proposalParam.ProposalVetoRatio,
+				Result:        types.ProposalNotPass,
+				TimeRemaining: 0,
+			},
+		},
+		{
+			testName:   "proposal in deposit period doesn't have tally",
+			proposalID: depositingID,
+			wantErr:    ErrNotOngoingProposal(),
+		},
+		{
+			testName:   "proposal doesn't exist",
+			proposalID: types.ProposalKey("100"),
+			wantErr:    model.ErrProposalNotFound(),
+		},
+	}
+	for _, tc := range testCases {
+		tally, err := pm.GetProposalTally(ctx, tc.proposalID, votes)
+		if !assert.Equal(t, tc.wantErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.wantErr)
+		}
+		if tc.wantErr != nil {
+			continue
+		}
+		if !assert.Equal(t, tc.wantTally, tally) {
+			t.Errorf("%s: diff tally, got %v, want %v", tc.testName, tally, tc.wantTally)
+		}
+	}
+}
+
+func TestGetPendingVotes(t *testing.T) {
+	ctx, am, pm, _, vm, valManager, _ := setupTest(t, 0)
+	pm.InitGenesis(ctx)
+	vm.InitGenesis(ctx)
+	user1 := createTestAccount(ctx, am, "user1", c4600)
+	user2 := createTestAccount(ctx, am, "user2", c4600)
+	user3 := createTestAccount(ctx, am, "user3", c4600)
+	vm.AddVoter(ctx, user1, c4600)
+	vm.AddVoter(ctx, user2, c4600)
+	vm.AddVoter(ctx, user3, c4600)
+	// user3 has a default vote to be cast when proposal is decided
+	err := vm.SetDefaultVoteOption(ctx, user3, types.VoteOptionYes)
+	assert.Nil(t, err)
+	err = valManager.SetValidatorList(ctx, &valmodel.ValidatorList{
+		OncallValidators: []types.AccountKey{user1, user2, user3},
+	})
+	assert.Nil(t, err)
+	zero := types.NewCoinFromInt64(0)
+
+	id1, _ := pm.AddProposal(
+		ctx, user1, pm.CreateChangeParamProposal(ctx, param.GlobalAllocationParam{}, ""), 100, zero)
+	id2, _ := pm.AddProposal(
+		ctx, user1, pm.CreateContentCensorshipProposal(ctx, "permlink", 0, ""), 200, zero)
+	id3, _ := pm.AddProposal(
+		ctx, user1, pm.CreateProtocolUpgradeProposal(ctx, "link", ""), 100, zero)
+	_, err = pm.UpdateProposalPassStatus(ctx, types.ProtocolUpgrade, id3)
+	assert.Nil(t, err)
+	_, err = vm.AddVote(ctx, id1, user1, types.VoteOptionYes)
+	assert.Nil(t, err)
+
+	// expired proposal is not listed
+	pendingVotes, err := pm.GetPendingVotes(ctx, vm, valManager)
+	assert.Nil(t, err)
+	assert.Equal(t, []PendingVotes{
+		{
+			ProposalID:        id1,
+			ProposalType:      types.ChangeParam,
+			ExpiredAt:         ctx.BlockHeader().Time.Unix() + 100,
+			VoteRequired:      true,
+			PendingValidators: []types.AccountKey{user2},
+		},
+		{
+			ProposalID:        id2,
+			ProposalType:      types.ContentCensorship,
+			ExpiredAt:         ctx.BlockHeader().Time.Unix() + 200,
+			VoteRequired:      false,
+			PendingValidators: []types.AccountKey{user1, user2},
+		},
+	}, pendingVotes)
+}
@@ -36,3 +36,43 @@ func ErrFailedToUnmarshalProposal(err error) sdk.Error {
 func ErrFailedToUnmarshalNextProposalID(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalNextProposalID, fmt.Sprintf("failed to unmarshal next proposal id: %s", err.Error()))
 }
+
+// ErrFailedToMarshalProposalDeposit - error if marshal proposal deposit failed
+func ErrFailedToMarshalProposalDeposit(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalDeposit, fmt.Sprintf("failed to marshal proposal deposit: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalProposalDeposit - error if unmarshal proposal deposit failed
+func ErrFailedToUnmarshalProposalDeposit(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalDeposit, fmt.Sprintf("failed to unmarshal proposal deposit: %s", err.Error()))
+}
+
+// ErrFailedToMarshalTreasurySpend - error if marshal treasury spend failed
+func ErrFailedToMarshalTreasurySpend(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalTreasurySpend, fmt.Sprintf("failed to marshal treasury spend: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalTreasurySpend - error if unmarshal treasury spend failed
+func ErrFailedToUnmarshalTreasurySpend(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalTreasurySpend, fmt.Sprintf("failed to unmarshal treasury spend: %s", err.Error()))
+}
+
+// ErrFailedToMarshalContentAppeal - error if marshal content appeal failed
+func ErrFailedToMarshalContentAppeal(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalContentAppeal, fmt.Sprintf("failed to marshal content appeal: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalContentAppeal - error if unmarshal content appeal failed
+func ErrFailedToUnmarshalContentAppeal(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalContentAppeal, fmt.Sprintf("failed to unmarshal content appeal: %s", err.Error()))
+}
+
+// ErrFailedToMarshalAccountFreeze - error if marshal account freeze failed
+func ErrFailedToMarshalAccountFreeze(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalAccountFreeze, fmt.Sprintf("failed to marshal account freeze: %s", err.Error()))
+}
+
+// ErrFailedToUnmarshalAccountFreeze - error if unmarshal account freeze failed
+func ErrFailedToUnmarshalAccountFreeze(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalAccountFreeze, fmt.Sprintf("failed to unmarshal account freeze: %s", err.Error()))
+}
@@ -0,0 +1,4 @@
+package model
+
+// ProposalTablesIR - same
+type ProposalTablesIR = ProposalTables
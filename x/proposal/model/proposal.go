@@ -5,16 +5,27 @@ import (
 	types "github.com/lino-network/lino/types"
 )
 
-// Proposal - there are three proposal types
+// Proposal - there are seven proposal types
 // 1) change parameter proposal
 // 2) content censorship proposal
 // 3) protocol upgrade proposal
+// 4) treasury spend proposal
+// 5) content appeal proposal
+// 6) account freeze proposal
+// 7) account unfreeze proposal
 type Proposal interface {
 	GetProposalInfo() ProposalInfo
 	SetProposalInfo(ProposalInfo)
 }
 
 // ProposalInfo - basic proposal info
+// AgreeVotes - voting power voted yes
+// DisagreeVotes - voting power voted no, including no with veto
+// AbstainVotes - voting power voted abstain, only counted in quorum
+// VetoVotes - voting power voted no with veto
+// Deposit - total deposit of all depositors, returned when decided unless vetoed
+// AbstainVotes, VetoVotes and Deposit are placed after the original fields
+// so that proposals stored before they were introduced still decode
 type ProposalInfo struct {
 	Creator       types.AccountKey     `json:"creator"`
 	ProposalID    types.ProposalKey    `json:"proposal_id"`
@@ -24,6 +35,22 @@ type ProposalInfo struct {
 	CreatedAt     int64                `json:"created_at"`
 	ExpiredAt     int64                `json:"expired_at"`
 	Reason        string               `json:"reason"`
+	AbstainVotes  types.Coin           `json:"abstain_vote"`
+	VetoVotes     types.Coin           `json:"veto_vote"`
+	Deposit       types.Coin           `json:"deposit"`
+}
+
+// fillMissingCoins - coins of proposal stored before they were introduced
+// decode as nil and are treated as zero
+func fillMissingCoins(proposal Proposal) Proposal {
+	info := proposal.GetProposalInfo()
+	for _, coin := range []*types.Coin{&info.AbstainVotes, &info.VetoVotes, &info.Deposit} {
+		if coin.IsNil() {
+			*coin = types.NewCoinFromInt64(0)
+		}
+	}
+	proposal.SetProposalInfo(info)
+	return proposal
 }
 
 // ChangeParamProposal - change parameter proposal
@@ -39,10 +66,12 @@ func (p *ChangeParamProposal) GetProposalInfo() ProposalInfo { return p.Proposal
 // SetProposalInfo - implements Proposal
 func (p *ChangeParamProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
-// ContentCensorshipProposal - content censorship proposal
+// ContentCensorshipProposal - content censorship proposal,
+// revision zero censors the whole post, otherwise only the given revision
 type ContentCensorshipProposal struct {
 	ProposalInfo
 	Permlink types.Permlink `json:"permlink"`
+	Revision int64          `json:"revision"`
 	Reason   string         `json:"reason"`
 }
 
@@ -65,7 +94,100 @@ func (p *ProtocolUpgradeProposal) GetProposalInfo() ProposalInfo { return p.Prop
 // SetProposalInfo - implements Proposal
 func (p *ProtocolUpgradeProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
 
+// TreasurySpendProposal - pay recipient from treasury pool once passed,
+// amount is paid at once if times is one, otherwise vested in equal parts every interval
+type TreasurySpendProposal struct {
+	ProposalInfo
+	Recipient   types.AccountKey `json:"recipient"`
+	Amount      types.Coin       `json:"amount"`
+	Times       int64            `json:"times"`
+	IntervalSec int64            `json:"interval_second"`
+	Reason      string           `json:"reason"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *TreasurySpendProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *TreasurySpendProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
+// ContentAppealProposal - appeal of post author against a passed content censorship,
+// censored content is restored once passed
+type ContentAppealProposal struct {
+	ProposalInfo
+	CensorshipID types.ProposalKey `json:"censorship_id"`
+	Permlink     types.Permlink    `json:"permlink"`
+	Revision     int64             `json:"revision"`
+	Reason       string            `json:"reason"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *ContentAppealProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *ContentAppealProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
+// AccountFreezeProposal - freeze outgoing transfers, donations and stake changes
+// of an account for a fixed period once passed
+type AccountFreezeProposal struct {
+	ProposalInfo
+	Username types.AccountKey `json:"username"`
+	Reason   string           `json:"reason"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *AccountFreezeProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *AccountFreezeProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
+// AccountUnfreezeProposal - lift the freeze of an account once passed
+type AccountUnfreezeProposal struct {
+	ProposalInfo
+	Username types.AccountKey `json:"username"`
+	Reason   string           `json:"reason"`
+}
+
+// GetProposalInfo - implements Proposal
+func (p *AccountUnfreezeProposal) GetProposalInfo() ProposalInfo { return p.ProposalInfo }
+
+// SetProposalInfo - implements Proposal
+func (p *AccountUnfreezeProposal) SetProposalInfo(info ProposalInfo) { p.ProposalInfo = info }
+
 // NextProposalID - store next proposal ID to KVStore
 type NextProposalID struct {
 	NextProposalID int64 `json:"next_proposal_id"`
 }
+
+// ProposalDeposit - deposit a depositor added to a proposal
+type ProposalDeposit struct {
+	Depositor types.AccountKey `json:"depositor"`
+	Amount    types.Coin       `json:"amount"`
+}
+
+// TreasurySpend - record of a treasury spend decided by a passed proposal,
+// Skipped is true if treasury pool couldn't cover the spend and nothing was paid
+type TreasurySpend struct {
+	ProposalID  types.ProposalKey `json:"proposal_id"`
+	Recipient   types.AccountKey  `json:"recipient"`
+	Amount      types.Coin        `json:"amount"`
+	Times       int64             `json:"times"`
+	IntervalSec int64             `json:"interval_second"`
+	SpentAt     int64             `json:"spent_at"`
+	Skipped     bool              `json:"skipped"`
+}
+
+// ContentAppeal - link from a content censorship proposal to the latest appeal against it
+type ContentAppeal struct {
+	CensorshipID types.ProposalKey `json:"censorship_id"`
+	AppealID     types.ProposalKey `json:"appeal_id"`
+}
+
+// AccountFreeze - freeze of an account set by a passed account freeze proposal,
+// the account is frozen until FrozenUntil
+type AccountFreeze struct {
+	Username    types.AccountKey  `json:"username"`
+	ProposalID  types.ProposalKey `json:"proposal_id"`
+	FrozenAt    int64             `json:"frozen_at"`
+	FrozenUntil int64             `json:"frozen_until"`
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"github.com/lino-network/lino/types"
+)
+
+// ProposalRow - pk: proposalID
+type ProposalRow struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Proposal   Proposal          `json:"proposal"`
+}
+
+// ProposalDepositRow - pk: (proposalID, depositor)
+type ProposalDepositRow struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Deposit    ProposalDeposit   `json:"deposit"`
+}
+
+// TreasurySpendRow - pk: proposalID
+type TreasurySpendRow struct {
+	ProposalID    types.ProposalKey `json:"proposal_id"`
+	TreasurySpend TreasurySpend     `json:"treasury_spend"`
+}
+
+// ContentAppealRow - pk: censorshipID
+type ContentAppealRow struct {
+	CensorshipID  types.ProposalKey `json:"censorship_id"`
+	ContentAppeal ContentAppeal     `json:"content_appeal"`
+}
+
+// AccountFreezeRow - pk: username
+type AccountFreezeRow struct {
+	Username      types.AccountKey `json:"username"`
+	AccountFreeze AccountFreeze    `json:"account_freeze"`
+}
+
+// NextProposalIDTable - no pk
+type NextProposalIDTable struct {
+	NextProposalID NextProposalID `json:"next_proposal_id"`
+}
+
+// ProposalTables - state of proposal
+type ProposalTables struct {
+	NextProposalID      NextProposalIDTable  `json:"next_proposal_id"`
+	OngoingProposals    []ProposalRow        `json:"ongoing_proposals"`
+	ExpiredProposals    []ProposalRow        `json:"expired_proposals"`
+	DepositingProposals []ProposalRow        `json:"depositing_proposals"`
+	ProposalDeposits    []ProposalDepositRow `json:"proposal_deposits"`
+	TreasurySpends      []TreasurySpendRow   `json:"treasury_spends"`
+	ContentAppeals      []ContentAppealRow   `json:"content_appeals"`
+	AccountFreezes      []AccountFreezeRow   `json:"account_freezes"`
+}
+
+// ToIR - same
+func (p ProposalTables) ToIR() ProposalTablesIR {
+	return p
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"strings"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/param"
@@ -8,9 +10,14 @@ import (
 )
 
 var (
-	nextProposalIDSubstore  = []byte{0x00}
-	ongoingProposalSubStore = []byte{0x01}
-	expiredProposalSubStore = []byte{0x02}
+	nextProposalIDSubstore     = []byte{0x00}
+	ongoingProposalSubStore    = []byte{0x01}
+	expiredProposalSubStore    = []byte{0x02}
+	depositingProposalSubStore = []byte{0x03}
+	proposalDepositSubStore    = []byte{0x04}
+	treasurySpendSubStore      = []byte{0x05}
+	contentAppealSubStore      = []byte{0x06}
+	accountFreezeSubStore      = []byte{0x07}
 )
 
 // ProposalStorage - proposal storage
@@ -21,11 +28,25 @@ type ProposalStorage struct {
 
 func NewProposalStorage(key sdk.StoreKey) ProposalStorage {
 	cdc := wire.New()
+	RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+	vs := ProposalStorage{
+		key: key,
+		cdc: cdc,
+	}
+	return vs
+}
 
+// RegisterWire - register proposal and parameter types on wire codec
+func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterInterface((*Proposal)(nil), nil)
 	cdc.RegisterConcrete(&ChangeParamProposal{}, "changeParam", nil)
 	cdc.RegisterConcrete(&ProtocolUpgradeProposal{}, "upgrade", nil)
 	cdc.RegisterConcrete(&ContentCensorshipProposal{}, "censorship", nil)
+	cdc.RegisterConcrete(&TreasurySpendProposal{}, "treasurySpend", nil)
+	cdc.RegisterConcrete(&ContentAppealProposal{}, "contentAppeal", nil)
+	cdc.RegisterConcrete(&AccountFreezeProposal{}, "accountFreeze", nil)
+	cdc.RegisterConcrete(&AccountUnfreezeProposal{}, "accountUnfreeze", nil)
 
 	cdc.RegisterInterface((*param.Parameter)(nil), nil)
 	cdc.RegisterConcrete(param.GlobalAllocationParam{}, "allocation", nil)
@@ -38,13 +59,6 @@ func NewProposalStorage(key sdk.StoreKey) ProposalStorage {
 	cdc.RegisterConcrete(param.BandwidthParam{}, "bandwidthParam", nil)
 	cdc.RegisterConcrete(param.AccountParam{}, "accountParam", nil)
 	cdc.RegisterConcrete(param.PostParam{}, "postParam", nil)
-
-	wire.RegisterCrypto(cdc)
-	vs := ProposalStorage{
-		key: key,
-		cdc: cdc,
-	}
-	return vs
 }
 
 // InitGenesis - initialize proposal storage
@@ -61,7 +75,41 @@ func (ps ProposalStorage) InitGenesis(ctx sdk.Context) sdk.Error {
 // DoesProposalExist - check if proposal exists in KVStore or not
 func (ps ProposalStorage) DoesProposalExist(ctx sdk.Context, proposalID types.ProposalKey) bool {
 	store := ctx.KVStore(ps.key)
-	return store.Has(GetOngoingProposalKey(proposalID)) || store.Has(GetExpiredProposalKey(proposalID))
+	return store.Has(GetOngoingProposalKey(proposalID)) ||
+		store.Has(GetExpiredProposalKey(proposalID)) ||
+		store.Has(GetDepositingProposalKey(proposalID))
+}
+
+// GetDepositingProposal - get proposal from depositing proposal KVStore
+func (ps ProposalStorage) GetDepositingProposal(ctx sdk.Context, proposalID types.ProposalKey) (Proposal, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	proposalByte := store.Get(GetDepositingProposalKey(proposalID))
+	if proposalByte == nil {
+		return nil, ErrProposalNotFound()
+	}
+	proposal := new(Proposal)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(proposalByte, proposal); err != nil {
+		return nil, ErrFailedToUnmarshalProposal(err)
+	}
+	return fillMissingCoins(*proposal), nil
+}
+
+// SetDepositingProposal - set proposal to depositing proposal KVStore
+func (ps ProposalStorage) SetDepositingProposal(ctx sdk.Context, proposalID types.ProposalKey, proposal Proposal) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	proposalByte, err := ps.cdc.MarshalBinaryLengthPrefixed(proposal)
+	if err != nil {
+		return ErrFailedToMarshalProposal(err)
+	}
+	store.Set(GetDepositingProposalKey(proposalID), proposalByte)
+	return nil
+}
+
+// DeleteDepositingProposal - delete proposal from depositing proposal KVStore
+func (ps ProposalStorage) DeleteDepositingProposal(ctx sdk.Context, proposalID types.ProposalKey) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	store.Delete(GetDepositingProposalKey(proposalID))
+	return nil
 }
 
 // GetOngoingProposal - get proposal from ongoing proposal KVStore
@@ -75,7 +123,7 @@ func (ps ProposalStorage) GetOngoingProposal(ctx sdk.Context, proposalID types.P
 	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(proposalByte, proposal); err != nil {
 		return nil, ErrFailedToUnmarshalProposal(err)
 	}
-	return *proposal, nil
+	return fillMissingCoins(*proposal), nil
 }
 
 // SetOngoingProposal - set proposal to ongoing proposal KVStore
@@ -107,7 +155,7 @@ func (ps ProposalStorage) GetExpiredProposal(ctx sdk.Context, proposalID types.P
 	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(proposalByte, proposal); err != nil {
 		return nil, ErrFailedToUnmarshalProposal(err)
 	}
-	return *proposal, nil
+	return fillMissingCoins(*proposal), nil
 }
 
 // SetExpiredProposal - set proposal to expired proposal KVStore
@@ -142,7 +190,7 @@ func (ps ProposalStorage) GetOngoingProposalList(ctx sdk.Context) ([]Proposal, s
 		if err != nil {
 			return nil, ErrFailedToMarshalProposal(err)
 		}
-		proposalList = append(proposalList, p)
+		proposalList = append(proposalList, fillMissingCoins(p))
 	}
 
 	return proposalList, nil
@@ -162,12 +210,183 @@ func (ps ProposalStorage) GetExpiredProposalList(ctx sdk.Context) ([]Proposal, s
 		if err != nil {
 			return nil, ErrFailedToUnmarshalProposal(err)
 		}
-		proposalList = append(proposalList, p)
+		proposalList = append(proposalList, fillMissingCoins(p))
+	}
+
+	return proposalList, nil
+}
+
+// GetDepositingProposalList - get depositing proposal list from depositing proposal KVStore
+func (ps ProposalStorage) GetDepositingProposalList(ctx sdk.Context) ([]Proposal, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iterator := store.Iterator(subspace(depositingProposalSubStore))
+	defer iterator.Close()
+
+	var proposalList []Proposal
+	for ; iterator.Valid(); iterator.Next() {
+		proposalBytes := iterator.Value()
+		var p Proposal
+		err := ps.cdc.UnmarshalBinaryLengthPrefixed(proposalBytes, &p)
+		if err != nil {
+			return nil, ErrFailedToUnmarshalProposal(err)
+		}
+		proposalList = append(proposalList, fillMissingCoins(p))
 	}
 
 	return proposalList, nil
 }
 
+// GetProposalDeposit - get deposit of a depositor to a proposal from KVStore,
+// return zero deposit if depositor doesn't deposit to the proposal
+func (ps ProposalStorage) GetProposalDeposit(
+	ctx sdk.Context, proposalID types.ProposalKey, depositor types.AccountKey) (*ProposalDeposit, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	depositByte := store.Get(GetProposalDepositKey(proposalID, depositor))
+	if depositByte == nil {
+		return &ProposalDeposit{Depositor: depositor, Amount: types.NewCoinFromInt64(0)}, nil
+	}
+	deposit := new(ProposalDeposit)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(depositByte, deposit); err != nil {
+		return nil, ErrFailedToUnmarshalProposalDeposit(err)
+	}
+	return deposit, nil
+}
+
+// SetProposalDeposit - set deposit of a depositor to a proposal to KVStore
+func (ps ProposalStorage) SetProposalDeposit(
+	ctx sdk.Context, proposalID types.ProposalKey, deposit *ProposalDeposit) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	depositByte, err := ps.cdc.MarshalBinaryLengthPrefixed(*deposit)
+	if err != nil {
+		return ErrFailedToMarshalProposalDeposit(err)
+	}
+	store.Set(GetProposalDepositKey(proposalID, deposit.Depositor), depositByte)
+	return nil
+}
+
+// GetProposalDeposits - get all deposits of a proposal from KVStore
+func (ps ProposalStorage) GetProposalDeposits(
+	ctx sdk.Context, proposalID types.ProposalKey) ([]ProposalDeposit, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iterator := store.Iterator(subspace(getProposalDepositPrefix(proposalID)))
+	defer iterator.Close()
+
+	deposits := []ProposalDeposit{}
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit ProposalDeposit
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &deposit); err != nil {
+			return nil, ErrFailedToUnmarshalProposalDeposit(err)
+		}
+		deposits = append(deposits, deposit)
+	}
+	return deposits, nil
+}
+
+// SetTreasurySpend - set treasury spend of a passed proposal to KVStore
+func (ps ProposalStorage) SetTreasurySpend(ctx sdk.Context, spend *TreasurySpend) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	spendByte, err := ps.cdc.MarshalBinaryLengthPrefixed(*spend)
+	if err != nil {
+		return ErrFailedToMarshalTreasurySpend(err)
+	}
+	store.Set(GetTreasurySpendKey(spend.ProposalID), spendByte)
+	return nil
+}
+
+// GetTreasurySpends - get all treasury spends from KVStore
+func (ps ProposalStorage) GetTreasurySpends(ctx sdk.Context) ([]TreasurySpend, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iterator := store.Iterator(subspace(treasurySpendSubStore))
+	defer iterator.Close()
+
+	spends := []TreasurySpend{}
+	for ; iterator.Valid(); iterator.Next() {
+		var spend TreasurySpend
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &spend); err != nil {
+			return nil, ErrFailedToUnmarshalTreasurySpend(err)
+		}
+		spends = append(spends, spend)
+	}
+	return spends, nil
+}
+
+// GetContentAppeal - get appeal against a content censorship proposal from KVStore,
+// return nil if the censorship is not appealed
+func (ps ProposalStorage) GetContentAppeal(
+	ctx sdk.Context, censorshipID types.ProposalKey) (*ContentAppeal, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	appealByte := store.Get(GetContentAppealKey(censorshipID))
+	if appealByte == nil {
+		return nil, nil
+	}
+	appeal := new(ContentAppeal)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(appealByte, appeal); err != nil {
+		return nil, ErrFailedToUnmarshalContentAppeal(err)
+	}
+	return appeal, nil
+}
+
+// SetContentAppeal - set appeal against a content censorship proposal to KVStore
+func (ps ProposalStorage) SetContentAppeal(ctx sdk.Context, appeal *ContentAppeal) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	appealByte, err := ps.cdc.MarshalBinaryLengthPrefixed(*appeal)
+	if err != nil {
+		return ErrFailedToMarshalContentAppeal(err)
+	}
+	store.Set(GetContentAppealKey(appeal.CensorshipID), appealByte)
+	return nil
+}
+
+// GetAccountFreeze - get freeze of an account from KVStore,
+// return nil if the account is never frozen or already unfrozen
+func (ps ProposalStorage) GetAccountFreeze(
+	ctx sdk.Context, username types.AccountKey) (*AccountFreeze, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	freezeByte := store.Get(GetAccountFreezeKey(username))
+	if freezeByte == nil {
+		return nil, nil
+	}
+	freeze := new(AccountFreeze)
+	if err := ps.cdc.UnmarshalBinaryLengthPrefixed(freezeByte, freeze); err != nil {
+		return nil, ErrFailedToUnmarshalAccountFreeze(err)
+	}
+	return freeze, nil
+}
+
+// SetAccountFreeze - set freeze of an account to KVStore
+func (ps ProposalStorage) SetAccountFreeze(ctx sdk.Context, freeze *AccountFreeze) sdk.Error {
+	store := ctx.KVStore(ps.key)
+	freezeByte, err := ps.cdc.MarshalBinaryLengthPrefixed(*freeze)
+	if err != nil {
+		return ErrFailedToMarshalAccountFreeze(err)
+	}
+	store.Set(GetAccountFreezeKey(freeze.Username), freezeByte)
+	return nil
+}
+
+// DeleteAccountFreeze - delete freeze of an account from KVStore
+func (ps ProposalStorage) DeleteAccountFreeze(ctx sdk.Context, username types.AccountKey) {
+	store := ctx.KVStore(ps.key)
+	store.Delete(GetAccountFreezeKey(username))
+}
+
+// GetAccountFreezes - get freezes of all accounts from KVStore
+func (ps ProposalStorage) GetAccountFreezes(ctx sdk.Context) ([]AccountFreeze, sdk.Error) {
+	store := ctx.KVStore(ps.key)
+	iterator := store.Iterator(subspace(accountFreezeSubStore))
+	defer iterator.Close()
+
+	freezes := []AccountFreeze{}
+	for ; iterator.Valid(); iterator.Next() {
+		var freeze AccountFreeze
+		if err := ps.cdc.UnmarshalBinaryLengthPrefixed(iterator.Value(), &freeze); err != nil {
+			return nil, ErrFailedToUnmarshalAccountFreeze(err)
+		}
+		freezes = append(freezes, freeze)
+	}
+	return freezes, nil
+}
+
 // GetNextProposalID - get next proposal ID from KVStore
 func (ps ProposalStorage) GetNextProposalID(ctx sdk.Context) (*NextProposalID, sdk.Error) {
 	store := ctx.KVStore(ps.key)
@@ -193,6 +412,152 @@ func (ps ProposalStorage) SetNextProposalID(ctx sdk.Context, nextProposalID *Nex
 	return nil
 }
 
+// Export - export proposal state
+func (ps ProposalStorage) Export(ctx sdk.Context) *ProposalTables {
+	tables := &ProposalTables{}
+	store := ctx.KVStore(ps.key)
+	// export table.NextProposalID
+	nextProposalID, err := ps.GetNextProposalID(ctx)
+	if err != nil {
+		panic("failed to get next proposal id: " + err.Error())
+	}
+	tables.NextProposalID = NextProposalIDTable{
+		NextProposalID: *nextProposalID,
+	}
+	// export table.OngoingProposals, ExpiredProposals and DepositingProposals
+	exportProposals := func(substore []byte) []ProposalRow {
+		rows := []ProposalRow{}
+		itr := sdk.KVStorePrefixIterator(store, substore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			var proposal Proposal
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &proposal); err != nil {
+				panic("failed to read proposal: " + err.Error())
+			}
+			row := ProposalRow{
+				ProposalID: types.ProposalKey(k[1:]),
+				Proposal:   fillMissingCoins(proposal),
+			}
+			rows = append(rows, row)
+		}
+		return rows
+	}
+	tables.OngoingProposals = exportProposals(ongoingProposalSubStore)
+	tables.ExpiredProposals = exportProposals(expiredProposalSubStore)
+	tables.DepositingProposals = exportProposals(depositingProposalSubStore)
+	// export table.ProposalDeposits
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, proposalDepositSubStore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			proposalDepositor := string(k[1:])
+			strs := strings.Split(proposalDepositor, types.KeySeparator)
+			if len(strs) != 2 {
+				panic("failed to split out proposalDepositor: " + proposalDepositor)
+			}
+			var deposit ProposalDeposit
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &deposit); err != nil {
+				panic("failed to read proposal deposit: " + err.Error())
+			}
+			row := ProposalDepositRow{
+				ProposalID: types.ProposalKey(strs[0]),
+				Deposit:    deposit,
+			}
+			tables.ProposalDeposits = append(tables.ProposalDeposits, row)
+		}
+	}()
+	// export table.TreasurySpends
+	spends, err := ps.GetTreasurySpends(ctx)
+	if err != nil {
+		panic("failed to get treasury spends: " + err.Error())
+	}
+	for _, spend := range spends {
+		row := TreasurySpendRow{
+			ProposalID:    spend.ProposalID,
+			TreasurySpend: spend,
+		}
+		tables.TreasurySpends = append(tables.TreasurySpends, row)
+	}
+	// export table.ContentAppeals
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, contentAppealSubStore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			var appeal ContentAppeal
+			if err := ps.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &appeal); err != nil {
+				panic("failed to read content appeal: " + err.Error())
+			}
+			row := ContentAppealRow{
+				CensorshipID:  appeal.CensorshipID,
+				ContentAppeal: appeal,
+			}
+			tables.ContentAppeals = append(tables.ContentAppeals, row)
+		}
+	}()
+	// export table.AccountFreezes
+	freezes, err := ps.GetAccountFreezes(ctx)
+	if err != nil {
+		panic("failed to get account freezes: " + err.Error())
+	}
+	for _, freeze := range freezes {
+		row := AccountFreezeRow{
+			Username:      freeze.Username,
+			AccountFreeze: freeze,
+		}
+		tables.AccountFreezes = append(tables.AccountFreezes, row)
+	}
+	return tables
+}
+
+// Import - import proposal state
+func (ps ProposalStorage) Import(ctx sdk.Context, tb *ProposalTablesIR) {
+	check := func(e error) {
+		if e != nil {
+			panic("[ps] Failed to import: " + e.Error())
+		}
+	}
+	// import table.NextProposalID
+	err := ps.SetNextProposalID(ctx, &tb.NextProposalID.NextProposalID)
+	check(err)
+	// import table.OngoingProposals
+	for _, v := range tb.OngoingProposals {
+		err := ps.SetOngoingProposal(ctx, v.ProposalID, v.Proposal)
+		check(err)
+	}
+	// import table.ExpiredProposals
+	for _, v := range tb.ExpiredProposals {
+		err := ps.SetExpiredProposal(ctx, v.ProposalID, v.Proposal)
+		check(err)
+	}
+	// import table.DepositingProposals
+	for _, v := range tb.DepositingProposals {
+		err := ps.SetDepositingProposal(ctx, v.ProposalID, v.Proposal)
+		check(err)
+	}
+	// import table.ProposalDeposits
+	for _, v := range tb.ProposalDeposits {
+		err := ps.SetProposalDeposit(ctx, v.ProposalID, &v.Deposit)
+		check(err)
+	}
+	// import table.TreasurySpends
+	for _, v := range tb.TreasurySpends {
+		err := ps.SetTreasurySpend(ctx, &v.TreasurySpend)
+		check(err)
+	}
+	// import table.ContentAppeals
+	for _, v := range tb.ContentAppeals {
+		err := ps.SetContentAppeal(ctx, &v.ContentAppeal)
+		check(err)
+	}
+	// import table.AccountFreezes
+	for _, v := range tb.AccountFreezes {
+		err := ps.SetAccountFreeze(ctx, &v.AccountFreeze)
+		check(err)
+	}
+}
+
 // GetOngoingProposalKey - "ongoing proposal substore" + "proposal ID"
 func GetOngoingProposalKey(proposalID types.ProposalKey) []byte {
 	return append(ongoingProposalSubStore, proposalID...)
@@ -203,6 +568,35 @@ func GetExpiredProposalKey(proposalID types.ProposalKey) []byte {
 	return append(expiredProposalSubStore, proposalID...)
 }
 
+// GetDepositingProposalKey - "depositing proposal subStore" + "proposal ID"
+func GetDepositingProposalKey(proposalID types.ProposalKey) []byte {
+	return append(depositingProposalSubStore, proposalID...)
+}
+
+func getProposalDepositPrefix(proposalID types.ProposalKey) []byte {
+	return append(append(proposalDepositSubStore, proposalID...), types.KeySeparator...)
+}
+
+// GetProposalDepositKey - "proposal deposit subStore" + "proposal ID" + "depositor"
+func GetProposalDepositKey(proposalID types.ProposalKey, depositor types.AccountKey) []byte {
+	return append(getProposalDepositPrefix(proposalID), depositor...)
+}
+
+// GetTreasurySpendKey - "treasury spend subStore" + "proposal ID"
+func GetTreasurySpendKey(proposalID types.ProposalKey) []byte {
+	return append(treasurySpendSubStore, proposalID...)
+}
+
+// GetContentAppealKey - "content appeal subStore" + "censorship proposal ID"
+func GetContentAppealKey(censorshipID types.ProposalKey) []byte {
+	return append(contentAppealSubStore, censorshipID...)
+}
+
+// GetAccountFreezeKey - "account freeze subStore" + "username"
+func GetAccountFreezeKey(username types.AccountKey) []byte {
+	return append(accountFreezeSubStore, username...)
+}
+
 func getNextProposalIDKey() []byte {
 	return nextProposalIDSubstore
 }
@@ -3,6 +3,7 @@ package model
 import (
 	"testing"
 
+	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/param"
@@ -48,6 +49,7 @@ func TestProposalList(t *testing.T) {
 			ContentCreatorAllocation: sdk.NewDec(0),
 			DeveloperAllocation:      sdk.NewDec(0),
 			ValidatorAllocation:      sdk.NewDec(0),
+			TreasuryAllocation:       sdk.NewDec(0),
 		},
 	}
 
@@ -96,6 +98,7 @@ func TestProposal(t *testing.T) {
 					ContentCreatorAllocation: sdk.NewDec(0),
 					DeveloperAllocation:      sdk.NewDec(0),
 					ValidatorAllocation:      sdk.NewDec(0),
+					TreasuryAllocation:       sdk.NewDec(0),
 				},
 			},
 		},
@@ -156,6 +159,209 @@ func TestProposal(t *testing.T) {
 	}
 }
 
+func TestDepositingProposal(t *testing.T) {
+	ctx, ps := setup(t)
+	proposalID := types.ProposalKey("1")
+	p1 := ChangeParamProposal{
+		ProposalInfo: ProposalInfo{
+			Creator:       types.AccountKey("user"),
+			ProposalID:    proposalID,
+			AgreeVotes:    types.NewCoinFromInt64(0),
+			DisagreeVotes: types.NewCoinFromInt64(0),
+			Deposit:       types.NewCoinFromInt64(0),
+		},
+	}
+
+	assert.False(t, ps.DoesProposalExist(ctx, proposalID))
+	err := ps.SetDepositingProposal(ctx, proposalID, &p1)
+	assert.Nil(t, err)
+	assert.True(t, ps.DoesProposalExist(ctx, proposalID))
+
+	proposal, err := ps.GetDepositingProposal(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, &p1, proposal)
+	proposalList, err := ps.GetDepositingProposalList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []Proposal{&p1}, proposalList)
+
+	_, err = ps.GetOngoingProposal(ctx, proposalID)
+	assert.Equal(t, ErrProposalNotFound(), err)
+
+	err = ps.DeleteDepositingProposal(ctx, proposalID)
+	assert.Nil(t, err)
+	_, err = ps.GetDepositingProposal(ctx, proposalID)
+	assert.Equal(t, ErrProposalNotFound(), err)
+	assert.False(t, ps.DoesProposalExist(ctx, proposalID))
+}
+
+func TestProposalStoredBeforeNewCoins(t *testing.T) {
+	ctx, ps := setup(t)
+	proposalID := types.ProposalKey("1")
+
+	// layout of proposal before abstain votes, veto votes and deposit were introduced
+	type OldProposalInfo struct {
+		Creator       types.AccountKey     `json:"creator"`
+		ProposalID    types.ProposalKey    `json:"proposal_id"`
+		AgreeVotes    types.Coin           `json:"agree_vote"`
+		DisagreeVotes types.Coin           `json:"disagree_vote"`
+		Result        types.ProposalResult `json:"result"`
+		CreatedAt     int64                `json:"created_at"`
+		ExpiredAt     int64                `json:"expired_at"`
+		Reason        string               `json:"reason"`
+	}
+	type OldProtocolUpgradeProposal struct {
+		OldProposalInfo
+		Link   string `json:"link"`
+		Reason string `json:"reason"`
+	}
+	oldCdc := wire.New()
+	oldCdc.RegisterConcrete(&OldProtocolUpgradeProposal{}, "upgrade", nil)
+	oldProposal := &OldProtocolUpgradeProposal{
+		OldProposalInfo: OldProposalInfo{
+			Creator:       types.AccountKey("user"),
+			ProposalID:    proposalID,
+			AgreeVotes:    types.NewCoinFromInt64(100),
+			DisagreeVotes: types.NewCoinFromInt64(50),
+			Result:        types.ProposalPass,
+			CreatedAt:     10,
+			ExpiredAt:     20,
+		},
+		Link: "link",
+	}
+	proposalByte, err := oldCdc.MarshalBinaryLengthPrefixed(oldProposal)
+	assert.Nil(t, err)
+	ctx.KVStore(TestKVStoreKey).Set(GetOngoingProposalKey(proposalID), proposalByte)
+
+	want := &ProtocolUpgradeProposal{
+		ProposalInfo: ProposalInfo{
+			Creator:       types.AccountKey("user"),
+			ProposalID:    proposalID,
+			AgreeVotes:    types.NewCoinFromInt64(100),
+			DisagreeVotes: types.NewCoinFromInt64(50),
+			AbstainVotes:  types.NewCoinFromInt64(0),
+			VetoVotes:     types.NewCoinFromInt64(0),
+			Deposit:       types.NewCoinFromInt64(0),
+			Result:        types.ProposalPass,
+			CreatedAt:     10,
+			ExpiredAt:     20,
+		},
+		Link: "link",
+	}
+	proposal, err := ps.GetOngoingProposal(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, want, proposal)
+
+	proposals, err := ps.GetOngoingProposalList(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []Proposal{want}, proposals)
+}
+
+func TestProposalDeposit(t *testing.T) {
+	ctx, ps := setup(t)
+	user1, user2 := types.AccountKey("user1"), types.AccountKey("user2")
+
+	deposit, err := ps.GetProposalDeposit(ctx, types.ProposalKey("1"), user1)
+	assert.Nil(t, err)
+	assert.Equal(t, ProposalDeposit{Depositor: user1, Amount: types.NewCoinFromInt64(0)}, *deposit)
+
+	deposits, err := ps.GetProposalDeposits(ctx, types.ProposalKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(deposits))
+
+	d1 := ProposalDeposit{Depositor: user2, Amount: types.NewCoinFromInt64(100)}
+	d2 := ProposalDeposit{Depositor: user1, Amount: types.NewCoinFromInt64(200)}
+	d3 := ProposalDeposit{Depositor: user1, Amount: types.NewCoinFromInt64(300)}
+	assert.Nil(t, ps.SetProposalDeposit(ctx, types.ProposalKey("1"), &d1))
+	assert.Nil(t, ps.SetProposalDeposit(ctx, types.ProposalKey("1"), &d2))
+	assert.Nil(t, ps.SetProposalDeposit(ctx, types.ProposalKey("12"), &d3))
+
+	deposit, err = ps.GetProposalDeposit(ctx, types.ProposalKey("1"), user1)
+	assert.Nil(t, err)
+	assert.Equal(t, d2, *deposit)
+
+	deposits, err = ps.GetProposalDeposits(ctx, types.ProposalKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []ProposalDeposit{d2, d1}, deposits)
+
+	deposits, err = ps.GetProposalDeposits(ctx, types.ProposalKey("12"))
+	assert.Nil(t, err)
+	assert.Equal(t, []ProposalDeposit{d3}, deposits)
+}
+
+func TestTreasurySpend(t *testing.T) {
+	ctx, ps := setup(t)
+
+	spends, err := ps.GetTreasurySpends(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(spends))
+
+	s1 := TreasurySpend{
+		ProposalID: types.ProposalKey("1"), Recipient: types.AccountKey("user1"),
+		Amount: types.NewCoinFromInt64(100), Times: 1, SpentAt: 100}
+	s2 := TreasurySpend{
+		ProposalID: types.ProposalKey("2"), Recipient: types.AccountKey("user2"),
+		Amount: types.NewCoinFromInt64(300), Times: 3, IntervalSec: 3600, SpentAt: 200}
+	assert.Nil(t, ps.SetTreasurySpend(ctx, &s2))
+	assert.Nil(t, ps.SetTreasurySpend(ctx, &s1))
+
+	spends, err = ps.GetTreasurySpends(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []TreasurySpend{s1, s2}, spends)
+}
+
+func TestContentAppeal(t *testing.T) {
+	ctx, ps := setup(t)
+
+	appeal, err := ps.GetContentAppeal(ctx, types.ProposalKey("1"))
+	assert.Nil(t, err)
+	assert.Nil(t, appeal)
+
+	a1 := ContentAppeal{CensorshipID: types.ProposalKey("1"), AppealID: types.ProposalKey("2")}
+	assert.Nil(t, ps.SetContentAppeal(ctx, &a1))
+	appeal, err = ps.GetContentAppeal(ctx, types.ProposalKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, a1, *appeal)
+
+	// a new appeal replaces the previous one
+	a2 := ContentAppeal{CensorshipID: types.ProposalKey("1"), AppealID: types.ProposalKey("3")}
+	assert.Nil(t, ps.SetContentAppeal(ctx, &a2))
+	appeal, err = ps.GetContentAppeal(ctx, types.ProposalKey("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, a2, *appeal)
+}
+
+func TestAccountFreeze(t *testing.T) {
+	ctx, ps := setup(t)
+
+	freeze, err := ps.GetAccountFreeze(ctx, types.AccountKey("user1"))
+	assert.Nil(t, err)
+	assert.Nil(t, freeze)
+
+	f1 := AccountFreeze{
+		Username: types.AccountKey("user1"), ProposalID: types.ProposalKey("1"),
+		FrozenAt: 100, FrozenUntil: 200}
+	f2 := AccountFreeze{
+		Username: types.AccountKey("user2"), ProposalID: types.ProposalKey("2"),
+		FrozenAt: 150, FrozenUntil: 250}
+	assert.Nil(t, ps.SetAccountFreeze(ctx, &f2))
+	assert.Nil(t, ps.SetAccountFreeze(ctx, &f1))
+	freeze, err = ps.GetAccountFreeze(ctx, types.AccountKey("user1"))
+	assert.Nil(t, err)
+	assert.Equal(t, f1, *freeze)
+
+	freezes, err := ps.GetAccountFreezes(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []AccountFreeze{f1, f2}, freezes)
+
+	ps.DeleteAccountFreeze(ctx, types.AccountKey("user1"))
+	freeze, err = ps.GetAccountFreeze(ctx, types.AccountKey("user1"))
+	assert.Nil(t, err)
+	assert.Nil(t, freeze)
+	freezes, err = ps.GetAccountFreezes(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []AccountFreeze{f2}, freezes)
+}
+
 func TestNextProposalID(t *testing.T) {
 	ctx, ps := setup(t)
 
@@ -171,3 +377,46 @@ func TestNextProposalID(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, nextProposalID, id)
 }
+
+func TestExportImport(t *testing.T) {
+	ctx, ps := setup(t)
+	user1, user2 := types.AccountKey("user1"), types.AccountKey("user2")
+	newProposalInfo := func(id types.ProposalKey) ProposalInfo {
+		return ProposalInfo{
+			Creator:       user1,
+			ProposalID:    id,
+			AgreeVotes:    types.NewCoinFromInt64(0),
+			DisagreeVotes: types.NewCoinFromInt64(0),
+			AbstainVotes:  types.NewCoinFromInt64(0),
+			VetoVotes:     types.NewCoinFromInt64(0),
+			Deposit:       types.NewCoinFromInt64(0),
+		}
+	}
+	ongoing := &ProtocolUpgradeProposal{ProposalInfo: newProposalInfo("1"), Link: "link"}
+	expired := &ContentCensorshipProposal{ProposalInfo: newProposalInfo("2"), Permlink: "permlink"}
+	depositing := &AccountFreezeProposal{ProposalInfo: newProposalInfo("3"), Username: user2}
+	assert.Nil(t, ps.SetOngoingProposal(ctx, "1", ongoing))
+	assert.Nil(t, ps.SetExpiredProposal(ctx, "2", expired))
+	assert.Nil(t, ps.SetDepositingProposal(ctx, "3", depositing))
+	assert.Nil(t, ps.SetProposalDeposit(ctx, "3", &ProposalDeposit{Depositor: user1, Amount: types.NewCoinFromInt64(10)}))
+	assert.Nil(t, ps.SetTreasurySpend(ctx, &TreasurySpend{ProposalID: "4", Recipient: user1, Amount: types.NewCoinFromInt64(100)}))
+	assert.Nil(t, ps.SetContentAppeal(ctx, &ContentAppeal{CensorshipID: "2", AppealID: "5"}))
+	assert.Nil(t, ps.SetAccountFreeze(ctx, &AccountFreeze{Username: user2, ProposalID: "6", FrozenAt: 1, FrozenUntil: 2}))
+	assert.Nil(t, ps.SetNextProposalID(ctx, &NextProposalID{NextProposalID: 7}))
+
+	tables := ps.Export(ctx)
+	assert.Equal(t, int64(7), tables.NextProposalID.NextProposalID.NextProposalID)
+	assert.Equal(t, []ProposalRow{{ProposalID: "1", Proposal: ongoing}}, tables.OngoingProposals)
+	assert.Equal(t, []ProposalRow{{ProposalID: "2", Proposal: expired}}, tables.ExpiredProposals)
+	assert.Equal(t, []ProposalRow{{ProposalID: "3", Proposal: depositing}}, tables.DepositingProposals)
+	assert.Equal(t, 1, len(tables.ProposalDeposits))
+	assert.Equal(t, types.ProposalKey("3"), tables.ProposalDeposits[0].ProposalID)
+	assert.Equal(t, 1, len(tables.TreasurySpends))
+	assert.Equal(t, 1, len(tables.ContentAppeals))
+	assert.Equal(t, 1, len(tables.AccountFreezes))
+
+	ctx2, ps2 := setup(t)
+	ir := tables.ToIR()
+	ps2.Import(ctx2, &ir)
+	assert.Equal(t, tables, ps2.Export(ctx2))
+}
@@ -22,6 +22,11 @@ var _ types.Msg = ChangeBandwidthParamMsg{}
 var _ types.Msg = ChangeAccountParamMsg{}
 var _ types.Msg = ChangePostParamMsg{}
 var _ types.Msg = VoteProposalMsg{}
+var _ types.Msg = DepositProposalMsg{}
+var _ types.Msg = TreasurySpendMsg{}
+var _ types.Msg = ContentAppealMsg{}
+var _ types.Msg = AccountFreezeMsg{}
+var _ types.Msg = AccountUnfreezeMsg{}
 
 var _ ChangeParamMsg = ChangeGlobalAllocationParamMsg{}
 var _ ChangeParamMsg = ChangeInfraInternalAllocationParamMsg{}
@@ -48,6 +53,7 @@ type ChangeParamMsg interface {
 type ContentCensorshipMsg interface {
 	GetCreator() types.AccountKey
 	GetPermlink() types.Permlink
+	GetRevision() int64
 	GetReason() string
 }
 
@@ -58,10 +64,12 @@ type ProtocolUpgradeMsg interface {
 	GetReason() string
 }
 
-// DeletePostContentMsg - implement of content censorship msg
+// DeletePostContentMsg - implement of content censorship msg,
+// revision zero censors the whole post, otherwise only the given revision
 type DeletePostContentMsg struct {
 	Creator  types.AccountKey `json:"creator"`
 	Permlink types.Permlink   `json:"permlink"`
+	Revision int64            `json:"revision"`
 	Reason   string           `json:"reason"`
 }
 
@@ -139,17 +147,57 @@ type ChangePostParamMsg struct {
 type VoteProposalMsg struct {
 	Voter      types.AccountKey  `json:"voter"`
 	ProposalID types.ProposalKey `json:"proposal_id"`
-	Result     bool              `json:"result"`
+	Option     types.VoteOption  `json:"option"`
+}
+
+// DepositProposalMsg - add deposit to a proposal in deposit period
+type DepositProposalMsg struct {
+	Depositor  types.AccountKey  `json:"depositor"`
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Amount     types.LNO         `json:"amount"`
+}
+
+// TreasurySpendMsg - propose to pay recipient from treasury pool,
+// times larger than one vests the amount in equal parts every interval
+type TreasurySpendMsg struct {
+	Creator     types.AccountKey `json:"creator"`
+	Recipient   types.AccountKey `json:"recipient"`
+	Amount      types.LNO        `json:"amount"`
+	Times       int64            `json:"times"`
+	IntervalSec int64            `json:"interval_second"`
+	Reason      string           `json:"reason"`
+}
+
+// ContentAppealMsg - post author appeals against a passed content censorship proposal
+type ContentAppealMsg struct {
+	Author     types.AccountKey  `json:"author"`
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Reason     string            `json:"reason"`
+}
+
+// AccountFreezeMsg - propose to freeze outgoing transfers, donations and stake changes of an account
+type AccountFreezeMsg struct {
+	Creator  types.AccountKey `json:"creator"`
+	Username types.AccountKey `json:"username"`
+	Reason   string           `json:"reason"`
+}
+
+// AccountUnfreezeMsg - propose to unfreeze a frozen account before its freeze ends
+type AccountUnfreezeMsg struct {
+	Creator  types.AccountKey `json:"creator"`
+	Username types.AccountKey `json:"username"`
+	Reason   string           `json:"reason"`
 }
 
 //----------------------------------------
 // ChangeGlobalAllocationParamMsg Msg Implementations
 
 func NewDeletePostContentMsg(
-	creator string, permlink types.Permlink, reason string) DeletePostContentMsg {
+	creator string, permlink types.Permlink, revision int64, reason string) DeletePostContentMsg {
 	return DeletePostContentMsg{
 		Creator:  types.AccountKey(creator),
 		Permlink: permlink,
+		Revision: revision,
 		Reason:   reason,
 	}
 }
@@ -157,6 +205,9 @@ func NewDeletePostContentMsg(
 // GetPermlink - implement DeletePostContentMsg
 func (msg DeletePostContentMsg) GetPermlink() types.Permlink { return msg.Permlink }
 
+// GetRevision - implement DeletePostContentMsg
+func (msg DeletePostContentMsg) GetRevision() int64 { return msg.Revision }
+
 // GetCreator - implement DeletePostContentMsg
 func (msg DeletePostContentMsg) GetCreator() types.AccountKey { return msg.Creator }
 
@@ -178,6 +229,9 @@ func (msg DeletePostContentMsg) ValidateBasic() sdk.Error {
 	if len(msg.GetPermlink()) == 0 {
 		return ErrInvalidPermlink()
 	}
+	if msg.Revision < 0 {
+		return ErrInvalidRevision()
+	}
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
@@ -185,7 +239,7 @@ func (msg DeletePostContentMsg) ValidateBasic() sdk.Error {
 }
 
 func (msg DeletePostContentMsg) String() string {
-	return fmt.Sprintf("DeletePostContentMsg{Creator:%v, post:%v}", msg.Creator, msg.GetPermlink())
+	return fmt.Sprintf("DeletePostContentMsg{Creator:%v, post:%v, revision:%v}", msg.Creator, msg.GetPermlink(), msg.Revision)
 }
 
 // GetPermission - implement types.Msg
@@ -322,13 +376,15 @@ func (msg ChangeGlobalAllocationParamMsg) ValidateBasic() sdk.Error {
 	if !msg.Parameter.InfraAllocation.
 		Add(msg.Parameter.ContentCreatorAllocation).
 		Add(msg.Parameter.DeveloperAllocation).
-		Add(msg.Parameter.ValidatorAllocation).Equal(sdk.NewDec(1)) {
+		Add(msg.Parameter.ValidatorAllocation).
+		Add(msg.Parameter.TreasuryAllocation).Equal(sdk.NewDec(1)) {
 		return ErrIllegalParameter()
 	}
 	if msg.Parameter.InfraAllocation.LT(sdk.ZeroDec()) ||
 		msg.Parameter.ContentCreatorAllocation.LT(sdk.ZeroDec()) ||
 		msg.Parameter.DeveloperAllocation.LT(sdk.ZeroDec()) ||
-		msg.Parameter.ValidatorAllocation.LT(sdk.ZeroDec()) {
+		msg.Parameter.ValidatorAllocation.LT(sdk.ZeroDec()) ||
+		msg.Parameter.TreasuryAllocation.LT(sdk.ZeroDec()) {
 		return ErrIllegalParameter()
 	}
 	if msg.Parameter.GlobalGrowthRate.GT(param.AnnualInflationCeiling) {
@@ -412,6 +468,16 @@ func (msg ChangeInfraInternalAllocationParamMsg) ValidateBasic() sdk.Error {
 		return ErrIllegalParameter()
 	}
 
+	if msg.Parameter.InfraProviderCoinReturnIntervalSec <= 0 ||
+		msg.Parameter.InfraProviderCoinReturnTimes <= 0 {
+		return ErrIllegalParameter()
+	}
+
+	if !msg.Parameter.InfraProviderMinDeposit.IsPositive() ||
+		!msg.Parameter.InfraProviderDisputePenalty.IsNotNegative() {
+		return ErrIllegalParameter()
+	}
+
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
@@ -483,7 +549,9 @@ func (msg ChangeVoteParamMsg) ValidateBasic() sdk.Error {
 	if msg.Parameter.DelegatorCoinReturnIntervalSec <= 0 ||
 		msg.Parameter.VoterCoinReturnIntervalSec <= 0 ||
 		msg.Parameter.DelegatorCoinReturnTimes <= 0 ||
-		msg.Parameter.VoterCoinReturnTimes <= 0 {
+		msg.Parameter.VoterCoinReturnTimes <= 0 ||
+		msg.Parameter.RedelegationIntervalSec < 0 ||
+		msg.Parameter.MaxRedelegations <= 0 {
 		return ErrIllegalParameter()
 	}
 
@@ -562,7 +630,13 @@ func (msg ChangeProposalParamMsg) ValidateBasic() sdk.Error {
 	if msg.Parameter.ContentCensorshipDecideSec <= 0 ||
 		msg.Parameter.ChangeParamExecutionSec <= 0 ||
 		msg.Parameter.ChangeParamDecideSec <= 0 ||
-		msg.Parameter.ProtocolUpgradeDecideSec <= 0 {
+		msg.Parameter.ProtocolUpgradeDecideSec <= 0 ||
+		msg.Parameter.TreasurySpendDecideSec <= 0 ||
+		msg.Parameter.ContentAppealWindowSec <= 0 ||
+		msg.Parameter.ContentAppealDecideSec <= 0 ||
+		msg.Parameter.AccountFreezeSec <= 0 ||
+		msg.Parameter.AccountFreezeDecideSec <= 0 ||
+		msg.Parameter.ProposalDepositSec <= 0 {
 		return ErrIllegalParameter()
 	}
 
@@ -571,16 +645,38 @@ func (msg ChangeProposalParamMsg) ValidateBasic() sdk.Error {
 		!msg.Parameter.ChangeParamMinDeposit.IsPositive() ||
 		!msg.Parameter.ChangeParamPassVotes.IsPositive() ||
 		!msg.Parameter.ProtocolUpgradePassVotes.IsPositive() ||
-		!msg.Parameter.ProtocolUpgradeMinDeposit.IsPositive() {
+		!msg.Parameter.ProtocolUpgradeMinDeposit.IsPositive() ||
+		!msg.Parameter.TreasurySpendPassVotes.IsPositive() ||
+		!msg.Parameter.TreasurySpendMinDeposit.IsPositive() ||
+		!msg.Parameter.ContentAppealPassVotes.IsPositive() ||
+		!msg.Parameter.ContentAppealMinDeposit.IsPositive() ||
+		!msg.Parameter.AccountFreezePassVotes.IsPositive() ||
+		!msg.Parameter.AccountFreezeMinDeposit.IsPositive() ||
+		!msg.Parameter.ProposalMinInitialDeposit.IsPositive() {
 		return ErrIllegalParameter()
 	}
 
 	if !msg.Parameter.ContentCensorshipPassRatio.GT(sdk.ZeroDec()) ||
 		!msg.Parameter.ChangeParamPassRatio.GT(sdk.ZeroDec()) ||
 		!msg.Parameter.ProtocolUpgradePassRatio.GT(sdk.ZeroDec()) ||
+		!msg.Parameter.TreasurySpendPassRatio.GT(sdk.ZeroDec()) ||
+		!msg.Parameter.AccountFreezePassRatio.GT(sdk.ZeroDec()) ||
 		msg.Parameter.ProtocolUpgradePassRatio.GT(sdk.NewDec(1)) ||
 		msg.Parameter.ChangeParamPassRatio.GT(sdk.NewDec(1)) ||
-		msg.Parameter.ContentCensorshipPassRatio.GT(sdk.NewDec(1)) {
+		msg.Parameter.ContentCensorshipPassRatio.GT(sdk.NewDec(1)) ||
+		msg.Parameter.TreasurySpendPassRatio.GT(sdk.NewDec(1)) ||
+		msg.Parameter.ContentAppealPassRatio.GT(sdk.NewDec(1)) ||
+		msg.Parameter.AccountFreezePassRatio.GT(sdk.NewDec(1)) {
+		return ErrIllegalParameter()
+	}
+
+	// overturning a censorship requires no less agreement than the censorship itself
+	if msg.Parameter.ContentAppealPassRatio.LT(msg.Parameter.ContentCensorshipPassRatio) {
+		return ErrIllegalParameter()
+	}
+
+	if !msg.Parameter.ProposalVetoRatio.GT(sdk.ZeroDec()) ||
+		msg.Parameter.ProposalVetoRatio.GT(sdk.NewDec(1)) {
 		return ErrIllegalParameter()
 	}
 
@@ -889,7 +985,17 @@ func (msg ChangePostParamMsg) ValidateBasic() sdk.Error {
 	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
 		return ErrReasonTooLong()
 	}
-	if msg.Parameter.PostIntervalSec < 0 || msg.Parameter.ReportOrUpvoteIntervalSec < 0 {
+	if msg.Parameter.PostIntervalSec < 0 || msg.Parameter.ReportOrUpvoteIntervalSec < 0 ||
+		msg.Parameter.BatchViewIntervalSec < 0 || msg.Parameter.MaxBatchViewCount < 0 ||
+		msg.Parameter.MaxBatchViewCount > types.MaximumBatchViewCount ||
+		msg.Parameter.DonationRefundWindowSec < 0 ||
+		msg.Parameter.DonationRefundWindowSec > types.MaximumDonationRefundWindowSec ||
+		msg.Parameter.MaxCommentDepth < 0 {
+		return ErrIllegalParameter()
+	}
+	if msg.Parameter.MaxTitleLength < 0 || msg.Parameter.MaxTitleLength > types.MaxPostTitleLength ||
+		msg.Parameter.MaxContentLength < 0 || msg.Parameter.MaxContentLength > types.MaxPostContentLength ||
+		msg.Parameter.MaxNumOfLinks < 0 || msg.Parameter.MaxNumOfLinks > types.MaximumNumOfLinks {
 		return ErrIllegalParameter()
 	}
 	return nil
@@ -1002,11 +1108,11 @@ func (msg ChangeBandwidthParamMsg) GetConsumeAmount() types.Coin {
 
 //----------------------------------------
 // VoteProposalMsg Msg Implementations
-func NewVoteProposalMsg(voter string, proposalID int64, result bool) VoteProposalMsg {
+func NewVoteProposalMsg(voter string, proposalID int64, option types.VoteOption) VoteProposalMsg {
 	return VoteProposalMsg{
 		Voter:      types.AccountKey(voter),
 		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
-		Result:     result,
+		Option:     option,
 	}
 }
 
@@ -1022,11 +1128,14 @@ func (msg VoteProposalMsg) ValidateBasic() sdk.Error {
 		len(msg.Voter) > types.MaximumUsernameLength {
 		return ErrInvalidUsername()
 	}
+	if msg.Option < types.VoteOptionYes || msg.Option > types.VoteOptionNoWithVeto {
+		return ErrInvalidVoteOption()
+	}
 	return nil
 }
 
 func (msg VoteProposalMsg) String() string {
-	return fmt.Sprintf("VoteProposalMsg{Voter:%v, ProposalID:%v, Result:%v}", msg.Voter, msg.ProposalID, msg.Result)
+	return fmt.Sprintf("VoteProposalMsg{Voter:%v, ProposalID:%v, Option:%v}", msg.Voter, msg.ProposalID, msg.Option)
 }
 
 // GetPermission - implement types.Msg
@@ -1052,3 +1161,303 @@ func (msg VoteProposalMsg) GetSigners() []sdk.AccAddress {
 func (msg VoteProposalMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+//----------------------------------------
+// DepositProposalMsg Msg Implementations
+func NewDepositProposalMsg(depositor string, proposalID int64, amount types.LNO) DepositProposalMsg {
+	return DepositProposalMsg{
+		Depositor:  types.AccountKey(depositor),
+		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
+		Amount:     amount,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg DepositProposalMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg DepositProposalMsg) Type() string { return "DepositProposalMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg DepositProposalMsg) ValidateBasic() sdk.Error {
+	if len(msg.Depositor) < types.MinimumUsernameLength ||
+		len(msg.Depositor) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if _, err := types.LinoToCoin(msg.Amount); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg DepositProposalMsg) String() string {
+	return fmt.Sprintf("DepositProposalMsg{Depositor:%v, ProposalID:%v, Amount:%v}", msg.Depositor, msg.ProposalID, msg.Amount)
+}
+
+// GetPermission - implement types.Msg
+func (msg DepositProposalMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg DepositProposalMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg DepositProposalMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Depositor)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg DepositProposalMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// TreasurySpendMsg Msg Implementations
+func NewTreasurySpendMsg(
+	creator, recipient string, amount types.LNO, times, intervalSec int64, reason string) TreasurySpendMsg {
+	return TreasurySpendMsg{
+		Creator:     types.AccountKey(creator),
+		Recipient:   types.AccountKey(recipient),
+		Amount:      amount,
+		Times:       times,
+		IntervalSec: intervalSec,
+		Reason:      reason,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg TreasurySpendMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg TreasurySpendMsg) Type() string { return "TreasurySpendMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg TreasurySpendMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength ||
+		len(msg.Recipient) < types.MinimumUsernameLength ||
+		len(msg.Recipient) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if _, err := types.LinoToCoin(msg.Amount); err != nil {
+		return err
+	}
+	if msg.Times < 1 || msg.Times > types.MaximumTreasurySpendTimes || msg.IntervalSec < 0 {
+		return ErrInvalidSpendSchedule()
+	}
+	if msg.Times > 1 && msg.IntervalSec == 0 {
+		return ErrInvalidSpendSchedule()
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg TreasurySpendMsg) String() string {
+	return fmt.Sprintf("TreasurySpendMsg{Creator:%v, Recipient:%v, Amount:%v, Times:%v, IntervalSec:%v}",
+		msg.Creator, msg.Recipient, msg.Amount, msg.Times, msg.IntervalSec)
+}
+
+// GetPermission - implement types.Msg
+func (msg TreasurySpendMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg TreasurySpendMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg TreasurySpendMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg TreasurySpendMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// ContentAppealMsg Msg Implementations
+func NewContentAppealMsg(author string, proposalID int64, reason string) ContentAppealMsg {
+	return ContentAppealMsg{
+		Author:     types.AccountKey(author),
+		ProposalID: types.ProposalKey(strconv.FormatInt(proposalID, 10)),
+		Reason:     reason,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg ContentAppealMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg ContentAppealMsg) Type() string { return "ContentAppealMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg ContentAppealMsg) ValidateBasic() sdk.Error {
+	if len(msg.Author) < types.MinimumUsernameLength ||
+		len(msg.Author) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg ContentAppealMsg) String() string {
+	return fmt.Sprintf("ContentAppealMsg{Author:%v, ProposalID:%v}", msg.Author, msg.ProposalID)
+}
+
+// GetPermission - implement types.Msg
+func (msg ContentAppealMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg ContentAppealMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg ContentAppealMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Author)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg ContentAppealMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// AccountFreezeMsg Msg Implementations
+func NewAccountFreezeMsg(creator, username, reason string) AccountFreezeMsg {
+	return AccountFreezeMsg{
+		Creator:  types.AccountKey(creator),
+		Username: types.AccountKey(username),
+		Reason:   reason,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg AccountFreezeMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg AccountFreezeMsg) Type() string { return "AccountFreezeMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg AccountFreezeMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength ||
+		len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg AccountFreezeMsg) String() string {
+	return fmt.Sprintf("AccountFreezeMsg{Creator:%v, Username:%v}", msg.Creator, msg.Username)
+}
+
+// GetPermission - implement types.Msg
+func (msg AccountFreezeMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg AccountFreezeMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg AccountFreezeMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg AccountFreezeMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+//----------------------------------------
+// AccountUnfreezeMsg Msg Implementations
+func NewAccountUnfreezeMsg(creator, username, reason string) AccountUnfreezeMsg {
+	return AccountUnfreezeMsg{
+		Creator:  types.AccountKey(creator),
+		Username: types.AccountKey(username),
+		Reason:   reason,
+	}
+}
+
+// Route - implement sdk.Msg
+func (msg AccountUnfreezeMsg) Route() string { return RouterKey }
+
+// Type - implement sdk.Msg
+func (msg AccountUnfreezeMsg) Type() string { return "AccountUnfreezeMsg" }
+
+// ValidateBasic - implement sdk.Msg
+func (msg AccountUnfreezeMsg) ValidateBasic() sdk.Error {
+	if len(msg.Creator) < types.MinimumUsernameLength ||
+		len(msg.Creator) > types.MaximumUsernameLength ||
+		len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if utf8.RuneCountInString(msg.Reason) > types.MaximumLengthOfProposalReason {
+		return ErrReasonTooLong()
+	}
+	return nil
+}
+
+func (msg AccountUnfreezeMsg) String() string {
+	return fmt.Sprintf("AccountUnfreezeMsg{Creator:%v, Username:%v}", msg.Creator, msg.Username)
+}
+
+// GetPermission - implement types.Msg
+func (msg AccountUnfreezeMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implement sdk.Msg
+func (msg AccountUnfreezeMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implement sdk.Msg
+func (msg AccountUnfreezeMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Creator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg AccountUnfreezeMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
@@ -48,14 +48,34 @@ func TestVoteProposalMsg(t *testing.T) {
 	}{
 		{
 			testName:        "normal case",
-			voteProposalMsg: NewVoteProposalMsg("user1", 1, true),
+			voteProposalMsg: NewVoteProposalMsg("user1", 1, types.VoteOptionYes),
 			expectedError:   nil,
 		},
 		{
 			testName:        "empty username is illegal",
-			voteProposalMsg: NewVoteProposalMsg("", 1, true),
+			voteProposalMsg: NewVoteProposalMsg("", 1, types.VoteOptionYes),
 			expectedError:   ErrInvalidUsername(),
 		},
+		{
+			testName:        "abstain is legal",
+			voteProposalMsg: NewVoteProposalMsg("user1", 1, types.VoteOptionAbstain),
+			expectedError:   nil,
+		},
+		{
+			testName:        "no with veto is legal",
+			voteProposalMsg: NewVoteProposalMsg("user1", 1, types.VoteOptionNoWithVeto),
+			expectedError:   nil,
+		},
+		{
+			testName:        "empty option is illegal",
+			voteProposalMsg: NewVoteProposalMsg("user1", 1, types.VoteOption(0)),
+			expectedError:   ErrInvalidVoteOption(),
+		},
+		{
+			testName:        "unknown option is illegal",
+			voteProposalMsg: NewVoteProposalMsg("user1", 1, types.VoteOption(5)),
+			expectedError:   ErrInvalidVoteOption(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -66,6 +86,181 @@ func TestVoteProposalMsg(t *testing.T) {
 	}
 }
 
+func TestDepositProposalMsg(t *testing.T) {
+	testCases := []struct {
+		testName           string
+		depositProposalMsg DepositProposalMsg
+		expectedError      sdk.Error
+	}{
+		{
+			testName:           "normal case",
+			depositProposalMsg: NewDepositProposalMsg("user1", 1, "1"),
+			expectedError:      nil,
+		},
+		{
+			testName:           "empty username is illegal",
+			depositProposalMsg: NewDepositProposalMsg("", 1, "1"),
+			expectedError:      ErrInvalidUsername(),
+		},
+		{
+			testName:           "negative amount is illegal",
+			depositProposalMsg: NewDepositProposalMsg("user1", 1, "-1"),
+			expectedError:      types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName:           "illegal amount",
+			depositProposalMsg: NewDepositProposalMsg("user1", 1, "abc"),
+			expectedError:      types.ErrInvalidCoins("Illegal LNO"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.depositProposalMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestTreasurySpendMsg(t *testing.T) {
+	testCases := []struct {
+		testName         string
+		treasurySpendMsg TreasurySpendMsg
+		expectedError    sdk.Error
+	}{
+		{
+			testName:         "normal case",
+			treasurySpendMsg: NewTreasurySpendMsg("user1", "user2", "100", 1, 0, ""),
+			expectedError:    nil,
+		},
+		{
+			testName:         "vesting spend",
+			treasurySpendMsg: NewTreasurySpendMsg("user1", "user2", "100", 10, 3600, ""),
+			expectedError:    nil,
+		},
+		{
+			testName:         "empty recipient is illegal",
+			treasurySpendMsg: NewTreasurySpendMsg("user1", "", "100", 1, 0, ""),
+			expectedError:    ErrInvalidUsername(),
+		},
+		{
+			testName:         "negative amount is illegal",
+			treasurySpendMsg: NewTreasurySpendMsg("user1", "user2", "-1", 1, 0, ""),
+			expectedError:    types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+		{
+			testName:         "zero times is illegal",
+			treasurySpendMsg: NewTreasurySpendMsg("user1", "user2", "100", 0, 0, ""),
+			expectedError:    ErrInvalidSpendSchedule(),
+		},
+		{
+			testName: "too many times is illegal",
+			treasurySpendMsg: NewTreasurySpendMsg(
+				"user1", "user2", "100", types.MaximumTreasurySpendTimes+1, 3600, ""),
+			expectedError: ErrInvalidSpendSchedule(),
+		},
+		{
+			testName:         "vesting without interval is illegal",
+			treasurySpendMsg: NewTreasurySpendMsg("user1", "user2", "100", 10, 0, ""),
+			expectedError:    ErrInvalidSpendSchedule(),
+		},
+		{
+			testName:         "negative interval is illegal",
+			treasurySpendMsg: NewTreasurySpendMsg("user1", "user2", "100", 1, -1, ""),
+			expectedError:    ErrInvalidSpendSchedule(),
+		},
+		{
+			testName:         "utf8 reason is too long",
+			treasurySpendMsg: NewTreasurySpendMsg("user1", "user2", "100", 1, 0, tooLongOfUTF8Reason),
+			expectedError:    ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.treasurySpendMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestContentAppealMsg(t *testing.T) {
+	testCases := []struct {
+		testName         string
+		contentAppealMsg ContentAppealMsg
+		expectedError    sdk.Error
+	}{
+		{
+			testName:         "normal case",
+			contentAppealMsg: NewContentAppealMsg("user1", 1, "reason"),
+			expectedError:    nil,
+		},
+		{
+			testName:         "invalid username",
+			contentAppealMsg: NewContentAppealMsg("", 1, "reason"),
+			expectedError:    ErrInvalidUsername(),
+		},
+		{
+			testName:         "utf8 reason is too long",
+			contentAppealMsg: NewContentAppealMsg("user1", 1, tooLongOfUTF8Reason),
+			expectedError:    ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.contentAppealMsg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
+func TestAccountFreezeMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		msg           types.Msg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal freeze",
+			msg:           NewAccountFreezeMsg("user1", "user2", "reason"),
+			expectedError: nil,
+		},
+		{
+			testName:      "normal unfreeze",
+			msg:           NewAccountUnfreezeMsg("user1", "user2", "reason"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid creator",
+			msg:           NewAccountFreezeMsg("", "user2", "reason"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid username to freeze",
+			msg:           NewAccountFreezeMsg("user1", "", "reason"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid username to unfreeze",
+			msg:           NewAccountUnfreezeMsg("user1", "", "reason"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "utf8 reason is too long",
+			msg:           NewAccountFreezeMsg("user1", "user2", tooLongOfUTF8Reason),
+			expectedError: ErrReasonTooLong(),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.msg.ValidateBasic()
+		if !assert.Equal(t, tc.expectedError, result) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestChangeGlobalAllocationParamMsg(t *testing.T) {
 	p1 := param.GlobalAllocationParam{
 		GlobalGrowthRate:         types.NewDecFromRat(98, 1000),
@@ -73,6 +268,7 @@ func TestChangeGlobalAllocationParamMsg(t *testing.T) {
 		ContentCreatorAllocation: types.NewDecFromRat(55, 100),
 		DeveloperAllocation:      types.NewDecFromRat(20, 100),
 		ValidatorAllocation:      types.NewDecFromRat(5, 100),
+		TreasuryAllocation:       sdk.ZeroDec(),
 	}
 	p2 := p1
 	p2.DeveloperAllocation = types.NewDecFromRat(25, 100)
@@ -80,6 +276,14 @@ func TestChangeGlobalAllocationParamMsg(t *testing.T) {
 	p3 := p1
 	p3.GlobalGrowthRate = types.NewDecFromRat(1, 10)
 
+	p4 := p1
+	p4.DeveloperAllocation = types.NewDecFromRat(15, 100)
+	p4.TreasuryAllocation = types.NewDecFromRat(5, 100)
+
+	p5 := p4
+	p5.DeveloperAllocation = types.NewDecFromRat(25, 100)
+	p5.TreasuryAllocation = types.NewDecFromRat(-5, 100)
+
 	testCases := []struct {
 		testName                       string
 		ChangeGlobalAllocationParamMsg ChangeGlobalAllocationParamMsg
@@ -100,6 +304,16 @@ func TestChangeGlobalAllocationParamMsg(t *testing.T) {
 			ChangeGlobalAllocationParamMsg: NewChangeGlobalAllocationParamMsg("user1", p3, ""),
 			expectedError:                  ErrIllegalParameter(),
 		},
+		{
+			testName:                       "treasury allocation",
+			ChangeGlobalAllocationParamMsg: NewChangeGlobalAllocationParamMsg("user1", p4, ""),
+			expectedError:                  nil,
+		},
+		{
+			testName:                       "negative treasury allocation is illegal",
+			ChangeGlobalAllocationParamMsg: NewChangeGlobalAllocationParamMsg("user1", p5, ""),
+			expectedError:                  ErrIllegalParameter(),
+		},
 		{
 			testName:                       "empty username is illegal",
 			ChangeGlobalAllocationParamMsg: NewChangeGlobalAllocationParamMsg("", p1, ""),
@@ -129,8 +343,12 @@ func TestChangeGlobalAllocationParamMsg(t *testing.T) {
 
 func TestChangeInfraInternalAllocationParamMsg(t *testing.T) {
 	p1 := param.InfraInternalAllocationParam{
-		CDNAllocation:     types.NewDecFromRat(20, 100),
-		StorageAllocation: types.NewDecFromRat(80, 100),
+		CDNAllocation:                      types.NewDecFromRat(20, 100),
+		StorageAllocation:                  types.NewDecFromRat(80, 100),
+		InfraProviderMinDeposit:            types.NewCoinFromInt64(1000000 * types.Decimals),
+		InfraProviderCoinReturnIntervalSec: int64(7 * 24 * 3600),
+		InfraProviderCoinReturnTimes:       int64(7),
+		InfraProviderDisputePenalty:        types.NewCoinFromInt64(10000 * types.Decimals),
 	}
 
 	p2 := p1
@@ -140,6 +358,12 @@ func TestChangeInfraInternalAllocationParamMsg(t *testing.T) {
 	p3.StorageAllocation = types.NewDecFromRat(-1, 100)
 	p3.CDNAllocation = types.NewDecFromRat(101, 100)
 
+	p4 := p1
+	p4.InfraProviderCoinReturnTimes = 0
+
+	p5 := p1
+	p5.InfraProviderMinDeposit = types.NewCoinFromInt64(0)
+
 	testCases := []struct {
 		testName                              string
 		ChangeInfraInternalAllocationParamMsg ChangeInfraInternalAllocationParamMsg
@@ -160,6 +384,16 @@ func TestChangeInfraInternalAllocationParamMsg(t *testing.T) {
 			ChangeInfraInternalAllocationParamMsg: NewChangeInfraInternalAllocationParamMsg("user1", p3, ""),
 			expectedError:                         ErrIllegalParameter(),
 		},
+		{
+			testName:                              "illegal parameter (zero coin return times)",
+			ChangeInfraInternalAllocationParamMsg: NewChangeInfraInternalAllocationParamMsg("user1", p4, ""),
+			expectedError:                         ErrIllegalParameter(),
+		},
+		{
+			testName:                              "illegal parameter (zero provider min deposit)",
+			ChangeInfraInternalAllocationParamMsg: NewChangeInfraInternalAllocationParamMsg("user1", p5, ""),
+			expectedError:                         ErrIllegalParameter(),
+		},
 		{
 			testName:                              "empty username is illegal",
 			ChangeInfraInternalAllocationParamMsg: NewChangeInfraInternalAllocationParamMsg("", p1, ""),
@@ -194,6 +428,8 @@ func TestChangeVoteParamMsg(t *testing.T) {
 		VoterCoinReturnTimes:           int64(7),
 		DelegatorCoinReturnIntervalSec: int64(7 * 24 * 3600),
 		DelegatorCoinReturnTimes:       int64(7),
+		RedelegationIntervalSec:        int64(7 * 24 * 3600),
+		MaxRedelegations:               int64(7),
 	}
 
 	p2 := p1
@@ -211,6 +447,12 @@ func TestChangeVoteParamMsg(t *testing.T) {
 	p6 := p1
 	p6.DelegatorCoinReturnTimes = int64(0)
 
+	p7 := p1
+	p7.RedelegationIntervalSec = int64(-1)
+
+	p8 := p1
+	p8.MaxRedelegations = int64(0)
+
 	testCases := []struct {
 		testName           string
 		ChangeVoteParamMsg ChangeVoteParamMsg
@@ -246,6 +488,16 @@ func TestChangeVoteParamMsg(t *testing.T) {
 			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p5, ""),
 			expectedError:      ErrIllegalParameter(),
 		},
+		{
+			testName:           "negative RedelegationIntervalSec is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p7, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "zero MaxRedelegations is illegal",
+			ChangeVoteParamMsg: NewChangeVoteParamMsg("user1", p8, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
 		{
 			testName:           "empty username is illegal",
 			ChangeVoteParamMsg: NewChangeVoteParamMsg("", p1, ""),
@@ -489,6 +741,29 @@ func TestChangeProposalParamMsg(t *testing.T) {
 		ProtocolUpgradePassRatio:  types.NewDecFromRat(80, 100),
 		ProtocolUpgradePassVotes:  types.NewCoinFromInt64(10000000 * types.Decimals),
 		ProtocolUpgradeMinDeposit: types.NewCoinFromInt64(1000000 * types.Decimals),
+
+		TreasurySpendDecideSec:  int64(7 * 24 * 3600),
+		TreasurySpendPassRatio:  types.NewDecFromRat(70, 100),
+		TreasurySpendPassVotes:  types.NewCoinFromInt64(1000000 * types.Decimals),
+		TreasurySpendMinDeposit: types.NewCoinFromInt64(100000 * types.Decimals),
+
+		ContentAppealWindowSec:  int64(7 * 24 * 3600),
+		ContentAppealDecideSec:  int64(7 * 24 * 3600),
+		ContentAppealPassRatio:  types.NewDecFromRat(66, 100),
+		ContentAppealPassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		ContentAppealMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+		AccountFreezeSec:        int64(30 * 24 * 3600),
+		AccountFreezeDecideSec:  int64(7 * 24 * 3600),
+		AccountFreezePassRatio:  types.NewDecFromRat(66, 100),
+		AccountFreezePassVotes:  types.NewCoinFromInt64(10000 * types.Decimals),
+		AccountFreezeMinDeposit: types.NewCoinFromInt64(100 * types.Decimals),
+
+		ProposalVetoRatio: types.NewDecFromRat(334, 1000),
+
+		ProposalDepositSec: int64(7 * 24 * 3600),
+
+		ProposalMinInitialDeposit: types.NewCoinFromInt64(10 * types.Decimals),
 	}
 
 	p2 := p1
@@ -527,6 +802,42 @@ func TestChangeProposalParamMsg(t *testing.T) {
 	p13 := p1
 	p13.ProtocolUpgradeMinDeposit = types.NewCoinFromInt64(-1000000 * types.Decimals)
 
+	p14 := p1
+	p14.ProposalVetoRatio = types.NewDecFromRat(101, 100)
+
+	p15 := p1
+	p15.ProposalDepositSec = 0
+
+	p16 := p1
+	p16.TreasurySpendDecideSec = 0
+
+	p17 := p1
+	p17.TreasurySpendPassRatio = types.NewDecFromRat(101, 100)
+
+	p18 := p1
+	p18.TreasurySpendMinDeposit = types.NewCoinFromInt64(0)
+
+	p19 := p1
+	p19.ContentAppealWindowSec = 0
+
+	p20 := p1
+	p20.ContentAppealPassVotes = types.NewCoinFromInt64(0)
+
+	p21 := p1
+	p21.ContentAppealPassRatio = types.NewDecFromRat(40, 100)
+
+	p22 := p1
+	p22.AccountFreezeSec = 0
+
+	p23 := p1
+	p23.AccountFreezeMinDeposit = types.NewCoinFromInt64(0)
+
+	p24 := p1
+	p24.AccountFreezePassRatio = types.NewDecFromRat(101, 100)
+
+	p25 := p1
+	p25.ProposalMinInitialDeposit = types.NewCoinFromInt64(0)
+
 	testCases := []struct {
 		testName               string
 		ChangeProposalParamMsg ChangeProposalParamMsg
@@ -602,6 +913,66 @@ func TestChangeProposalParamMsg(t *testing.T) {
 			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p13, ""),
 			expectedError:          ErrIllegalParameter(),
 		},
+		{
+			testName:               "illegal veto ratio",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p14, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero ProposalDepositSec is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p15, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero TreasurySpendDecideSec is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p16, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "TreasurySpendPassRatio larger than one is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p17, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero TreasurySpendMinDeposit is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p18, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero ContentAppealWindowSec is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p19, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero ContentAppealPassVotes is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p20, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "ContentAppealPassRatio lower than ContentCensorshipPassRatio is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p21, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero AccountFreezeSec is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p22, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero AccountFreezeMinDeposit is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p23, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "AccountFreezePassRatio larger than one is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p24, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
+		{
+			testName:               "zero ProposalMinInitialDeposit is illegal",
+			ChangeProposalParamMsg: NewChangeProposalParamMsg("user1", p25, ""),
+			expectedError:          ErrIllegalParameter(),
+		},
 		{
 			testName: "reason is too long",
 			ChangeProposalParamMsg: NewChangeProposalParamMsg(
@@ -799,6 +1170,24 @@ func TestChangePostParamMsg(t *testing.T) {
 	p3 := p1
 	p3.PostIntervalSec = int64(-1)
 
+	p4 := p1
+	p4.BatchViewIntervalSec = int64(-1)
+
+	p5 := p1
+	p5.MaxContentLength = int64(types.MaxPostContentLength + 1)
+
+	p6 := p1
+	p6.DonationRefundWindowSec = int64(-1)
+
+	p7 := p1
+	p7.MaxCommentDepth = int64(-1)
+
+	p8 := p1
+	p8.MaxBatchViewCount = int64(types.MaximumBatchViewCount + 1)
+
+	p9 := p1
+	p9.DonationRefundWindowSec = int64(types.MaximumDonationRefundWindowSec + 1)
+
 	testCases := []struct {
 		testName           string
 		changePostParamMsg ChangePostParamMsg
@@ -819,6 +1208,36 @@ func TestChangePostParamMsg(t *testing.T) {
 			changePostParamMsg: NewChangePostParamMsg("user1", p3, ""),
 			expectedError:      ErrIllegalParameter(),
 		},
+		{
+			testName:           "illegal batch view interval",
+			changePostParamMsg: NewChangePostParamMsg("user1", p4, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "content length exceeds hard limit",
+			changePostParamMsg: NewChangePostParamMsg("user1", p5, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "illegal donation refund window",
+			changePostParamMsg: NewChangePostParamMsg("user1", p6, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "illegal max comment depth",
+			changePostParamMsg: NewChangePostParamMsg("user1", p7, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "max batch view count exceeds hard limit",
+			changePostParamMsg: NewChangePostParamMsg("user1", p8, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
+		{
+			testName:           "donation refund window exceeds hard limit",
+			changePostParamMsg: NewChangePostParamMsg("user1", p9, ""),
+			expectedError:      ErrIllegalParameter(),
+		},
 		{
 			testName:           "username too short",
 			changePostParamMsg: NewChangePostParamMsg("us", p1, ""),
@@ -847,36 +1266,42 @@ func TestDeletePostContentMsg(t *testing.T) {
 	}{
 		{
 			testName:             "normal case",
-			deletePostContentMsg: NewDeletePostContentMsg("user1", "permlink", "reason"),
+			deletePostContentMsg: NewDeletePostContentMsg("user1", "permlink", 0, "reason"),
 			expectedError:        nil,
 		},
 		{
 			testName:             "too short username is illegal",
-			deletePostContentMsg: NewDeletePostContentMsg("us", "permlink", "reason"),
+			deletePostContentMsg: NewDeletePostContentMsg("us", "permlink", 0, "reason"),
 			expectedError:        ErrInvalidUsername(),
 		},
 		{
 			testName:             "too long username is illegal",
-			deletePostContentMsg: NewDeletePostContentMsg("user1user1user1user1user1user1", "permlink", "reason"),
+			deletePostContentMsg: NewDeletePostContentMsg("user1user1user1user1user1user1", "permlink", 0, "reason"),
 			expectedError:        ErrInvalidUsername(),
 		},
 		{
 			testName:             "empty permlink is illegal",
-			deletePostContentMsg: NewDeletePostContentMsg("user1", "", "reason"),
+			deletePostContentMsg: NewDeletePostContentMsg("user1", "", 0, "reason"),
 			expectedError:        ErrInvalidPermlink(),
 		},
 		{
 			testName: "reason is too long",
 			deletePostContentMsg: NewDeletePostContentMsg(
-				"user1", "permlink", string(make([]byte, types.MaximumLengthOfProposalReason+1))),
+				"user1", "permlink", 0, string(make([]byte, types.MaximumLengthOfProposalReason+1))),
 			expectedError: ErrReasonTooLong(),
 		},
 		{
 			testName: "utf8 reason is too long",
 			deletePostContentMsg: NewDeletePostContentMsg(
-				"user1", "permlink", tooLongOfUTF8Reason),
+				"user1", "permlink", 0, tooLongOfUTF8Reason),
 			expectedError: ErrReasonTooLong(),
 		},
+		{
+			testName: "negative revision",
+			deletePostContentMsg: NewDeletePostContentMsg(
+				"user1", "permlink", -1, "reason"),
+			expectedError: ErrInvalidRevision(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -942,7 +1367,7 @@ func TestMsgPermission(t *testing.T) {
 		{
 			testName: "delete post content msg",
 			msg: NewDeletePostContentMsg(
-				"creator", "perm_link", "reason"),
+				"creator", "perm_link", 0, "reason"),
 			expectPermission: types.TransactionPermission,
 		},
 		{
@@ -1006,7 +1431,32 @@ func TestMsgPermission(t *testing.T) {
 		},
 		{
 			testName:         "vote proposal msg",
-			msg:              NewVoteProposalMsg("voter", 1, true),
+			msg:              NewVoteProposalMsg("voter", 1, types.VoteOptionYes),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "deposit proposal msg",
+			msg:              NewDepositProposalMsg("depositor", 1, "1"),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "treasury spend msg",
+			msg:              NewTreasurySpendMsg("creator", "recipient", "1", 1, 0, ""),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "content appeal msg",
+			msg:              NewContentAppealMsg("author", 1, ""),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "account freeze msg",
+			msg:              NewAccountFreezeMsg("creator", "user", ""),
+			expectPermission: types.TransactionPermission,
+		},
+		{
+			testName:         "account unfreeze msg",
+			msg:              NewAccountUnfreezeMsg("creator", "user", ""),
 			expectPermission: types.TransactionPermission,
 		},
 	}
@@ -1028,7 +1478,7 @@ func TestGetSignBytes(t *testing.T) {
 		{
 			testName: "delete post content msg",
 			msg: NewDeletePostContentMsg(
-				"creator", "perm_link", "reason"),
+				"creator", "perm_link", 0, "reason"),
 		},
 		{
 			testName: "upgrade protocol msg",
@@ -1081,7 +1531,27 @@ func TestGetSignBytes(t *testing.T) {
 		},
 		{
 			testName: "vote proposal msg",
-			msg:      NewVoteProposalMsg("voter", 1, true),
+			msg:      NewVoteProposalMsg("voter", 1, types.VoteOptionYes),
+		},
+		{
+			testName: "deposit proposal msg",
+			msg:      NewDepositProposalMsg("depositor", 1, "1"),
+		},
+		{
+			testName: "treasury spend msg",
+			msg:      NewTreasurySpendMsg("creator", "recipient", "1", 1, 0, ""),
+		},
+		{
+			testName: "content appeal msg",
+			msg:      NewContentAppealMsg("author", 1, ""),
+		},
+		{
+			testName: "account freeze msg",
+			msg:      NewAccountFreezeMsg("creator", "user", ""),
+		},
+		{
+			testName: "account unfreeze msg",
+			msg:      NewAccountUnfreezeMsg("creator", "user", ""),
 		},
 	}
 
@@ -1099,7 +1569,7 @@ func TestGetSigners(t *testing.T) {
 		{
 			testName: "delete post content msg",
 			msg: NewDeletePostContentMsg(
-				"creator", "perm_link", "reason"),
+				"creator", "perm_link", 0, "reason"),
 			expectSigners: []types.AccountKey{"creator"},
 		},
 		{
@@ -1163,9 +1633,34 @@ func TestGetSigners(t *testing.T) {
 		},
 		{
 			testName:      "vote proposal msg",
-			msg:           NewVoteProposalMsg("voter", 1, true),
+			msg:           NewVoteProposalMsg("voter", 1, types.VoteOptionYes),
 			expectSigners: []types.AccountKey{"voter"},
 		},
+		{
+			testName:      "deposit proposal msg",
+			msg:           NewDepositProposalMsg("depositor", 1, "1"),
+			expectSigners: []types.AccountKey{"depositor"},
+		},
+		{
+			testName:      "treasury spend msg",
+			msg:           NewTreasurySpendMsg("creator", "recipient", "1", 1, 0, ""),
+			expectSigners: []types.AccountKey{"creator"},
+		},
+		{
+			testName:      "content appeal msg",
+			msg:           NewContentAppealMsg("author", 1, ""),
+			expectSigners: []types.AccountKey{"author"},
+		},
+		{
+			testName:      "account freeze msg",
+			msg:           NewAccountFreezeMsg("creator", "user", ""),
+			expectSigners: []types.AccountKey{"creator"},
+		},
+		{
+			testName:      "account unfreeze msg",
+			msg:           NewAccountUnfreezeMsg("creator", "user", ""),
+			expectSigners: []types.AccountKey{"creator"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1,10 +1,17 @@
 package proposal
 
 import (
+	"strconv"
+	"strings"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/proposal/model"
+	"github.com/lino-network/lino/x/vote"
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	val "github.com/lino-network/lino/x/validator"
 )
 
 const (
@@ -17,14 +24,92 @@ const (
 	// QuerierRoute is the querier route for gov
 	QuerierRoute = ModuleName
 
-	QueryNextProposal    = "next"
-	QueryOngoingProposal = "ongoing"
-	QueryExpiredProposal = "expired"
+	QueryNextProposal       = "next"
+	QueryOngoingProposal    = "ongoing"
+	QueryExpiredProposal    = "expired"
+	QueryDepositingProposal = "depositing"
+	QueryProposalDeposits   = "deposits"
+	QueryProposalList       = "list"
+	QueryProposalTally      = "tally"
+	QueryProposalVotes      = "votes"
+	QueryTreasurySpends     = "treasurySpends"
+	QueryAccountFreeze      = "accountFreeze"
+	QueryAccountFreezes     = "accountFreezes"
+	QueryPendingVotes       = "pendingVotes"
+
+	// filters of proposal list query, in the form of <filter>=<value>
+	ProposalTypeFilter    = "type"
+	ProposalResultFilter  = "result"
+	ProposalCreatorFilter = "creator"
+
+	// maxProposalListLimit - maximum number of proposals returned by a list query
+	maxProposalListLimit = 100
+	// maxProposalVotesLimit - maximum number of votes returned by a votes query
+	maxProposalVotesLimit = 100
+	// maxTreasurySpendsLimit - maximum number of spends returned by a treasury spends query
+	maxTreasurySpendsLimit = 100
+	// maxAccountFreezesLimit - maximum number of freezes returned by an account freezes query
+	maxAccountFreezesLimit = 100
 )
 
+// ProposalFilter - filter of proposal list query, nil or empty field matches all proposals
+type ProposalFilter struct {
+	ProposalType *types.ProposalType   `json:"proposal_type"`
+	Result       *types.ProposalResult `json:"result"`
+	Creator      types.AccountKey      `json:"creator"`
+}
+
+// Match - check if proposal matches all fields of the filter
+func (filter ProposalFilter) Match(proposal model.Proposal) bool {
+	proposalInfo := proposal.GetProposalInfo()
+	if filter.ProposalType != nil {
+		proposalType, err := getProposalType(proposal)
+		if err != nil || proposalType != *filter.ProposalType {
+			return false
+		}
+	}
+	if filter.Result != nil && proposalInfo.Result != *filter.Result {
+		return false
+	}
+	if filter.Creator != "" && proposalInfo.Creator != filter.Creator {
+		return false
+	}
+	return true
+}
+
+// ProposalTally - tally of a proposal with its pass requirement and time remaining for voting
+type ProposalTally struct {
+	ProposalID    types.ProposalKey    `json:"proposal_id"`
+	ProposalType  types.ProposalType   `json:"proposal_type"`
+	IsOngoing     bool                 `json:"is_ongoing"`
+	AgreeVotes    types.Coin           `json:"agree_votes"`
+	DisagreeVotes types.Coin           `json:"disagree_votes"`
+	AbstainVotes  types.Coin           `json:"abstain_votes"`
+	VetoVotes     types.Coin           `json:"veto_votes"`
+	TotalVotes    types.Coin           `json:"total_votes"`
+	AgreeRatio    sdk.Dec              `json:"agree_ratio"`
+	VetoRatio     sdk.Dec              `json:"veto_ratio"`
+	PassRatio     sdk.Dec              `json:"pass_ratio"`
+	PassVotes     types.Coin           `json:"pass_votes"`
+	VetoThreshold sdk.Dec              `json:"veto_threshold"`
+	Result        types.ProposalResult `json:"result"`
+	TimeRemaining int64                `json:"time_remaining"`
+}
+
+// PendingVotes - oncall validators who haven't voted on an ongoing proposal,
+// VoteRequired is true if validators who didn't vote will be punished
+type PendingVotes struct {
+	ProposalID        types.ProposalKey  `json:"proposal_id"`
+	ProposalType      types.ProposalType `json:"proposal_type"`
+	ExpiredAt         int64              `json:"expired_at"`
+	VoteRequired      bool               `json:"vote_required"`
+	PendingValidators []types.AccountKey `json:"pending_validators"`
+}
+
 // creates a querier for proposal REST endpoints
-func NewQuerier(pm ProposalManager) sdk.Querier {
+func NewQuerier(pm ProposalManager, vm vote.VoteManager, valManager val.ValidatorManager) sdk.Querier {
 	cdc := wire.New()
+	model.RegisterWire(cdc)
 	wire.RegisterCrypto(cdc)
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
@@ -32,6 +117,24 @@ func NewQuerier(pm ProposalManager) sdk.Querier {
 			return queryOngoingProposal(ctx, cdc, path[1:], req, pm)
 		case QueryExpiredProposal:
 			return queryExpiredProposal(ctx, cdc, path[1:], req, pm)
+		case QueryDepositingProposal:
+			return queryDepositingProposal(ctx, cdc, path[1:], req, pm)
+		case QueryProposalDeposits:
+			return queryProposalDeposits(ctx, cdc, path[1:], req, pm)
+		case QueryProposalList:
+			return queryProposalList(ctx, cdc, path[1:], req, pm)
+		case QueryProposalTally:
+			return queryProposalTally(ctx, cdc, path[1:], req, pm, vm)
+		case QueryProposalVotes:
+			return queryProposalVotes(ctx, cdc, path[1:], req, pm, vm)
+		case QueryTreasurySpends:
+			return queryTreasurySpends(ctx, cdc, path[1:], req, pm)
+		case QueryAccountFreeze:
+			return queryAccountFreeze(ctx, cdc, path[1:], req, pm)
+		case QueryAccountFreezes:
+			return queryAccountFreezes(ctx, cdc, path[1:], req, pm)
+		case QueryPendingVotes:
+			return queryPendingVotes(ctx, cdc, path[1:], req, pm, vm, valManager)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown proposal query endpoint")
 		}
@@ -67,3 +170,296 @@ func queryExpiredProposal(ctx sdk.Context, cdc *wire.Codec, path []string, req a
 	}
 	return res, nil
 }
+
+func queryDepositingProposal(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	proposal, err := pm.storage.GetDepositingProposal(ctx, types.ProposalKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(proposal)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryProposalDeposits(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	proposalID := types.ProposalKey(path[0])
+	if !pm.DoesProposalExist(ctx, proposalID) {
+		return nil, model.ErrProposalNotFound()
+	}
+	deposits, err := pm.GetProposalDeposits(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(deposits)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryProposalList - path is ongoing or expired with optional offset, limit and filters,
+// limit is capped by maxProposalListLimit
+func queryProposalList(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	var isExpired bool
+	switch path[0] {
+	case QueryOngoingProposal:
+		isExpired = false
+	case QueryExpiredProposal:
+		isExpired = true
+	default:
+		return nil, ErrQueryFailed()
+	}
+	offset, limit, err := parseOffsetAndLimit(path[1:], maxProposalListLimit)
+	if err != nil {
+		return nil, err
+	}
+	filter := ProposalFilter{}
+	if len(path) > 3 {
+		if filter, err = parseProposalFilter(path[3:]); err != nil {
+			return nil, err
+		}
+	}
+	proposals, err := pm.GetProposalList(ctx, isExpired, filter, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(proposals)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryProposalTally - path is proposal ID, tally of ongoing proposal is
+// computed with current voting power of voters
+func queryProposalTally(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager, vm vote.VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	proposalID := types.ProposalKey(path[0])
+	votes, err := vm.GetProposalVotes(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	tally, err := pm.GetProposalTally(ctx, proposalID, votes)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(tally)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryProposalVotes - path is proposal ID with optional offset and limit,
+// limit is capped by maxProposalVotesLimit
+func queryProposalVotes(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager, vm vote.VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	proposalID := types.ProposalKey(path[0])
+	if !pm.DoesProposalExist(ctx, proposalID) {
+		return nil, model.ErrProposalNotFound()
+	}
+	offset, limit, err := parseOffsetAndLimit(path[1:], maxProposalVotesLimit)
+	if err != nil {
+		return nil, err
+	}
+	votes, err := vm.GetAllVotes(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	if offset > int64(len(votes)) {
+		offset = int64(len(votes))
+	}
+	votes = votes[offset:]
+	if limit < int64(len(votes)) {
+		votes = votes[:limit]
+	}
+	res, marshalErr := cdc.MarshalJSON(votes)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryTreasurySpends - path is optional offset and limit,
+// limit is capped by maxTreasurySpendsLimit
+func queryTreasurySpends(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager) ([]byte, sdk.Error) {
+	offset, limit, err := parseOffsetAndLimit(path, maxTreasurySpendsLimit)
+	if err != nil {
+		return nil, err
+	}
+	spends, err := pm.GetTreasurySpends(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if offset > int64(len(spends)) {
+		offset = int64(len(spends))
+	}
+	spends = spends[offset:]
+	if limit < int64(len(spends)) {
+		spends = spends[:limit]
+	}
+	res, marshalErr := cdc.MarshalJSON(spends)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryAccountFreeze - path is username, return error if the account is not frozen now
+func queryAccountFreeze(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	username := types.AccountKey(path[0])
+	freeze, err := pm.GetAccountFreeze(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if freeze == nil {
+		return nil, ErrAccountNotFrozen(username)
+	}
+	res, marshalErr := cdc.MarshalJSON(freeze)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryAccountFreezes - path is optional offset and limit,
+// only accounts frozen now are returned and limit is capped by maxAccountFreezesLimit
+func queryAccountFreezes(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager) ([]byte, sdk.Error) {
+	offset, limit, err := parseOffsetAndLimit(path, maxAccountFreezesLimit)
+	if err != nil {
+		return nil, err
+	}
+	freezes, err := pm.GetAccountFreezes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if offset > int64(len(freezes)) {
+		offset = int64(len(freezes))
+	}
+	freezes = freezes[offset:]
+	if limit < int64(len(freezes)) {
+		freezes = freezes[:limit]
+	}
+	res, marshalErr := cdc.MarshalJSON(freezes)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// queryPendingVotes - validators who haven't voted on each ongoing proposal
+func queryPendingVotes(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, pm ProposalManager, vm vote.VoteManager, valManager val.ValidatorManager) ([]byte, sdk.Error) {
+	pendingVotes, err := pm.GetPendingVotes(ctx, vm, valManager)
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(pendingVotes)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+// parseOffsetAndLimit - parse optional offset and limit from path, limit is capped by maxLimit
+func parseOffsetAndLimit(path []string, maxLimit int64) (int64, int64, sdk.Error) {
+	offset, limit := int64(0), maxLimit
+	var parseErr error
+	if len(path) > 0 {
+		if offset, parseErr = strconv.ParseInt(path[0], 10, 64); parseErr != nil || offset < 0 {
+			return 0, 0, ErrQueryFailed()
+		}
+	}
+	if len(path) > 1 {
+		if limit, parseErr = strconv.ParseInt(path[1], 10, 64); parseErr != nil || limit <= 0 {
+			return 0, 0, ErrQueryFailed()
+		}
+		if limit > maxLimit {
+			limit = maxLimit
+		}
+	}
+	return offset, limit, nil
+}
+
+// parseProposalFilter - parse filters in the form of <filter>=<value>
+func parseProposalFilter(path []string) (ProposalFilter, sdk.Error) {
+	filter := ProposalFilter{}
+	for _, segment := range path {
+		kv := strings.SplitN(segment, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return filter, ErrQueryFailed()
+		}
+		switch kv[0] {
+		case ProposalTypeFilter:
+			proposalType, err := parseProposalType(kv[1])
+			if err != nil {
+				return filter, err
+			}
+			filter.ProposalType = &proposalType
+		case ProposalResultFilter:
+			result, err := parseProposalResult(kv[1])
+			if err != nil {
+				return filter, err
+			}
+			filter.Result = &result
+		case ProposalCreatorFilter:
+			filter.Creator = types.AccountKey(kv[1])
+		default:
+			return filter, ErrQueryFailed()
+		}
+	}
+	return filter, nil
+}
+
+func parseProposalType(proposalType string) (types.ProposalType, sdk.Error) {
+	switch proposalType {
+	case "change_param":
+		return types.ChangeParam, nil
+	case "content_censorship":
+		return types.ContentCensorship, nil
+	case "protocol_upgrade":
+		return types.ProtocolUpgrade, nil
+	case "treasury_spend":
+		return types.TreasurySpend, nil
+	case "content_appeal":
+		return types.ContentAppeal, nil
+	case "account_freeze":
+		return types.AccountFreeze, nil
+	case "account_unfreeze":
+		return types.AccountUnfreeze, nil
+	}
+	return 0, ErrIncorrectProposalType()
+}
+
+func parseProposalResult(result string) (types.ProposalResult, sdk.Error) {
+	switch result {
+	case "not_pass":
+		return types.ProposalNotPass, nil
+	case "pass":
+		return types.ProposalPass, nil
+	case "revoked":
+		return types.ProposalRevoked, nil
+	case "vetoed":
+		return types.ProposalVetoed, nil
+	case "deposit_not_met":
+		return types.ProposalDepositNotMet, nil
+	}
+	return 0, ErrQueryFailed()
+}
@@ -55,6 +55,7 @@ func setupTest(t *testing.T, height int64) (
 	cdc.RegisterConcrete(acc.ReturnCoinEvent{}, "1", nil)
 	cdc.RegisterConcrete(param.ChangeParamEvent{}, "2", nil)
 	cdc.RegisterConcrete(DecideProposalEvent{}, "3", nil)
+	cdc.RegisterConcrete(ExpireProposalDepositEvent{}, "4", nil)
 
 	err := initGlobalManager(ctx, globalManager)
 	assert.Nil(t, err)
@@ -107,15 +108,15 @@ func createTestPost(
 
 	err = pm.CreatePost(
 		ctx, msg.Author, msg.PostID, msg.SourceAuthor, msg.SourcePostID,
-		msg.ParentAuthor, msg.ParentPostID, msg.Content,
-		msg.Title, splitRate, msg.Links)
+		msg.ParentAuthor, msg.ParentPostID, msg.Content, msg.Title,
+		msg.ContentHash, msg.ContentURI, splitRate, msg.Links, msg.Beneficiaries, msg.Tags)
 
 	assert.Nil(t, err)
 	return user, postID
 }
 
 func addProposalInfo(ctx sdk.Context, pm ProposalManager, proposalID types.ProposalKey,
-	agreeVotes, disagreeVotes types.Coin) sdk.Error {
+	agreeVotes, disagreeVotes, abstainVotes, vetoVotes types.Coin) sdk.Error {
 	proposal, err := pm.storage.GetOngoingProposal(ctx, proposalID)
 	if err != nil {
 		return err
@@ -124,6 +125,8 @@ func addProposalInfo(ctx sdk.Context, pm ProposalManager, proposalID types.Propo
 	proposalInfo := proposal.GetProposalInfo()
 	proposalInfo.AgreeVotes = agreeVotes
 	proposalInfo.DisagreeVotes = disagreeVotes
+	proposalInfo.AbstainVotes = abstainVotes
+	proposalInfo.VetoVotes = vetoVotes
 
 	proposal.SetProposalInfo(proposalInfo)
 
@@ -7,8 +7,13 @@ import (
 // Register concrete types on wire codec
 func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(VoteProposalMsg{}, "lino/voteProposal", nil)
+	cdc.RegisterConcrete(DepositProposalMsg{}, "lino/depositProposal", nil)
 	cdc.RegisterConcrete(DeletePostContentMsg{}, "lino/deletePostContent", nil)
 	cdc.RegisterConcrete(UpgradeProtocolMsg{}, "lino/upgradeProtocol", nil)
+	cdc.RegisterConcrete(TreasurySpendMsg{}, "lino/treasurySpend", nil)
+	cdc.RegisterConcrete(ContentAppealMsg{}, "lino/contentAppeal", nil)
+	cdc.RegisterConcrete(AccountFreezeMsg{}, "lino/accountFreeze", nil)
+	cdc.RegisterConcrete(AccountUnfreezeMsg{}, "lino/accountUnfreeze", nil)
 	cdc.RegisterConcrete(ChangeGlobalAllocationParamMsg{}, "lino/changeGlobalAllocation", nil)
 	cdc.RegisterConcrete(ChangeInfraInternalAllocationParamMsg{}, "lino/changeInfraAllocation", nil)
 	cdc.RegisterConcrete(ChangeVoteParamMsg{}, "lino/changeVoteParam", nil)
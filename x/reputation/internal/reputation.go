@@ -8,7 +8,11 @@ import (
 
 type Reputation interface {
 	DonateAt(u Uid, p Pid, s Stake) Dp
+	// undo a donation made in round started at @p roundStartAt.
+	RefundAt(u Uid, p Pid, s Stake, dp Dp, roundStartAt Time)
 	ReportAt(u Uid, p Pid) Rep
+	// undo the report of @p u on @p p, when the report is replaced.
+	UnreportAt(u Uid, p Pid) Rep
 	// user's freescore += @p r, NOTE: unit is COIN.
 	IncFreeScore(u Uid, r Rep)
 	Update(t Time) // called every endblocker.
@@ -119,6 +123,28 @@ func (rep ReputationImpl) DonateAt(u Uid, p Pid, s Stake) Dp {
 	return dp
 }
 
+// RefundAt - undo the donation of @p u on @p p with stake @p s, which got @p dp.
+// Donation made in a settled round only returns the dp used on the post, round
+// result is kept. Keys bought are kept as well.
+func (rep ReputationImpl) RefundAt(u Uid, p Pid, s Stake, dp Dp, roundStartAt Time) {
+	if len(u) == 0 {
+		panic("Uid must be longer than 0")
+	}
+	if len(p) == 0 {
+		panic("Pid must be longer than 0")
+	}
+	var usedDp Dp = rep.store.GetUserDonatedOn(u, p)
+	rep.store.SetUserDonatedOn(u, p, bigIntMax(bigIntSub(usedDp, dp), BigIntZero))
+	var current RoundId = rep.store.GetCurrentRound()
+	if rep.store.GetRoundStartAt(current) != roundStartAt {
+		return
+	}
+	negDp := new(big.Int).Neg(dp)
+	rep.incPostSumDp(current, p, negDp)
+	rep.incRoundSumDp(current, negDp)
+	rep.incPostSumStake(current, p, new(big.Int).Neg(s))
+}
+
 // buy keys of post @p p for user @p u using @p dp.
 func (rep ReputationImpl) buyKey(roundId RoundId, u Uid, p Pid, stake Stake) {
 	numKeysSold := rep.store.GetRoundNumKeysSold(roundId, p)
@@ -249,6 +275,16 @@ func (rep ReputationImpl) ReportAt(u Uid, p Pid) Rep {
 	return sumRep
 }
 
+// add back the reputation deducted by the last report of the user.
+func (rep ReputationImpl) UnreportAt(u Uid, p Pid) Rep {
+	sumRep := rep.store.GetSumRep(p)
+	oldRep := rep.store.GetUserLastReport(u, p)
+	sumRep.Add(sumRep, oldRep)
+	rep.store.SetSumRep(p, sumRep)
+	rep.store.SetUserLastReport(u, p, big.NewInt(0))
+	return sumRep
+}
+
 func (rep ReputationImpl) GetCurrentRound() (RoundId, Time) {
 	rid := rep.store.GetCurrentRound()
 	startAt := rep.store.GetRoundStartAt(rid)
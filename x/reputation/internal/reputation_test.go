@@ -163,6 +163,13 @@ func TestReportAt(t *testing.T) {
 	rst := rep.ReportAt(user1, post1)
 	assert.Equal(big.NewInt(-OneLinoCoin), rep.GetSumRep(post1))
 	assert.Equal(big.NewInt(-OneLinoCoin), rst)
+
+	rst = rep.UnreportAt(user1, post1)
+	assert.Equal(big.NewInt(0), rep.GetSumRep(post1))
+	assert.Equal(big.NewInt(0), rst)
+	// report again after unreport deducts the full reputation
+	rst = rep.ReportAt(user1, post1)
+	assert.Equal(big.NewInt(-OneLinoCoin), rst)
 }
 
 func TestDonationNoLessThanInit(t *testing.T) {
@@ -216,6 +223,27 @@ func TestDonationReturnDp1(t *testing.T) {
 	assert.Equal(big.NewInt(OneLinoCoin), dp3)
 }
 
+func TestRefundReturnDp(t *testing.T) {
+	assert := assert.New(t)
+	store := newReputationStoreOnMock()
+	rep := NewTestReputationImpl(store)
+	user1 := "user1"
+	post1 := "post1"
+
+	_, roundStartAt := rep.GetCurrentRound()
+	current := store.GetCurrentRound()
+	dp1 := rep.DonateAt(user1, post1, big.NewInt(OneLinoCoin))
+	assert.Equal(big.NewInt(OneLinoCoin), dp1)
+	rep.RefundAt(user1, post1, big.NewInt(OneLinoCoin), dp1, roundStartAt)
+	assert.Equal(0, store.GetRoundPostSumDp(current, post1).Cmp(BigIntZero))
+	assert.Equal(0, store.GetRoundSumDp(current).Cmp(BigIntZero))
+	assert.Equal(0, store.GetRoundPostSumStake(current, post1).Cmp(BigIntZero))
+
+	// dp used on refunded donation can be used again.
+	dp2 := rep.DonateAt(user1, post1, big.NewInt(OneLinoCoin))
+	assert.Equal(big.NewInt(OneLinoCoin), dp2)
+}
+
 func TestDonationReturnDp2(t *testing.T) {
 	assert := assert.New(t)
 	store := newReputationStoreOnMock()
@@ -80,6 +80,26 @@ func (rep ReputationManager) DonateAt(ctx sdk.Context,
 	return types.NewCoinFromBigInt(dp), nil
 }
 
+// RefundAt - undo the donation of @p username on @p post with @p coinDay, which
+// got @p dp in the round started at @p roundStartAt.
+func (rep ReputationManager) RefundAt(ctx sdk.Context,
+	username types.AccountKey, post types.Permlink, coinDay, dp types.Coin, roundStartAt int64) sdk.Error {
+	handler, err := rep.getHandler(ctx)
+	if err != nil {
+		return err
+	}
+
+	uid := string(username)
+	pid := string(post)
+	err = rep.basicCheck(uid, pid)
+	if err != nil {
+		return err
+	}
+
+	handler.RefundAt(uid, pid, coinDay.Amount.BigInt(), dp.Amount.BigInt(), roundStartAt)
+	return nil
+}
+
 // ReportAt - @p username report @p post.
 func (rep ReputationManager) ReportAt(ctx sdk.Context,
 	username types.AccountKey, post types.Permlink) (types.Coin, sdk.Error) {
@@ -98,6 +118,24 @@ func (rep ReputationManager) ReportAt(ctx sdk.Context,
 	return types.NewCoinFromBigInt(sumRep), nil
 }
 
+// UnreportAt - undo the report of @p username on @p post.
+func (rep ReputationManager) UnreportAt(ctx sdk.Context,
+	username types.AccountKey, post types.Permlink) (types.Coin, sdk.Error) {
+	handler, err := rep.getHandler(ctx)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+
+	uid := string(username)
+	pid := string(post)
+	err = rep.basicCheck(uid, pid)
+	if err != nil {
+		return types.NewCoinFromInt64(0), err
+	}
+	sumRep := handler.UnreportAt(uid, pid)
+	return types.NewCoinFromBigInt(sumRep), nil
+}
+
 func (rep ReputationManager) calcFreeScore(amount types.Coin) *big.Int {
 	score := amount.Amount.BigInt()
 	score.Mul(score, big.NewInt(15))
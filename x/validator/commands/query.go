@@ -10,6 +10,7 @@ import (
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	"github.com/lino-network/lino/client"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/validator"
 	"github.com/lino-network/lino/x/validator/model"
 )
 
@@ -39,6 +40,34 @@ func GetValidatorCmd(storeName string, cdc *wire.Codec) *cobra.Command {
 	}
 }
 
+// GetMissedVotesCmd returns missed vote penalties of a validator
+func GetMissedVotesCmd(cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "missed-votes <username>",
+		Short: "Query missed vote penalties of a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.NewCoreContextFromViper()
+			if len(args) != 1 || len(args[0]) == 0 {
+				return errors.New("You must provide a username")
+			}
+
+			res, err := ctx.QueryCustom(validator.QuerierRoute, validator.QueryMissedVotes, args[0])
+			if err != nil {
+				return err
+			}
+			missedVotes := []model.MissedVote{}
+			if err := cdc.UnmarshalJSON(res, &missedVotes); err != nil {
+				return err
+			}
+
+			if err := client.PrintIndent(missedVotes); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
 type commander struct {
 	storeName string
 	cdc       *wire.Codec
@@ -140,7 +140,7 @@ func returnCoinTo(
 	times int64, interval int64, coin types.Coin) sdk.Error {
 
 	if err := am.AddFrozenMoney(
-		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times); err != nil {
+		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times, types.ValidatorReturnCoin); err != nil {
 		return err
 	}
 
@@ -291,9 +291,10 @@ func (vm ValidatorManager) FireIncompetentValidator(
 	return totalPenalty, nil
 }
 
-// PunishValidatorsDidntVote - validators are required to vote Protocol Upgrade and Parameter Change proposal
+// PunishValidatorsDidntVote - validators are required to vote Protocol Upgrade and Parameter Change proposal,
+// each penalty is recorded as a missed vote of the validator
 func (vm ValidatorManager) PunishValidatorsDidntVote(
-	ctx sdk.Context, penaltyList []types.AccountKey) (types.Coin, sdk.Error) {
+	ctx sdk.Context, proposalID types.ProposalKey, penaltyList []types.AccountKey) (types.Coin, sdk.Error) {
 	totalPenalty := types.NewCoinFromInt64(0)
 	param, err := vm.paramHolder.GetValidatorParam(ctx)
 	if err != nil {
@@ -305,12 +306,25 @@ func (vm ValidatorManager) PunishValidatorsDidntVote(
 		if err != nil {
 			return totalPenalty, err
 		}
+		missedVote := &model.MissedVote{
+			ProposalID: proposalID,
+			Penalty:    actualPenalty,
+			PunishedAt: ctx.BlockHeader().Time.Unix(),
+		}
+		if err := vm.storage.SetMissedVote(ctx, validator, missedVote); err != nil {
+			return totalPenalty, err
+		}
 		totalPenalty = totalPenalty.Plus(actualPenalty)
 	}
 
 	return totalPenalty, nil
 }
 
+// GetMissedVotes - get missed vote penalties of a validator
+func (vm ValidatorManager) GetMissedVotes(ctx sdk.Context, username types.AccountKey) ([]model.MissedVote, sdk.Error) {
+	return vm.storage.GetMissedVotes(ctx, username)
+}
+
 // RegisterValidator - register validator
 func (vm ValidatorManager) RegisterValidator(
 	ctx sdk.Context, username types.AccountKey, pubKey crypto.PubKey, coin types.Coin, link string) sdk.Error {
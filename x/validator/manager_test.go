@@ -338,6 +338,47 @@ func TestPunishmentBasic(t *testing.T) {
 	assert.Equal(t, true, validator2.Deposit.IsZero())
 }
 
+func TestPunishValidatorsDidntVote(t *testing.T) {
+	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
+	handler := NewHandler(am, valManager, voteManager, &gm)
+	valManager.InitGenesis(ctx)
+
+	valParam, _ := valManager.paramHolder.GetValidatorParam(ctx)
+
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	// deposit of user1 is still enough after missed vote penalty
+	deposit := valParam.ValidatorMinCommittingDeposit.Plus(valParam.PenaltyMissVote)
+	createTestAccount(ctx, am, "user1", minBalance.Plus(deposit))
+	createTestAccount(ctx, am, "user2", minBalance.Plus(valParam.ValidatorMinCommittingDeposit))
+
+	voteManager.AddVoter(ctx, "user1", valParam.ValidatorMinVotingDeposit)
+	voteManager.AddVoter(ctx, "user2", valParam.ValidatorMinVotingDeposit)
+
+	msg1 := NewValidatorDepositMsg("user1", coinToString(deposit), secp256k1.GenPrivKey().PubKey(), "")
+	msg2 := NewValidatorDepositMsg("user2", coinToString(valParam.ValidatorMinCommittingDeposit), secp256k1.GenPrivKey().PubKey(), "")
+	handler(ctx, msg1)
+	handler(ctx, msg2)
+
+	penalty, err := valManager.PunishValidatorsDidntVote(
+		ctx, types.ProposalKey("1"), []types.AccountKey{"user1"})
+	assert.Nil(t, err)
+	assert.Equal(t, valParam.PenaltyMissVote, penalty)
+
+	// missed vote is recorded for punished validator only
+	missedVotes, err := valManager.GetMissedVotes(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, []model.MissedVote{
+		{
+			ProposalID: types.ProposalKey("1"),
+			Penalty:    valParam.PenaltyMissVote,
+			PunishedAt: ctx.BlockHeader().Time.Unix(),
+		},
+	}, missedVotes)
+	missedVotes, err = valManager.GetMissedVotes(ctx, "user2")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(missedVotes))
+}
+
 func TestPunishmentAndSubstitutionExists(t *testing.T) {
 	ctx, am, valManager, voteManager, gm := setupTest(t, 0)
 	handler := NewHandler(am, valManager, voteManager, &gm)
@@ -39,4 +39,5 @@ type ValidatorRowIR struct {
 type ValidatorTablesIR struct {
 	Validators    []ValidatorRowIR `json:"validators"`
 	ValidatorList ValidatorListRow `json:"validator_list"`
+	MissedVotes   []MissedVoteRow  `json:"missed_votes"`
 }
@@ -24,10 +24,17 @@ type ValidatorListRow struct {
 	List ValidatorList `json:"list"`
 }
 
+// MissedVoteRow - pk: (Username, ProposalID)
+type MissedVoteRow struct {
+	Username   types.AccountKey `json:"username"`
+	MissedVote MissedVote       `json:"missed_vote"`
+}
+
 // ValidatorTables state of validators
 type ValidatorTables struct {
 	Validators    []ValidatorRow   `json:"validators"`
 	ValidatorList ValidatorListRow `json:"validator_list"`
+	MissedVotes   []MissedVoteRow  `json:"missed_votes"`
 }
 
 // ToIR -
@@ -37,5 +44,6 @@ func (v ValidatorTables) ToIR() *ValidatorTablesIR {
 		rst.Validators = append(rst.Validators, v.ToIR())
 	}
 	rst.ValidatorList = v.ValidatorList
+	rst.MissedVotes = v.MissedVotes
 	return rst
 }
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"strings"
+
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -12,6 +14,7 @@ import (
 var (
 	validatorSubstore     = []byte{0x00}
 	validatorListSubstore = []byte{0x01}
+	missedVoteSubstore    = []byte{0x02}
 )
 
 type ValidatorStorage struct {
@@ -97,6 +100,35 @@ func (vs ValidatorStorage) SetValidatorList(ctx sdk.Context, lst *ValidatorList)
 	return nil
 }
 
+// SetMissedVote - record penalty of a validator who didn't vote on a proposal
+func (vs ValidatorStorage) SetMissedVote(
+	ctx sdk.Context, username types.AccountKey, missedVote *MissedVote) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	missedVoteByte, err := vs.cdc.MarshalBinaryLengthPrefixed(*missedVote)
+	if err != nil {
+		return ErrFailedToMarshalMissedVote(err)
+	}
+	store.Set(GetMissedVoteKey(username, missedVote.ProposalID), missedVoteByte)
+	return nil
+}
+
+// GetMissedVotes - get all missed vote penalties of a validator
+func (vs ValidatorStorage) GetMissedVotes(ctx sdk.Context, username types.AccountKey) ([]MissedVote, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	itr := sdk.KVStorePrefixIterator(store, getMissedVotePrefix(username))
+	defer itr.Close()
+
+	missedVotes := []MissedVote{}
+	for ; itr.Valid(); itr.Next() {
+		var missedVote MissedVote
+		if err := vs.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &missedVote); err != nil {
+			return nil, ErrFailedToUnmarshalMissedVote(err)
+		}
+		missedVotes = append(missedVotes, missedVote)
+	}
+	return missedVotes, nil
+}
+
 // Export state of validators.
 func (vs ValidatorStorage) Export(ctx sdk.Context) *ValidatorTables {
 	tables := &ValidatorTables{}
@@ -127,6 +159,24 @@ func (vs ValidatorStorage) Export(ctx sdk.Context) *ValidatorTables {
 	tables.ValidatorList = ValidatorListRow{
 		List: *list,
 	}
+	// export table.missedVotes
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, missedVoteSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			username := types.AccountKey(strings.SplitN(string(k[1:]), types.KeySeparator, 2)[0])
+			var missedVote MissedVote
+			if err := vs.cdc.UnmarshalBinaryLengthPrefixed(itr.Value(), &missedVote); err != nil {
+				panic("failed to read missed vote: " + err.Error())
+			}
+			row := MissedVoteRow{
+				Username:   username,
+				MissedVote: missedVote,
+			}
+			tables.MissedVotes = append(tables.MissedVotes, row)
+		}
+	}()
 	return tables
 }
 
@@ -162,6 +212,11 @@ func (vs ValidatorStorage) Import(ctx sdk.Context, tb *ValidatorTablesIR) {
 	// import ValidatorList
 	err := vs.SetValidatorList(ctx, &tb.ValidatorList.List)
 	check(err)
+	// import table.MissedVotes
+	for _, v := range tb.MissedVotes {
+		missedVote := v.MissedVote
+		check(vs.SetMissedVote(ctx, v.Username, &missedVote))
+	}
 }
 
 func GetValidatorKey(accKey types.AccountKey) []byte {
@@ -171,3 +226,12 @@ func GetValidatorKey(accKey types.AccountKey) []byte {
 func GetValidatorListKey() []byte {
 	return validatorListSubstore
 }
+
+func getMissedVotePrefix(username types.AccountKey) []byte {
+	return append(append(missedVoteSubstore, username...), types.KeySeparator...)
+}
+
+// GetMissedVoteKey - "missed vote substore" + "username" + "separator" + "proposal ID"
+func GetMissedVoteKey(username types.AccountKey, proposalID types.ProposalKey) []byte {
+	return append(getMissedVotePrefix(username), proposalID...)
+}
@@ -119,3 +119,43 @@ func TestValidatorList(t *testing.T) {
 		}
 	}
 }
+
+func TestMissedVote(t *testing.T) {
+	ctx, vs := setup(t)
+	user1 := types.AccountKey("user1")
+	user2 := types.AccountKey("user2")
+
+	missedVotes, err := vs.GetMissedVotes(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []MissedVote{}, missedVotes)
+
+	missedVote1 := MissedVote{
+		ProposalID: types.ProposalKey("1"),
+		Penalty:    types.NewCoinFromInt64(100),
+		PunishedAt: 1,
+	}
+	missedVote2 := MissedVote{
+		ProposalID: types.ProposalKey("2"),
+		Penalty:    types.NewCoinFromInt64(50),
+		PunishedAt: 2,
+	}
+	assert.Nil(t, vs.SetMissedVote(ctx, user1, &missedVote1))
+	assert.Nil(t, vs.SetMissedVote(ctx, user1, &missedVote2))
+	assert.Nil(t, vs.SetMissedVote(ctx, user2, &missedVote1))
+
+	missedVotes, err = vs.GetMissedVotes(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []MissedVote{missedVote1, missedVote2}, missedVotes)
+	missedVotes, err = vs.GetMissedVotes(ctx, user2)
+	assert.Nil(t, err)
+	assert.Equal(t, []MissedVote{missedVote1}, missedVotes)
+
+	// missed votes survive export and import
+	tables := vs.Export(ctx)
+	assert.Equal(t, 3, len(tables.MissedVotes))
+	ctx2, vs2 := setup(t)
+	vs2.Import(ctx2, tables.ToIR())
+	missedVotes, err = vs2.GetMissedVotes(ctx2, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, []MissedVote{missedVote1, missedVote2}, missedVotes)
+}
@@ -41,6 +41,13 @@ func (v Validator) ToIR() ValidatorIR {
 	}
 }
 
+// MissedVote - penalty of a validator who didn't vote on a proposal required to vote
+type MissedVote struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Penalty    types.Coin        `json:"penalty"`
+	PunishedAt int64             `json:"punished_at"`
+}
+
 // ValidatorList -
 type ValidatorList struct {
 	OncallValidators   []types.AccountKey `json:"oncall_validators"`
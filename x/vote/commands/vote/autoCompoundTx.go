@@ -0,0 +1,45 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/x/vote"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AutoCompoundTxCmd will create a set auto compound tx and sign it with the given key
+func AutoCompoundTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voter-auto-compound",
+		Short: "turn on or off compounding interest into lino stake",
+		RunE:  sendAutoCompoundTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "voter name")
+	cmd.Flags().Bool(client.FlagAutoCompound, true, "compound interest into lino stake")
+	return cmd
+}
+
+func sendAutoCompoundTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		user := viper.GetString(client.FlagUser)
+		// create the message
+		msg := vote.NewSetAutoCompoundMsg(user, viper.GetBool(client.FlagAutoCompound))
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, signErr := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if signErr != nil {
+			return signErr
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/lino-network/lino/client"
+	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/vote"
+
+	wire "github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultVoteTxCmd will create a set default vote policy tx and sign it with the given key
+func DefaultVoteTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "default-vote",
+		Short: "register option voted on validator's behalf for proposal it is required to vote",
+		RunE:  sendDefaultVoteTx(cdc),
+	}
+	cmd.Flags().String(client.FlagUser, "", "validator name")
+	cmd.Flags().String(client.FlagOption, "none", "default vote option: none, yes, no, abstain or no_with_veto")
+	return cmd
+}
+
+func sendDefaultVoteTx(cdc *wire.Codec) client.CommandTxCallback {
+	return func(cmd *cobra.Command, args []string) error {
+		ctx := client.NewCoreContextFromViper()
+		user := viper.GetString(client.FlagUser)
+		option, err := parseDefaultVoteOption(viper.GetString(client.FlagOption))
+		if err != nil {
+			return err
+		}
+
+		// create the message
+		msg := vote.NewSetDefaultVotePolicyMsg(user, option)
+
+		// build and sign the transaction, then broadcast to Tendermint
+		res, err := ctx.SignBuildBroadcast([]sdk.Msg{msg}, cdc)
+
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Committed at block %d. Hash: %s\n", res.Height, res.Hash.String())
+		return nil
+	}
+}
+
+func parseDefaultVoteOption(option string) (types.VoteOption, error) {
+	switch option {
+	case "none":
+		return types.VoteOptionNone, nil
+	case "yes":
+		return types.VoteOptionYes, nil
+	case "no":
+		return types.VoteOptionNo, nil
+	case "abstain":
+		return types.VoteOptionAbstain, nil
+	case "no_with_veto":
+		return types.VoteOptionNoWithVeto, nil
+	}
+	return 0, fmt.Errorf("invalid vote option: %s", option)
+}
@@ -51,3 +51,33 @@ func ErrInvalidUsername() sdk.Error {
 func ErrQueryFailed() sdk.Error {
 	return types.NewError(types.CodeVoteQueryFailed, fmt.Sprintf("query vote store failed"))
 }
+
+// ErrRedelegateToSameVoter - error if source voter and destination voter are the same
+func ErrRedelegateToSameVoter() sdk.Error {
+	return types.NewError(types.CodeRedelegateToSameVoter, fmt.Sprintf("can't redelegate to the same voter"))
+}
+
+// ErrIllegalRedelegate - error if redelegate amount is illegal
+func ErrIllegalRedelegate() sdk.Error {
+	return types.NewError(types.CodeIllegalRedelegate, fmt.Sprintf("illegal redelegate"))
+}
+
+// ErrRedelegationInFlight - error if redelegate power which is still in flight
+func ErrRedelegationInFlight(voter types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeRedelegationInFlight, fmt.Sprintf("redelegation to %v is still in flight", voter))
+}
+
+// ErrTooManyRedelegations - error if delegator has too many in-flight redelegations
+func ErrTooManyRedelegations() sdk.Error {
+	return types.NewError(types.CodeTooManyRedelegations, fmt.Sprintf("too many in-flight redelegations"))
+}
+
+// ErrInvalidDefaultVoteOption - error if default vote option is not none, yes, no, abstain or no with veto
+func ErrInvalidDefaultVoteOption() sdk.Error {
+	return types.NewError(types.CodeInvalidDefaultVoteOption, fmt.Sprintf("invalid default vote option"))
+}
+
+// ErrNotValidator - error if user is not in validator reference list
+func ErrNotValidator(username types.AccountKey) sdk.Error {
+	return types.NewError(types.CodeNotValidator, fmt.Sprintf("%v is not a validator", username))
+}
@@ -25,7 +25,13 @@ func NewHandler(vm VoteManager, am acc.AccountManager, gm *global.GlobalManager,
 		case DelegatorWithdrawMsg:
 			return handleDelegatorWithdrawMsg(ctx, vm, gm, am, rm, msg)
 		case ClaimInterestMsg:
-			return handleClaimInterestMsg(ctx, vm, gm, am, msg)
+			return handleClaimInterestMsg(ctx, vm, gm, am, rm, msg)
+		case RedelegateMsg:
+			return handleRedelegateMsg(ctx, vm, am, msg)
+		case SetAutoCompoundMsg:
+			return handleSetAutoCompoundMsg(ctx, vm, gm, am, rm, msg)
+		case SetDefaultVotePolicyMsg:
+			return handleSetDefaultVotePolicyMsg(ctx, vm, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized vote msg type: %v", reflect.TypeOf(msg).Name())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -164,8 +170,62 @@ func handleDelegatorWithdrawMsg(
 	return sdk.Result{}
 }
 
-func handleClaimInterestMsg(ctx sdk.Context, vm VoteManager, gm *global.GlobalManager, am acc.AccountManager, msg ClaimInterestMsg) sdk.Result {
-	if err := calculateAndAddInterest(ctx, vm, gm, am, msg.Username); err != nil {
+func handleRedelegateMsg(
+	ctx sdk.Context, vm VoteManager, am acc.AccountManager, msg RedelegateMsg) sdk.Result {
+	// Must have an normal acount
+	if !am.DoesAccountExist(ctx, msg.DstVoter) {
+		return ErrAccountNotFound().Result()
+	}
+
+	coin, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	if !vm.IsLegalDelegatorWithdraw(ctx, msg.SrcVoter, msg.Delegator, coin) {
+		return ErrIllegalRedelegate().Result()
+	}
+
+	// delegated coin stays in vote module, no stake or interest change for delegator
+	if err := vm.Redelegate(ctx, msg.Delegator, msg.SrcVoter, msg.DstVoter, coin); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleSetAutoCompoundMsg(
+	ctx sdk.Context, vm VoteManager, gm *global.GlobalManager,
+	am acc.AccountManager, rm rep.ReputationManager, msg SetAutoCompoundMsg) sdk.Result {
+	if !vm.DoesVoterExist(ctx, msg.Username) {
+		return ErrVoterNotFound().Result()
+	}
+	// settle interest accrued under previous setting before switching
+	if err := calculateAndAddInterest(ctx, vm, gm, am, rm, msg.Username); err != nil {
+		return err.Result()
+	}
+	if err := vm.SetAutoCompound(ctx, msg.Username, msg.AutoCompound); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleSetDefaultVotePolicyMsg(ctx sdk.Context, vm VoteManager, msg SetDefaultVotePolicyMsg) sdk.Result {
+	if !vm.DoesVoterExist(ctx, msg.Username) {
+		return ErrVoterNotFound().Result()
+	}
+	// only validator can register a default vote, clearing is always allowed
+	if msg.Option != types.VoteOptionNone && !vm.IsInValidatorList(ctx, msg.Username) {
+		return ErrNotValidator(msg.Username).Result()
+	}
+	if err := vm.SetDefaultVoteOption(ctx, msg.Username, msg.Option); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+func handleClaimInterestMsg(
+	ctx sdk.Context, vm VoteManager, gm *global.GlobalManager,
+	am acc.AccountManager, rm rep.ReputationManager, msg ClaimInterestMsg) sdk.Result {
+	if err := calculateAndAddInterest(ctx, vm, gm, am, rm, msg.Username); err != nil {
 		return err.Result()
 	}
 	// claim interest
@@ -189,7 +249,7 @@ func AddStake(
 			return err
 		}
 	}
-	if err := calculateAndAddInterest(ctx, vm, gm, am, username); err != nil {
+	if err := calculateAndAddInterest(ctx, vm, gm, am, rm, username); err != nil {
 		return err
 	}
 
@@ -209,7 +269,7 @@ func AddStake(
 func MinusStake(
 	ctx sdk.Context, username types.AccountKey, stake types.Coin, vm VoteManager,
 	gm *global.GlobalManager, am acc.AccountManager, rm rep.ReputationManager) sdk.Error {
-	if err := calculateAndAddInterest(ctx, vm, gm, am, username); err != nil {
+	if err := calculateAndAddInterest(ctx, vm, gm, am, rm, username); err != nil {
 		return err
 	}
 
@@ -226,7 +286,7 @@ func MinusStake(
 }
 
 func calculateAndAddInterest(ctx sdk.Context, vm VoteManager, gm *global.GlobalManager,
-	am acc.AccountManager, name types.AccountKey) sdk.Error {
+	am acc.AccountManager, rm rep.ReputationManager, name types.AccountKey) sdk.Error {
 	userLinoStake, err := vm.GetLinoStake(ctx, name)
 	if err != nil {
 		return err
@@ -246,6 +306,18 @@ func calculateAndAddInterest(ctx sdk.Context, vm VoteManager, gm *global.GlobalM
 		return err
 	}
 
+	// compounded interest becomes linoStake, keep global stat and reputation in sync
+	autoCompound, err := vm.IsAutoCompound(ctx, name)
+	if err != nil {
+		return err
+	}
+	if autoCompound && interest.IsPositive() {
+		if err := gm.AddLinoStakeToStat(ctx, interest); err != nil {
+			return err
+		}
+		rm.OnStakeIn(ctx, name, interest)
+	}
+
 	if err := vm.SetLinoStakeLastChangedAt(ctx, name, ctx.BlockHeader().Time.Unix()); err != nil {
 		return err
 	}
@@ -258,7 +330,7 @@ func returnCoinTo(
 	times int64, interval int64, coin types.Coin, returnType types.TransferDetailType) sdk.Error {
 
 	if err := am.AddFrozenMoney(
-		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times); err != nil {
+		ctx, name, coin, ctx.BlockHeader().Time.Unix(), interval, times, returnType); err != nil {
 		return err
 	}
 
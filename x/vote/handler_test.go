@@ -2,6 +2,7 @@ package vote
 
 import (
 	"testing"
+	"time"
 
 	"github.com/lino-network/lino/types"
 	globalModel "github.com/lino-network/lino/x/global/model"
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestVoterDepositBasic(t *testing.T) {
@@ -304,6 +306,212 @@ func TestDelegatorWithdraw(t *testing.T) {
 	}
 }
 
+func TestRedelegate(t *testing.T) {
+	ctx, am, vm, gm, rm := setupTest(t, 0)
+	handler := NewHandler(vm, am, &gm, rm)
+	param, _ := vm.paramHolder.GetVoteParam(ctx)
+	minBalance := types.NewCoinFromInt64(5000 * types.Decimals)
+	delegatedCoin := param.MinStakeIn
+	delta := types.NewCoinFromInt64(1 * types.Decimals)
+
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	user2 := createTestAccount(ctx, am, "user2", minBalance)
+	user3 := createTestAccount(ctx, am, "user3", minBalance)
+	user4 := createTestAccount(ctx, am, "user4", minBalance)
+	vm.AddVoter(ctx, user1, param.MinStakeIn)
+
+	// user3 delegates to user1 twice
+	msg := NewDelegateMsg(string(user3), string(user1), coinToString(delegatedCoin))
+	assert.Equal(t, sdk.Result{}, handler(ctx, msg))
+	assert.Equal(t, sdk.Result{}, handler(ctx, msg))
+
+	testCases := []struct {
+		testName       string
+		delegator      types.AccountKey
+		srcVoter       types.AccountKey
+		dstVoter       types.AccountKey
+		amount         types.Coin
+		expectedResult sdk.Result
+	}{
+		{
+			testName:       "destination voter account doesn't exist",
+			delegator:      user3,
+			srcVoter:       user1,
+			dstVoter:       types.AccountKey("user5"),
+			amount:         delegatedCoin,
+			expectedResult: ErrAccountNotFound().Result(),
+		},
+		{
+			testName:       "no delegation exist, can't redelegate",
+			delegator:      user4,
+			srcVoter:       user1,
+			dstVoter:       user2,
+			amount:         delegatedCoin,
+			expectedResult: ErrIllegalRedelegate().Result(),
+		},
+		{
+			testName:       "can't redelegate more than delegation",
+			delegator:      user3,
+			srcVoter:       user1,
+			dstVoter:       user2,
+			amount:         delegatedCoin.Plus(delegatedCoin).Plus(delta),
+			expectedResult: ErrIllegalRedelegate().Result(),
+		},
+		{
+			testName:       "redelegate to a new voter",
+			delegator:      user3,
+			srcVoter:       user1,
+			dstVoter:       user2,
+			amount:         delegatedCoin,
+			expectedResult: sdk.Result{},
+		},
+		{
+			testName:       "can't redelegate in-flight power again",
+			delegator:      user3,
+			srcVoter:       user2,
+			dstVoter:       user4,
+			amount:         delta,
+			expectedResult: ErrRedelegationInFlight(user2).Result(),
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := NewRedelegateMsg(
+			string(tc.delegator), string(tc.srcVoter), string(tc.dstVoter), coinToString(tc.amount))
+		res := handler(ctx, msg)
+		if !assert.Equal(t, tc.expectedResult, res) {
+			t.Errorf("%s: diff result, got %v, want %v", tc.testName, res, tc.expectedResult)
+		}
+	}
+
+	// delegated power is moved, delegator's delegation in total is unchanged
+	voter1, _ := vm.storage.GetVoter(ctx, user1)
+	voter2, _ := vm.storage.GetVoter(ctx, user2)
+	delegator, _ := vm.storage.GetVoter(ctx, user3)
+	assert.Equal(t, delegatedCoin, voter1.DelegatedPower)
+	assert.Equal(t, delegatedCoin, voter2.DelegatedPower)
+	assert.Equal(t, delegatedCoin.Plus(delegatedCoin), delegator.DelegateToOthers)
+	delegation1, _ := vm.storage.GetDelegation(ctx, user1, user3)
+	delegation2, _ := vm.storage.GetDelegation(ctx, user2, user3)
+	assert.Equal(t, delegatedCoin, delegation1.Amount)
+	assert.Equal(t, delegatedCoin, delegation2.Amount)
+	redelegations, _ := vm.GetRedelegations(ctx, user3)
+	assert.Equal(t, 1, len(redelegations))
+
+	// in-flight redelegations are limited
+	for i := int64(1); i < param.MaxRedelegations; i++ {
+		msg := NewRedelegateMsg(string(user3), string(user1), string(user4), coinToString(delta))
+		assert.Equal(t, sdk.Result{}, handler(ctx, msg))
+	}
+	msg = NewRedelegateMsg(string(user3), string(user1), string(user4), coinToString(delta))
+	assert.Equal(t, ErrTooManyRedelegations().Result(), handler(ctx, msg))
+
+	// after redelegation completed, power can be redelegated again
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(param.RedelegationIntervalSec, 0)})
+	redelegations, _ = vm.GetRedelegations(ctx, user3)
+	assert.Equal(t, 0, len(redelegations))
+	msg = NewRedelegateMsg(string(user3), string(user2), string(user1), coinToString(delegatedCoin))
+	assert.Equal(t, sdk.Result{}, handler(ctx, msg))
+	assert.False(t, vm.DoesDelegationExist(ctx, user2, user3))
+	voter2, _ = vm.storage.GetVoter(ctx, user2)
+	assert.True(t, voter2.DelegatedPower.IsZero())
+}
+
+func TestAutoCompoundInterest(t *testing.T) {
+	ctx, am, vm, gm, rm := setupTest(t, 0)
+	handler := NewHandler(vm, am, &gm, rm)
+	voteParam, _ := vm.paramHolder.GetVoteParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	stake := voteParam.MinStakeIn
+	friction := types.NewCoinFromInt64(100 * types.Decimals)
+
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(stake))
+	createTestAccount(ctx, am, "user2", minBalance)
+
+	// non-voter can't set auto compound
+	result := handler(ctx, NewSetAutoCompoundMsg("user2", true))
+	assert.Equal(t, ErrVoterNotFound().Result(), result)
+
+	result = handler(ctx, NewStakeInMsg("user1", coinToString(stake)))
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewSetAutoCompoundMsg("user1", true))
+	assert.Equal(t, sdk.Result{}, result)
+
+	// user1 is the only staker, all friction of day 0 belongs to user1
+	gs := globalModel.NewGlobalStorage(testGlobalKVStoreKey)
+	day0Stat, _ := gs.GetLinoStakeStat(ctx, 0)
+	day0Stat.UnclaimedFriction = friction
+	gs.SetLinoStakeStat(ctx, 0, day0Stat)
+	gs.SetLinoStakeStat(ctx, 1, &globalModel.LinoStakeStat{
+		TotalConsumptionFriction: types.NewCoinFromInt64(0),
+		UnclaimedFriction:        types.NewCoinFromInt64(0),
+		TotalLinoStake:           stake,
+		UnclaimedLinoStake:       stake,
+	})
+
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(24*3600+1, 0)})
+	result = handler(ctx, NewClaimInterestMsg("user1"))
+	assert.Equal(t, sdk.Result{}, result)
+
+	voter, _ := vm.storage.GetVoter(ctx, user1)
+	assert.Equal(t, stake.Plus(friction), voter.LinoStake)
+	assert.Equal(t, true, voter.Interest.IsZero())
+	saving, _ := am.GetSavingFromBank(ctx, user1)
+	assert.Equal(t, minBalance, saving)
+
+	day1Stat, _ := gs.GetLinoStakeStat(ctx, 1)
+	assert.Equal(t, stake.Plus(friction), day1Stat.TotalLinoStake)
+	assert.Equal(t, stake.Plus(friction), day1Stat.UnclaimedLinoStake)
+
+	// turn off, later interest stays claimable
+	result = handler(ctx, NewSetAutoCompoundMsg("user1", false))
+	assert.Equal(t, sdk.Result{}, result)
+	autoCompound, _ := vm.IsAutoCompound(ctx, user1)
+	assert.Equal(t, false, autoCompound)
+}
+
+func TestSetDefaultVotePolicy(t *testing.T) {
+	ctx, am, vm, gm, rm := setupTest(t, 0)
+	handler := NewHandler(vm, am, &gm, rm)
+	voteParam, _ := vm.paramHolder.GetVoteParam(ctx)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	stake := voteParam.MinStakeIn
+
+	user1 := createTestAccount(ctx, am, "user1", minBalance.Plus(stake))
+	user2 := createTestAccount(ctx, am, "user2", minBalance.Plus(stake))
+	createTestAccount(ctx, am, "user3", minBalance)
+
+	// non-voter can't set default vote policy
+	result := handler(ctx, NewSetDefaultVotePolicyMsg("user3", types.VoteOptionYes))
+	assert.Equal(t, ErrVoterNotFound().Result(), result)
+
+	result = handler(ctx, NewStakeInMsg("user1", coinToString(stake)))
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewStakeInMsg("user2", coinToString(stake)))
+	assert.Equal(t, sdk.Result{}, result)
+	err := vm.SetValidatorReferenceList(ctx, &model.ReferenceList{
+		AllValidators: []types.AccountKey{user1},
+	})
+	assert.Nil(t, err)
+
+	// voter not in validator list can't set default vote policy
+	result = handler(ctx, NewSetDefaultVotePolicyMsg("user2", types.VoteOptionYes))
+	assert.Equal(t, ErrNotValidator(user2).Result(), result)
+
+	result = handler(ctx, NewSetDefaultVotePolicyMsg("user1", types.VoteOptionNo))
+	assert.Equal(t, sdk.Result{}, result)
+	voter, _ := vm.storage.GetVoter(ctx, user1)
+	assert.Equal(t, types.VoteOptionNo, voter.DefaultVoteOption)
+
+	// clear is always allowed
+	result = handler(ctx, NewSetDefaultVotePolicyMsg("user2", types.VoteOptionNone))
+	assert.Equal(t, sdk.Result{}, result)
+	result = handler(ctx, NewSetDefaultVotePolicyMsg("user1", types.VoteOptionNone))
+	assert.Equal(t, sdk.Result{}, result)
+	voter, _ = vm.storage.GetVoter(ctx, user1)
+	assert.Equal(t, types.VoteOptionNone, voter.DefaultVoteOption)
+}
+
 func TestAddFrozenMoney(t *testing.T) {
 	ctx, am, vm, gm, _ := setupTest(t, 0)
 	vm.InitGenesis(ctx)
@@ -365,6 +573,9 @@ func TestAddFrozenMoney(t *testing.T) {
 		if lst[len(lst)-1].Interval != tc.expectedFrozenInterval {
 			t.Errorf("%s: diff interval, got %v, want %v", tc.testName, lst[len(lst)-1].Interval, tc.expectedFrozenInterval)
 		}
+		if lst[len(lst)-1].ReturnType != types.VoteReturnCoin {
+			t.Errorf("%s: diff return type, got %v, want %v", tc.testName, lst[len(lst)-1].ReturnType, types.VoteReturnCoin)
+		}
 	}
 }
 
@@ -383,7 +594,7 @@ func TestDeleteVoteBasic(t *testing.T) {
 	handler(ctx, depositMsg)
 
 	// add vote
-	_ = vm.AddVote(ctx, proposalID1, user2, true)
+	_, _ = vm.AddVote(ctx, proposalID1, user2, types.VoteOptionYes)
 
 	voteList, _ := vm.storage.GetAllVotes(ctx, proposalID1)
 	assert.Equal(t, user2, voteList[0].Voter)
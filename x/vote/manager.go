@@ -101,28 +101,39 @@ func (vm VoteManager) CanBecomeValidator(ctx sdk.Context, username types.Account
 	return voter.LinoStake.IsGTE(param.ValidatorMinVotingDeposit)
 }
 
-// AddVote - voter vote for a proposal
-func (vm VoteManager) AddVote(ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey, res bool) sdk.Error {
+// AddVote - voter vote for a proposal or change the vote,
+// returns previous vote if the vote is changed
+func (vm VoteManager) AddVote(
+	ctx sdk.Context, proposalID types.ProposalKey, voter types.AccountKey,
+	option types.VoteOption) (*model.Vote, sdk.Error) {
+	var prevVote *model.Vote
 	// check if the vote exist
 	if vm.DoesVoteExist(ctx, proposalID, voter) {
-		return ErrVoteAlreadyExist()
+		vote, err := vm.storage.GetVote(ctx, proposalID, voter)
+		if err != nil {
+			return nil, err
+		}
+		if vote.GetOption() == option {
+			return nil, ErrVoteAlreadyExist()
+		}
+		prevVote = vote
 	}
 
 	votingPower, err := vm.GetVotingPower(ctx, voter)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	vote := model.Vote{
 		Voter:       voter,
-		Result:      res,
+		Option:      option,
 		VotingPower: votingPower,
 	}
 
 	if err := vm.storage.SetVote(ctx, proposalID, voter, &vote); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return prevVote, nil
 }
 
 // GetVote - get vote detail based on voter and proposal ID
@@ -130,6 +141,11 @@ func (vm VoteManager) GetVote(ctx sdk.Context, proposalID types.ProposalKey, vot
 	return vm.storage.GetVote(ctx, proposalID, voter)
 }
 
+// GetAllVotes - get all votes of a proposal with voting power when they were cast
+func (vm VoteManager) GetAllVotes(ctx sdk.Context, proposalID types.ProposalKey) ([]model.Vote, sdk.Error) {
+	return vm.storage.GetAllVotes(ctx, proposalID)
+}
+
 // AddDelegation - add delegation
 func (vm VoteManager) AddDelegation(ctx sdk.Context, voterName types.AccountKey, delegatorName types.AccountKey, coin types.Coin) sdk.Error {
 	var delegation *model.Delegation
@@ -181,6 +197,125 @@ func (vm VoteManager) AddDelegation(ctx sdk.Context, voterName types.AccountKey,
 	return nil
 }
 
+// Redelegate - move delegation from source voter to destination voter immediately.
+// Redelegated power is in flight for RedelegationIntervalSec, during which it can't
+// be redelegated again.
+func (vm VoteManager) Redelegate(
+	ctx sdk.Context, delegatorName, srcVoterName, dstVoterName types.AccountKey, coin types.Coin) sdk.Error {
+	if coin.IsZero() {
+		return ErrInvalidCoin()
+	}
+	param, err := vm.paramHolder.GetVoteParam(ctx)
+	if err != nil {
+		return err
+	}
+	redelegations, err := vm.storage.GetRedelegations(ctx, delegatorName)
+	if err != nil {
+		return err
+	}
+
+	// remove matured redelegations, reject hopping in-flight power
+	now := ctx.BlockHeader().Time.Unix()
+	inFlight := []model.Redelegation{}
+	for _, redelegation := range redelegations.Redelegations {
+		if redelegation.CompleteAt <= now {
+			continue
+		}
+		if redelegation.DstVoter == srcVoterName {
+			return ErrRedelegationInFlight(srcVoterName)
+		}
+		inFlight = append(inFlight, redelegation)
+	}
+	if int64(len(inFlight)) >= param.MaxRedelegations {
+		return ErrTooManyRedelegations()
+	}
+
+	srcDelegation, err := vm.storage.GetDelegation(ctx, srcVoterName, delegatorName)
+	if err != nil {
+		return err
+	}
+	if !srcDelegation.Amount.IsGTE(coin) {
+		return ErrIllegalRedelegate()
+	}
+
+	// add voter if not exist
+	if !vm.DoesVoterExist(ctx, dstVoterName) {
+		if err := vm.AddVoter(ctx, dstVoterName, types.NewCoinFromInt64(0)); err != nil {
+			return err
+		}
+	}
+	srcVoter, err := vm.storage.GetVoter(ctx, srcVoterName)
+	if err != nil {
+		return err
+	}
+	dstVoter, err := vm.storage.GetVoter(ctx, dstVoterName)
+	if err != nil {
+		return err
+	}
+	dstDelegation := &model.Delegation{
+		Delegator: delegatorName,
+		Amount:    types.NewCoinFromInt64(0),
+	}
+	if vm.DoesDelegationExist(ctx, dstVoterName, delegatorName) {
+		dstDelegation, err = vm.storage.GetDelegation(ctx, dstVoterName, delegatorName)
+		if err != nil {
+			return err
+		}
+	}
+
+	// delegator's delegateToOthers is unchanged, only the delegatee changes
+	srcVoter.DelegatedPower = srcVoter.DelegatedPower.Minus(coin)
+	dstVoter.DelegatedPower = dstVoter.DelegatedPower.Plus(coin)
+	srcDelegation.Amount = srcDelegation.Amount.Minus(coin)
+	dstDelegation.Amount = dstDelegation.Amount.Plus(coin)
+	redelegations.Redelegations = append(inFlight, model.Redelegation{
+		SrcVoter:   srcVoterName,
+		DstVoter:   dstVoterName,
+		Amount:     coin,
+		CreatedAt:  now,
+		CompleteAt: now + param.RedelegationIntervalSec,
+	})
+
+	if err := vm.storage.SetVoter(ctx, srcVoterName, srcVoter); err != nil {
+		return err
+	}
+	if err := vm.storage.SetVoter(ctx, dstVoterName, dstVoter); err != nil {
+		return err
+	}
+	if srcDelegation.Amount.IsZero() {
+		if err := vm.storage.DeleteDelegation(ctx, srcVoterName, delegatorName); err != nil {
+			return err
+		}
+	} else {
+		if err := vm.storage.SetDelegation(ctx, srcVoterName, delegatorName, srcDelegation); err != nil {
+			return err
+		}
+	}
+	if err := vm.storage.SetDelegation(ctx, dstVoterName, delegatorName, dstDelegation); err != nil {
+		return err
+	}
+	if err := vm.storage.SetRedelegations(ctx, delegatorName, redelegations); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetRedelegations - get in-flight redelegations of a delegator
+func (vm VoteManager) GetRedelegations(ctx sdk.Context, delegatorName types.AccountKey) ([]model.Redelegation, sdk.Error) {
+	redelegations, err := vm.storage.GetRedelegations(ctx, delegatorName)
+	if err != nil {
+		return nil, err
+	}
+	now := ctx.BlockHeader().Time.Unix()
+	inFlight := []model.Redelegation{}
+	for _, redelegation := range redelegations.Redelegations {
+		if redelegation.CompleteAt > now {
+			inFlight = append(inFlight, redelegation)
+		}
+	}
+	return inFlight, nil
+}
+
 // AddVoter - add voter
 func (vm VoteManager) AddVoter(ctx sdk.Context, username types.AccountKey, coin types.Coin) sdk.Error {
 	voter := &model.Voter{
@@ -285,20 +420,107 @@ func (vm VoteManager) ClaimInterest(
 	return claimedInterest, nil
 }
 
-// AddInterest - add interst
+// AddInterest - add interst, interest is added to linoStake directly if voter enables auto compound
 func (vm VoteManager) AddInterest(
 	ctx sdk.Context, username types.AccountKey, interest types.Coin) sdk.Error {
 	voter, err := vm.storage.GetVoter(ctx, username)
 	if err != nil {
 		return err
 	}
-	voter.Interest = voter.Interest.Plus(interest)
+	if voter.AutoCompound {
+		voter.LinoStake = voter.LinoStake.Plus(interest)
+	} else {
+		voter.Interest = voter.Interest.Plus(interest)
+	}
 	if err := vm.storage.SetVoter(ctx, username, voter); err != nil {
 		return err
 	}
 	return nil
 }
 
+// IsAutoCompound - check if voter's interest is compounded into linoStake
+func (vm VoteManager) IsAutoCompound(ctx sdk.Context, username types.AccountKey) (bool, sdk.Error) {
+	voter, err := vm.storage.GetVoter(ctx, username)
+	if err != nil {
+		return false, err
+	}
+	return voter.AutoCompound, nil
+}
+
+// SetAutoCompound - set voter auto compound flag
+func (vm VoteManager) SetAutoCompound(
+	ctx sdk.Context, username types.AccountKey, autoCompound bool) sdk.Error {
+	voter, err := vm.storage.GetVoter(ctx, username)
+	if err != nil {
+		return err
+	}
+	voter.AutoCompound = autoCompound
+	if err := vm.storage.SetVoter(ctx, username, voter); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetDefaultVoteOption - set option voted on behalf of validator who didn't vote
+func (vm VoteManager) SetDefaultVoteOption(
+	ctx sdk.Context, username types.AccountKey, option types.VoteOption) sdk.Error {
+	voter, err := vm.storage.GetVoter(ctx, username)
+	if err != nil {
+		return err
+	}
+	voter.DefaultVoteOption = option
+	if err := vm.storage.SetVoter(ctx, username, voter); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CastDefaultVotes - cast default vote for validators who didn't vote on the proposal
+// but registered a default vote policy
+func (vm VoteManager) CastDefaultVotes(
+	ctx sdk.Context, proposalID types.ProposalKey, validators []types.AccountKey) sdk.Error {
+	for _, validator := range validators {
+		if !vm.DoesVoterExist(ctx, validator) || vm.DoesVoteExist(ctx, proposalID, validator) {
+			continue
+		}
+		voter, err := vm.storage.GetVoter(ctx, validator)
+		if err != nil {
+			return err
+		}
+		if voter.DefaultVoteOption == types.VoteOptionNone {
+			continue
+		}
+		if _, err := vm.AddVote(ctx, proposalID, validator, voter.DefaultVoteOption); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetValidatorsNotVoted - get oncall validators who haven't voted on the proposal and
+// don't have a default vote to be cast for them, which are the validators to be punished
+func (vm VoteManager) GetValidatorsNotVoted(
+	ctx sdk.Context, proposalID types.ProposalKey,
+	oncallValidators []types.AccountKey) ([]types.AccountKey, sdk.Error) {
+	notVoted := []types.AccountKey{}
+	for _, validator := range oncallValidators {
+		if vm.DoesVoteExist(ctx, proposalID, validator) {
+			continue
+		}
+		if vm.DoesVoterExist(ctx, validator) {
+			voter, err := vm.storage.GetVoter(ctx, validator)
+			if err != nil {
+				return nil, err
+			}
+			if voter.DefaultVoteOption != types.VoteOptionNone {
+				continue
+			}
+		}
+		notVoted = append(notVoted, validator)
+	}
+	return notVoted, nil
+}
+
 // GetVotingPower - get voter voting power
 func (vm VoteManager) GetVotingPower(ctx sdk.Context, voterName types.AccountKey) (types.Coin, sdk.Error) {
 	voter, err := vm.storage.GetVoter(ctx, voterName)
@@ -309,6 +531,49 @@ func (vm VoteManager) GetVotingPower(ctx sdk.Context, voterName types.AccountKey
 	return res, nil
 }
 
+// GetProposalVotes - get all votes of a proposal with voting power recomputed from
+// current stake, delegator's own vote overrides its voters' vote with delegated share
+func (vm VoteManager) GetProposalVotes(ctx sdk.Context, proposalID types.ProposalKey) ([]model.Vote, sdk.Error) {
+	votes, err := vm.storage.GetAllVotes(ctx, proposalID)
+	if err != nil {
+		return nil, err
+	}
+
+	voteIndex := make(map[types.AccountKey]int)
+	for i, vote := range votes {
+		voteIndex[vote.Voter] = i
+		votes[i].VotingPower = types.NewCoinFromInt64(0)
+		// voter who has withdrawn all stake doesn't have voting power
+		if !vm.DoesVoterExist(ctx, vote.Voter) {
+			continue
+		}
+		votingPower, err := vm.GetVotingPower(ctx, vote.Voter)
+		if err != nil {
+			return nil, err
+		}
+		votes[i].VotingPower = votingPower
+	}
+
+	// move delegated share from voter to delegator who votes by itself
+	for i, vote := range votes {
+		delegatees, err := vm.storage.GetAllDelegatees(ctx, vote.Voter)
+		if err != nil {
+			return nil, err
+		}
+		for _, delegatee := range delegatees {
+			delegation, err := vm.storage.GetDelegation(ctx, delegatee, vote.Voter)
+			if err != nil {
+				return nil, err
+			}
+			votes[i].VotingPower = votes[i].VotingPower.Plus(delegation.Amount)
+			if j, ok := voteIndex[delegatee]; ok {
+				votes[j].VotingPower = votes[j].VotingPower.Minus(delegation.Amount)
+			}
+		}
+	}
+	return votes, nil
+}
+
 // GetPenaltyList - get penalty list if voter is also validator doesn't vote
 func (vm VoteManager) GetPenaltyList(
 	ctx sdk.Context, proposalID types.ProposalKey, proposalType types.ProposalType,
@@ -335,13 +600,20 @@ func (vm VoteManager) GetPenaltyList(
 		// vm.storage.DeleteVote(ctx, proposalID, vote.Voter)
 	}
 
-	// put all validators who didn't vote on these two types proposal into penalty list
-	if proposalType == types.ChangeParam || proposalType == types.ProtocolUpgrade {
+	// put all validators who didn't vote on these three types proposal into penalty list
+	if IsVoteRequired(proposalType) {
 		penaltyList.PenaltyList = oncallValidators
 	}
 	return penaltyList, nil
 }
 
+// IsVoteRequired - validators are required to vote on param change, protocol upgrade
+// and treasury spend proposal
+func IsVoteRequired(proposalType types.ProposalType) bool {
+	return proposalType == types.ChangeParam || proposalType == types.ProtocolUpgrade ||
+		proposalType == types.TreasurySpend
+}
+
 // GetLinoStake - get lino stake
 func (vm VoteManager) GetLinoStake(ctx sdk.Context, accKey types.AccountKey) (types.Coin, sdk.Error) {
 	voter, err := vm.storage.GetVoter(ctx, accKey)
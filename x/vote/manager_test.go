@@ -37,6 +37,58 @@ func TestAddVoter(t *testing.T) {
 	}
 }
 
+func TestAddVote(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	proposalID := types.ProposalKey("1")
+	votingPower := types.NewCoinFromInt64(100 * types.Decimals)
+	vm.AddVoter(ctx, user1, votingPower)
+
+	testCases := []struct {
+		testName         string
+		option           types.VoteOption
+		expectedPrevVote *model.Vote
+		expectedErr      sdk.Error
+	}{
+		{
+			testName:         "first vote",
+			option:           types.VoteOptionYes,
+			expectedPrevVote: nil,
+			expectedErr:      nil,
+		},
+		{
+			testName:         "vote the same option again",
+			option:           types.VoteOptionYes,
+			expectedPrevVote: nil,
+			expectedErr:      ErrVoteAlreadyExist(),
+		},
+		{
+			testName: "change vote option",
+			option:   types.VoteOptionNoWithVeto,
+			expectedPrevVote: &model.Vote{
+				Voter:       user1,
+				VotingPower: votingPower,
+				Option:      types.VoteOptionYes,
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		prevVote, err := vm.AddVote(ctx, proposalID, user1, tc.option)
+		if !assert.Equal(t, tc.expectedErr, err) {
+			t.Errorf("%s: diff err, got %v, want %v", tc.testName, err, tc.expectedErr)
+		}
+		if !assert.Equal(t, tc.expectedPrevVote, prevVote) {
+			t.Errorf("%s: diff prev vote, got %v, want %v", tc.testName, prevVote, tc.expectedPrevVote)
+		}
+	}
+	vote, err := vm.GetVote(ctx, proposalID, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, types.VoteOptionNoWithVeto, vote.Option)
+}
+
 func TestCanBecomeValidator(t *testing.T) {
 	ctx, am, vm, _, _ := setupTest(t, 0)
 	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
@@ -120,6 +172,24 @@ func TestAddAndClaimInterest(t *testing.T) {
 		t.Errorf("%s: diff interest", testName)
 	}
 
+	// interest goes to lino stake when auto compound is on
+	if err := vm.SetAutoCompound(ctx, accKey, true); err != nil {
+		t.Errorf("%s: failed to set auto compound, got err %v", testName, err)
+	}
+	err = vm.AddInterest(ctx, accKey, c500)
+	if err != nil {
+		t.Errorf("%s: failed to add interest, got err %v", testName, err)
+	}
+	voter, err = vm.storage.GetVoter(ctx, accKey)
+	if err != nil {
+		t.Errorf("%s: failed to get voter, got err %v", testName, err)
+	}
+	if !assert.Equal(t, c100.Plus(c500), voter.LinoStake) {
+		t.Errorf("%s: diff lino stake", testName)
+	}
+	if !assert.Equal(t, true, voter.Interest.IsZero()) {
+		t.Errorf("%s: diff interest", testName)
+	}
 }
 
 func TestIsInValidatorList(t *testing.T) {
@@ -224,6 +294,121 @@ func TestIsLegalVoterWithdraw(t *testing.T) {
 	}
 }
 
+func TestGetProposalVotes(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	user2 := createTestAccount(ctx, am, "user2", minBalance)
+	proposalID := types.ProposalKey("1")
+	vm.AddVoter(ctx, user1, types.NewCoinFromInt64(100))
+	vm.AddVoter(ctx, user2, types.NewCoinFromInt64(50))
+	vm.AddDelegation(ctx, user1, user2, types.NewCoinFromInt64(40))
+
+	testCases := []struct {
+		testName      string
+		voter         types.AccountKey
+		option        types.VoteOption
+		minusStake    types.Coin
+		expectedVotes []model.Vote
+	}{
+		{
+			testName:   "voter votes with delegated power",
+			voter:      user1,
+			option:     types.VoteOptionYes,
+			minusStake: types.NewCoinFromInt64(0),
+			expectedVotes: []model.Vote{
+				{Voter: user1, VotingPower: types.NewCoinFromInt64(140), Option: types.VoteOptionYes},
+			},
+		},
+		{
+			testName:   "delegator overrides voter with delegated share",
+			voter:      user2,
+			option:     types.VoteOptionNo,
+			minusStake: types.NewCoinFromInt64(0),
+			expectedVotes: []model.Vote{
+				{Voter: user1, VotingPower: types.NewCoinFromInt64(100), Option: types.VoteOptionYes},
+				{Voter: user2, VotingPower: types.NewCoinFromInt64(50), Option: types.VoteOptionNo},
+			},
+		},
+		{
+			testName:   "voting power is recomputed after stake out",
+			voter:      user1,
+			option:     types.VoteOptionYes,
+			minusStake: types.NewCoinFromInt64(60),
+			expectedVotes: []model.Vote{
+				{Voter: user1, VotingPower: types.NewCoinFromInt64(40), Option: types.VoteOptionYes},
+				{Voter: user2, VotingPower: types.NewCoinFromInt64(50), Option: types.VoteOptionNo},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		if !vm.DoesVoteExist(ctx, proposalID, tc.voter) {
+			_, err := vm.AddVote(ctx, proposalID, tc.voter, tc.option)
+			if err != nil {
+				t.Errorf("%s: failed to add vote, got err %v", tc.testName, err)
+			}
+		}
+		if !tc.minusStake.IsZero() {
+			if err := vm.MinusLinoStake(ctx, tc.voter, tc.minusStake); err != nil {
+				t.Errorf("%s: failed to minus stake, got err %v", tc.testName, err)
+			}
+		}
+
+		votes, err := vm.GetProposalVotes(ctx, proposalID)
+		if err != nil {
+			t.Errorf("%s: failed to get proposal votes, got err %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectedVotes, votes) {
+			t.Errorf("%s: diff votes, got %v, want %v", tc.testName, votes, tc.expectedVotes)
+		}
+	}
+}
+
+func TestCastDefaultVotes(t *testing.T) {
+	ctx, am, vm, _, _ := setupTest(t, 0)
+	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
+	user1 := createTestAccount(ctx, am, "user1", minBalance)
+	user2 := createTestAccount(ctx, am, "user2", minBalance)
+	user3 := createTestAccount(ctx, am, "user3", minBalance)
+	user4 := createTestAccount(ctx, am, "user4", minBalance)
+	proposalID := types.ProposalKey("1")
+	vm.AddVoter(ctx, user1, types.NewCoinFromInt64(100))
+	vm.AddVoter(ctx, user2, types.NewCoinFromInt64(100))
+	vm.AddVoter(ctx, user3, types.NewCoinFromInt64(100))
+	vm.SetDefaultVoteOption(ctx, user1, types.VoteOptionNo)
+	vm.SetDefaultVoteOption(ctx, user2, types.VoteOptionYes)
+
+	// user2 voted by itself, its default vote is not cast
+	_, err := vm.AddVote(ctx, proposalID, user2, types.VoteOptionNoWithVeto)
+	assert.Nil(t, err)
+
+	// user1 has a default vote to be cast, user4 is not oncall
+	notVoted, err := vm.GetValidatorsNotVoted(ctx, proposalID, []types.AccountKey{user1, user2, user3})
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{user3}, notVoted)
+
+	err = vm.CastDefaultVotes(ctx, proposalID, []types.AccountKey{user1, user2, user3, user4})
+	assert.Nil(t, err)
+
+	votes, err := vm.GetAllVotes(ctx, proposalID)
+	assert.Nil(t, err)
+	assert.Equal(t, []model.Vote{
+		{Voter: user1, VotingPower: types.NewCoinFromInt64(100), Option: types.VoteOptionNo},
+		{Voter: user2, VotingPower: types.NewCoinFromInt64(100), Option: types.VoteOptionNoWithVeto},
+	}, votes)
+
+	notVoted, err = vm.GetValidatorsNotVoted(ctx, proposalID, []types.AccountKey{user1, user2, user3, user4})
+	assert.Nil(t, err)
+	assert.Equal(t, []types.AccountKey{user3, user4}, notVoted)
+
+	// validators without default vote are still penalized
+	penaltyList, err := vm.GetPenaltyList(
+		ctx, proposalID, types.ChangeParam, []types.AccountKey{user1, user2, user3, user4})
+	assert.Nil(t, err)
+	assert.Equal(t, notVoted, penaltyList.PenaltyList)
+}
+
 func TestIsLegalDelegatorWithdraw(t *testing.T) {
 	ctx, am, vm, _, _ := setupTest(t, 0)
 	minBalance := types.NewCoinFromInt64(1 * types.Decimals)
@@ -47,6 +47,11 @@ func ErrFailedToMarshalReferenceList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToMarshalReferenceList, fmt.Sprintf("failed to marshal reference list: %s", err.Error()))
 }
 
+// ErrFailedToMarshalRedelegations - error if marshal redelegations failed
+func ErrFailedToMarshalRedelegations(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToMarshalRedelegations, fmt.Sprintf("failed to marshal redelegations: %s", err.Error()))
+}
+
 // ErrFailedToUnmarshalVoter - error if unmarshal voter failed
 func ErrFailedToUnmarshalVoter(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalVoter, fmt.Sprintf("failed to unmarshal voter: %s", err.Error()))
@@ -66,3 +71,8 @@ func ErrFailedToUnmarshalDelegation(err error) sdk.Error {
 func ErrFailedToUnmarshalReferenceList(err error) sdk.Error {
 	return types.NewError(types.CodeFailedToUnmarshalReferenceList, fmt.Sprintf("failed to unmarshal reference list: %s", err.Error()))
 }
+
+// ErrFailedToUnmarshalRedelegations - error if unmarshal redelegations failed
+func ErrFailedToUnmarshalRedelegations(err error) sdk.Error {
+	return types.NewError(types.CodeFailedToUnmarshalRedelegations, fmt.Sprintf("failed to unmarshal redelegations: %s", err.Error()))
+}
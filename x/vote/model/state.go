@@ -17,6 +17,19 @@ type DelegationRow struct {
 	Delegation Delegation       `json:"delegation"`
 }
 
+// VoteRow - pk: (proposalID, voter)
+type VoteRow struct {
+	ProposalID types.ProposalKey `json:"proposal_id"`
+	Voter      types.AccountKey  `json:"voter"`
+	Vote       Vote              `json:"vote"`
+}
+
+// RedelegationsRow - pk: delegator
+type RedelegationsRow struct {
+	Delegator     types.AccountKey `json:"delegator"`
+	Redelegations Redelegations    `json:"redelegations"`
+}
+
 // ReferenceListTable - no pk
 type ReferenceListTable struct {
 	List ReferenceList `json:"list"`
@@ -27,6 +40,8 @@ type VoterTables struct {
 	Voters        []VoterRow         `json:"voters"`
 	Delegations   []DelegationRow    `json:"delegations"`
 	ReferenceList ReferenceListTable `json:"reference_list"`
+	Redelegations []RedelegationsRow `json:"redelegations"`
+	Votes         []VoteRow          `json:"votes"`
 }
 
 // ToIR - same
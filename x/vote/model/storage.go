@@ -15,6 +15,7 @@ var (
 	voteSubstore          = []byte{0x02}
 	referenceListSubStore = []byte{0x03}
 	delegateeSubStore     = []byte{0x04}
+	redelegationSubStore  = []byte{0x05}
 )
 
 // VoteStorage - vote storage
@@ -180,6 +181,21 @@ func (vs VoteStorage) GetAllDelegators(ctx sdk.Context, voterName types.AccountK
 	return delegators, nil
 }
 
+// GetAllDelegatees - get all voters a delegator delegated to from KVStore
+func (vs VoteStorage) GetAllDelegatees(ctx sdk.Context, delegatorName types.AccountKey) ([]types.AccountKey, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	prefix := getDelegateePrefix(delegatorName)
+	iterator := store.Iterator(subspace(prefix))
+	defer iterator.Close()
+
+	var delegatees []types.AccountKey
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegatees = append(delegatees, types.AccountKey(iterator.Key()[len(prefix):]))
+	}
+	return delegatees, nil
+}
+
 // GetAllVotes - get all votes of a proposal from KVStore
 func (vs VoteStorage) GetAllVotes(ctx sdk.Context, proposalID types.ProposalKey) ([]Vote, sdk.Error) {
 	store := ctx.KVStore(vs.key)
@@ -200,6 +216,39 @@ func (vs VoteStorage) GetAllVotes(ctx sdk.Context, proposalID types.ProposalKey)
 	return votes, nil
 }
 
+// GetRedelegations - get in-flight redelegations of a delegator from KVStore,
+// return empty redelegations if delegator doesn't have any
+func (vs VoteStorage) GetRedelegations(ctx sdk.Context, delegator types.AccountKey) (*Redelegations, sdk.Error) {
+	store := ctx.KVStore(vs.key)
+	redelegationsByte := store.Get(GetRedelegationsKey(delegator))
+	if redelegationsByte == nil {
+		return &Redelegations{Redelegations: []Redelegation{}}, nil
+	}
+	redelegations := new(Redelegations)
+	if err := vs.cdc.UnmarshalBinaryLengthPrefixed(redelegationsByte, redelegations); err != nil {
+		return nil, ErrFailedToUnmarshalRedelegations(err)
+	}
+	return redelegations, nil
+}
+
+// SetRedelegations - set in-flight redelegations of a delegator to KVStore
+func (vs VoteStorage) SetRedelegations(ctx sdk.Context, delegator types.AccountKey, redelegations *Redelegations) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	redelegationsByte, err := vs.cdc.MarshalBinaryLengthPrefixed(*redelegations)
+	if err != nil {
+		return ErrFailedToMarshalRedelegations(err)
+	}
+	store.Set(GetRedelegationsKey(delegator), redelegationsByte)
+	return nil
+}
+
+// DeleteRedelegations - delete in-flight redelegations of a delegator from KVStore
+func (vs VoteStorage) DeleteRedelegations(ctx sdk.Context, delegator types.AccountKey) sdk.Error {
+	store := ctx.KVStore(vs.key)
+	store.Delete(GetRedelegationsKey(delegator))
+	return nil
+}
+
 // GetReferenceList - get reference list from KVStore
 func (vs VoteStorage) GetReferenceList(ctx sdk.Context) (*ReferenceList, sdk.Error) {
 	store := ctx.KVStore(vs.key)
@@ -261,6 +310,50 @@ func (vs VoteStorage) Export(ctx sdk.Context) *VoterTables {
 		}
 	}()
 
+	// export table.Redelegations
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, redelegationSubStore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			delegator := types.AccountKey(k[1:])
+			val, err := vs.GetRedelegations(ctx, delegator)
+			if err != nil {
+				panic("failed to read redelegations: " + err.Error())
+			}
+			row := RedelegationsRow{
+				Delegator:     delegator,
+				Redelegations: *val,
+			}
+			tables.Redelegations = append(tables.Redelegations, row)
+		}
+	}()
+
+	// export table.Votes
+	func() {
+		itr := sdk.KVStorePrefixIterator(store, voteSubstore)
+		defer itr.Close()
+		for ; itr.Valid(); itr.Next() {
+			k := itr.Key()
+			proposalVoter := string(k[1:])
+			strs := strings.Split(proposalVoter, types.KeySeparator)
+			if len(strs) != 2 {
+				panic("failed to split out proposalVoter: " + proposalVoter)
+			}
+			proposalID, voter := types.ProposalKey(strs[0]), types.AccountKey(strs[1])
+			val, err := vs.GetVote(ctx, proposalID, voter)
+			if err != nil {
+				panic("failed to read vote: " + err.Error())
+			}
+			row := VoteRow{
+				ProposalID: proposalID,
+				Voter:      voter,
+				Vote:       *val,
+			}
+			tables.Votes = append(tables.Votes, row)
+		}
+	}()
+
 	list, err := vs.GetReferenceList(ctx)
 	if err != nil {
 		panic("failed to get Reference List: " + err.Error())
@@ -288,6 +381,16 @@ func (vs VoteStorage) Import(ctx sdk.Context, ir *VoterTablesIR) {
 		err := vs.SetDelegation(ctx, v.Voter, v.Delegator, &v.Delegation)
 		check(err)
 	}
+	// import table.Redelegations
+	for _, v := range ir.Redelegations {
+		err := vs.SetRedelegations(ctx, v.Delegator, &v.Redelegations)
+		check(err)
+	}
+	// import table.Votes
+	for _, v := range ir.Votes {
+		err := vs.SetVote(ctx, v.ProposalID, v.Voter, &v.Vote)
+		check(err)
+	}
 	// import table.ReferenceList
 	err := vs.SetReferenceList(ctx, &ir.ReferenceList.List)
 	check(err)
@@ -331,6 +434,11 @@ func getReferenceListKey() []byte {
 	return referenceListSubStore
 }
 
+// GetRedelegationsKey - "redelegation substore" + "delegator"
+func GetRedelegationsKey(delegator types.AccountKey) []byte {
+	return append(redelegationSubStore, delegator...)
+}
+
 func getDelegateePrefix(me types.AccountKey) []byte {
 	return append(append(delegateeSubStore, me...), types.KeySeparator...)
 }
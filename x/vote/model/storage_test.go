@@ -75,7 +75,7 @@ func TestDoesVoteExist(t *testing.T) {
 
 	vote := &Vote{
 		Voter:       user1,
-		Result:      true,
+		Option:      types.VoteOptionYes,
 		VotingPower: votingPower,
 	}
 	err := vs.SetVote(ctx, proposalID1, user1, vote)
@@ -221,7 +221,7 @@ func TestVote(t *testing.T) {
 				{
 					Voter:       user1,
 					VotingPower: votingPower,
-					Result:      true,
+					Option:      types.VoteOptionYes,
 				},
 			},
 		},
@@ -236,7 +236,7 @@ func TestVote(t *testing.T) {
 				{
 					Voter:       user2,
 					VotingPower: votingPower,
-					Result:      true,
+					Option:      types.VoteOptionYes,
 				},
 			},
 		},
@@ -251,7 +251,7 @@ func TestVote(t *testing.T) {
 				{
 					Voter:       user2,
 					VotingPower: votingPower,
-					Result:      false,
+					Option:      types.VoteOptionNo,
 				},
 			},
 		},
@@ -266,12 +266,12 @@ func TestVote(t *testing.T) {
 				{
 					Voter:       user2,
 					VotingPower: votingPower,
-					Result:      false,
+					Option:      types.VoteOptionNo,
 				},
 				{
 					Voter:       user3,
 					VotingPower: votingPower,
-					Result:      true,
+					Option:      types.VoteOptionYes,
 				},
 			},
 		},
@@ -294,7 +294,7 @@ func TestVote(t *testing.T) {
 				{
 					Voter:       user3,
 					VotingPower: votingPower,
-					Result:      true,
+					Option:      types.VoteOptionYes,
 				},
 			},
 		},
@@ -309,7 +309,7 @@ func TestVote(t *testing.T) {
 				{
 					Voter:       user3,
 					VotingPower: votingPower,
-					Result:      false,
+					Option:      types.VoteOptionNo,
 				},
 			},
 		},
@@ -324,12 +324,12 @@ func TestVote(t *testing.T) {
 				{
 					Voter:       user2,
 					VotingPower: votingPower,
-					Result:      true,
+					Option:      types.VoteOptionYes,
 				},
 				{
 					Voter:       user3,
 					VotingPower: votingPower,
-					Result:      false,
+					Option:      types.VoteOptionNo,
 				},
 			},
 		},
@@ -374,6 +374,52 @@ func TestVote(t *testing.T) {
 	}
 }
 
+func TestVoteCastBeforeVoteOptions(t *testing.T) {
+	ctx, vs := setup(t)
+	proposalID := types.ProposalKey("1")
+	votingPower := types.NewCoinFromInt64(1000)
+
+	// layout of vote before vote options were introduced
+	type OldVote struct {
+		Voter       types.AccountKey `json:"voter"`
+		VotingPower types.Coin       `json:"voting_power"`
+		Result      bool             `json:"result"`
+	}
+	testCases := []struct {
+		testName   string
+		oldVote    OldVote
+		wantOption types.VoteOption
+	}{
+		{
+			testName:   "agree vote is mapped to yes",
+			oldVote:    OldVote{Voter: "user1", VotingPower: votingPower, Result: true},
+			wantOption: types.VoteOptionYes,
+		},
+		{
+			testName:   "disagree vote is mapped to no",
+			oldVote:    OldVote{Voter: "user2", VotingPower: votingPower, Result: false},
+			wantOption: types.VoteOptionNo,
+		},
+	}
+	for _, tc := range testCases {
+		voteByte, err := vs.cdc.MarshalBinaryLengthPrefixed(tc.oldVote)
+		assert.Nil(t, err, tc.testName)
+		ctx.KVStore(TestKVStoreKey).Set(GetVoteKey(proposalID, tc.oldVote.Voter), voteByte)
+
+		vote, err := vs.GetVote(ctx, proposalID, tc.oldVote.Voter)
+		assert.Nil(t, err, tc.testName)
+		assert.Equal(t, tc.oldVote.VotingPower, vote.VotingPower, tc.testName)
+		assert.Equal(t, tc.wantOption, vote.GetOption(), tc.testName)
+	}
+
+	err := vs.SetVote(ctx, proposalID, "user3", &Vote{
+		Voter: "user3", VotingPower: votingPower, Option: types.VoteOptionAbstain})
+	assert.Nil(t, err)
+	vote, err := vs.GetVote(ctx, proposalID, "user3")
+	assert.Nil(t, err)
+	assert.Equal(t, types.VoteOptionAbstain, vote.GetOption())
+}
+
 func TestDelegation(t *testing.T) {
 	ctx, vs := setup(t)
 	user1, user2, user3 :=
@@ -458,6 +504,7 @@ func TestAllDelegation(t *testing.T) {
 		amount             types.Coin
 		delegateTo         types.AccountKey
 		expectedDelegators []types.AccountKey
+		expectedDelegatees []types.AccountKey
 	}{
 		{
 			testName:           "user1 delegates to user2",
@@ -465,6 +512,7 @@ func TestAllDelegation(t *testing.T) {
 			amount:             types.NewCoinFromInt64(1),
 			delegateTo:         user2,
 			expectedDelegators: []types.AccountKey{user1},
+			expectedDelegatees: []types.AccountKey{user2},
 		},
 		{
 			testName:           "user1 delegates to user2 with more coins",
@@ -472,6 +520,7 @@ func TestAllDelegation(t *testing.T) {
 			amount:             types.NewCoinFromInt64(100),
 			delegateTo:         user2,
 			expectedDelegators: []types.AccountKey{user1},
+			expectedDelegatees: []types.AccountKey{user2},
 		},
 		{
 			testName:           "user1 delegates to user3",
@@ -479,6 +528,7 @@ func TestAllDelegation(t *testing.T) {
 			amount:             types.NewCoinFromInt64(1),
 			delegateTo:         user3,
 			expectedDelegators: []types.AccountKey{user1},
+			expectedDelegatees: []types.AccountKey{user2, user3},
 		},
 		{
 			testName:           "user2 delegates to user1",
@@ -486,6 +536,7 @@ func TestAllDelegation(t *testing.T) {
 			amount:             types.NewCoinFromInt64(1),
 			delegateTo:         user1,
 			expectedDelegators: []types.AccountKey{user2},
+			expectedDelegatees: []types.AccountKey{user1},
 		},
 		{
 			testName:           "user3 delegates to user1",
@@ -493,6 +544,7 @@ func TestAllDelegation(t *testing.T) {
 			amount:             types.NewCoinFromInt64(1),
 			delegateTo:         user1,
 			expectedDelegators: []types.AccountKey{user2, user3},
+			expectedDelegatees: []types.AccountKey{user1},
 		},
 	}
 
@@ -510,5 +562,55 @@ func TestAllDelegation(t *testing.T) {
 		if !assert.Equal(t, tc.expectedDelegators, delegators) {
 			t.Errorf("%s: diff delegators, got %v, want %v", tc.testName, delegators, tc.expectedDelegators)
 		}
+
+		delegatees, err := vs.GetAllDelegatees(ctx, tc.delegator)
+		if err != nil {
+			t.Errorf("%s: failed to get all delegatees, got non-empty err: %v", tc.testName, err)
+		}
+		if !assert.Equal(t, tc.expectedDelegatees, delegatees) {
+			t.Errorf("%s: diff delegatees, got %v, want %v", tc.testName, delegatees, tc.expectedDelegatees)
+		}
+	}
+}
+
+func TestRedelegations(t *testing.T) {
+	ctx, vs := setup(t)
+	user1, user2, user3 :=
+		types.AccountKey("user1"), types.AccountKey("user2"), types.AccountKey("user3")
+
+	// delegator without redelegation gets empty redelegations
+	redelegations, err := vs.GetRedelegations(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, Redelegations{Redelegations: []Redelegation{}}, *redelegations)
+
+	expected := Redelegations{
+		Redelegations: []Redelegation{
+			{
+				SrcVoter:   user2,
+				DstVoter:   user3,
+				Amount:     types.NewCoinFromInt64(100),
+				CreatedAt:  1,
+				CompleteAt: 100,
+			},
+			{
+				SrcVoter:   user3,
+				DstVoter:   user2,
+				Amount:     types.NewCoinFromInt64(10),
+				CreatedAt:  2,
+				CompleteAt: 101,
+			},
+		},
 	}
+	err = vs.SetRedelegations(ctx, user1, &expected)
+	assert.Nil(t, err)
+
+	redelegations, err = vs.GetRedelegations(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, *redelegations)
+
+	err = vs.DeleteRedelegations(ctx, user1)
+	assert.Nil(t, err)
+	redelegations, err = vs.GetRedelegations(ctx, user1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(redelegations.Redelegations))
 }
@@ -5,6 +5,8 @@ import (
 )
 
 // Voter - a voter in blockchain is account with voter deposit, who can vote for a proposal
+// AutoCompound - if set, accrued interest is added to LinoStake instead of Interest
+// DefaultVoteOption - if set, vote cast for validator on proposal required to vote when it didn't vote
 type Voter struct {
 	Username          types.AccountKey `json:"username"`
 	LinoStake         types.Coin       `json:"lino_stake"`
@@ -12,13 +14,29 @@ type Voter struct {
 	DelegateToOthers  types.Coin       `json:"delegate_to_others"`
 	LastPowerChangeAt int64            `json:"last_power_change_at"`
 	Interest          types.Coin       `json:"interest"`
+	AutoCompound      bool             `json:"auto_compound"`
+	DefaultVoteOption types.VoteOption `json:"default_vote_option"`
 }
 
 // Vote - a vote is created by a voter to a proposal
+// Result - yes or no of vote cast before vote options were introduced
 type Vote struct {
 	Voter       types.AccountKey `json:"voter"`
 	VotingPower types.Coin       `json:"voting_power"`
 	Result      bool             `json:"result"`
+	Option      types.VoteOption `json:"option"`
+}
+
+// GetOption - get option of the vote, vote cast before vote options
+// were introduced has no option and its result is mapped to yes or no
+func (vote Vote) GetOption() types.VoteOption {
+	if vote.Option != types.VoteOptionNone {
+		return vote.Option
+	}
+	if vote.Result {
+		return types.VoteOptionYes
+	}
+	return types.VoteOptionNo
 }
 
 // Delegation - normal user can delegate money to a voter to increase voter's voting power
@@ -27,6 +45,21 @@ type Delegation struct {
 	Amount    types.Coin       `json:"amount"`
 }
 
+// Redelegation - delegation moved from source voter to destination voter,
+// redelegated power is in flight until CompleteAt
+type Redelegation struct {
+	SrcVoter   types.AccountKey `json:"src_voter"`
+	DstVoter   types.AccountKey `json:"dst_voter"`
+	Amount     types.Coin       `json:"amount"`
+	CreatedAt  int64            `json:"created_at"`
+	CompleteAt int64            `json:"complete_at"`
+}
+
+// Redelegations - all in-flight redelegations of a delegator
+type Redelegations struct {
+	Redelegations []Redelegation `json:"redelegations"`
+}
+
 // ReferenceList - record validator to punish the validator who doesn't vote for proposal
 type ReferenceList struct {
 	AllValidators []types.AccountKey `json:"all_validators"`
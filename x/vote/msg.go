@@ -13,7 +13,10 @@ var _ types.Msg = StakeInMsg{}
 var _ types.Msg = StakeOutMsg{}
 var _ types.Msg = DelegateMsg{}
 var _ types.Msg = DelegatorWithdrawMsg{}
+var _ types.Msg = RedelegateMsg{}
 var _ types.Msg = ClaimInterestMsg{}
+var _ types.Msg = SetAutoCompoundMsg{}
+var _ types.Msg = SetDefaultVotePolicyMsg{}
 
 // StakeInMsg - voter deposit
 type StakeInMsg struct {
@@ -41,11 +44,32 @@ type DelegatorWithdrawMsg struct {
 	Amount    types.LNO        `json:"amount"`
 }
 
+// RedelegateMsg - delegator move delegation from one voter to another without unbonding
+type RedelegateMsg struct {
+	Delegator types.AccountKey `json:"delegator"`
+	SrcVoter  types.AccountKey `json:"src_voter"`
+	DstVoter  types.AccountKey `json:"dst_voter"`
+	Amount    types.LNO        `json:"amount"`
+}
+
 // ClaimInterestMsg - claim interest generated from lino power
 type ClaimInterestMsg struct {
 	Username types.AccountKey `json:"username"`
 }
 
+// SetAutoCompoundMsg - voter turn on or off compounding interest into lino stake
+type SetAutoCompoundMsg struct {
+	Username     types.AccountKey `json:"username"`
+	AutoCompound bool             `json:"auto_compound"`
+}
+
+// SetDefaultVotePolicyMsg - validator register the option voted on its behalf
+// for proposal it is required to vote, VoteOptionNone clears the policy
+type SetDefaultVotePolicyMsg struct {
+	Username types.AccountKey `json:"username"`
+	Option   types.VoteOption `json:"option"`
+}
+
 // NewStakeInMsg - return a StakeInMsg
 func NewStakeInMsg(username string, deposit types.LNO) StakeInMsg {
 	return StakeInMsg{
@@ -274,6 +298,71 @@ func (msg DelegatorWithdrawMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
 
+// NewRedelegateMsg - return RedelegateMsg
+func NewRedelegateMsg(delegator string, srcVoter string, dstVoter string, amount types.LNO) RedelegateMsg {
+	return RedelegateMsg{
+		Delegator: types.AccountKey(delegator),
+		SrcVoter:  types.AccountKey(srcVoter),
+		DstVoter:  types.AccountKey(dstVoter),
+		Amount:    amount,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg RedelegateMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg RedelegateMsg) Type() string { return "RedelegateMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg RedelegateMsg) ValidateBasic() sdk.Error {
+	if len(msg.Delegator) < types.MinimumUsernameLength ||
+		len(msg.Delegator) > types.MaximumUsernameLength ||
+		len(msg.SrcVoter) < types.MinimumUsernameLength ||
+		len(msg.SrcVoter) > types.MaximumUsernameLength ||
+		len(msg.DstVoter) < types.MinimumUsernameLength ||
+		len(msg.DstVoter) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if msg.SrcVoter == msg.DstVoter {
+		return ErrRedelegateToSameVoter()
+	}
+	_, err := types.LinoToCoin(msg.Amount)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (msg RedelegateMsg) String() string {
+	return fmt.Sprintf("RedelegateMsg{Delegator:%v, SrcVoter:%v, DstVoter:%v, Amount:%v}",
+		msg.Delegator, msg.SrcVoter, msg.DstVoter, msg.Amount)
+}
+
+// GetPermission - implements types.Msg
+func (msg RedelegateMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg RedelegateMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg RedelegateMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Delegator)}
+}
+
+// GetConsumeAmount - implement types.Msg
+func (msg RedelegateMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
 // NewClaimInterestMsg - return a ClaimInterestMsg
 func NewClaimInterestMsg(username string) ClaimInterestMsg {
 	return ClaimInterestMsg{
@@ -323,3 +412,108 @@ func (msg ClaimInterestMsg) GetSigners() []sdk.AccAddress {
 func (msg ClaimInterestMsg) GetConsumeAmount() types.Coin {
 	return types.NewCoinFromInt64(0)
 }
+
+// NewSetAutoCompoundMsg - return a SetAutoCompoundMsg
+func NewSetAutoCompoundMsg(username string, autoCompound bool) SetAutoCompoundMsg {
+	return SetAutoCompoundMsg{
+		Username:     types.AccountKey(username),
+		AutoCompound: autoCompound,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg SetAutoCompoundMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg SetAutoCompoundMsg) Type() string { return "SetAutoCompoundMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg SetAutoCompoundMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	return nil
+}
+
+func (msg SetAutoCompoundMsg) String() string {
+	return fmt.Sprintf("SetAutoCompoundMsg{Username:%v, AutoCompound:%v}", msg.Username, msg.AutoCompound)
+}
+
+// GetPermission - implements types.Msg
+func (msg SetAutoCompoundMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg SetAutoCompoundMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg SetAutoCompoundMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg SetAutoCompoundMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
+
+// NewSetDefaultVotePolicyMsg - return a SetDefaultVotePolicyMsg
+func NewSetDefaultVotePolicyMsg(username string, option types.VoteOption) SetDefaultVotePolicyMsg {
+	return SetDefaultVotePolicyMsg{
+		Username: types.AccountKey(username),
+		Option:   option,
+	}
+}
+
+// Route - implements sdk.Msg
+func (msg SetDefaultVotePolicyMsg) Route() string { return RouterKey }
+
+// Type - implements sdk.Msg
+func (msg SetDefaultVotePolicyMsg) Type() string { return "SetDefaultVotePolicyMsg" }
+
+// ValidateBasic - implements sdk.Msg
+func (msg SetDefaultVotePolicyMsg) ValidateBasic() sdk.Error {
+	if len(msg.Username) < types.MinimumUsernameLength ||
+		len(msg.Username) > types.MaximumUsernameLength {
+		return ErrInvalidUsername()
+	}
+	if msg.Option < types.VoteOptionNone || msg.Option > types.VoteOptionNoWithVeto {
+		return ErrInvalidDefaultVoteOption()
+	}
+	return nil
+}
+
+func (msg SetDefaultVotePolicyMsg) String() string {
+	return fmt.Sprintf("SetDefaultVotePolicyMsg{Username:%v, Option:%v}", msg.Username, msg.Option)
+}
+
+// GetPermission - implements types.Msg
+func (msg SetDefaultVotePolicyMsg) GetPermission() types.Permission {
+	return types.TransactionPermission
+}
+
+// GetSignBytes - implements sdk.Msg
+func (msg SetDefaultVotePolicyMsg) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg) // XXX: ensure some canonical form
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// GetSigners - implements sdk.Msg
+func (msg SetDefaultVotePolicyMsg) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Username)}
+}
+
+// GetConsumeAmount - implements types.Msg
+func (msg SetDefaultVotePolicyMsg) GetConsumeAmount() types.Coin {
+	return types.NewCoinFromInt64(0)
+}
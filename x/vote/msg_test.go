@@ -74,6 +74,90 @@ func TestClaimInterestMsg(t *testing.T) {
 	}
 }
 
+func TestSetAutoCompoundMsg(t *testing.T) {
+	testCases := map[string]struct {
+		msg      SetAutoCompoundMsg
+		wantCode sdk.CodeType
+	}{
+		"normal case - turn on": {
+			msg:      NewSetAutoCompoundMsg("test", true),
+			wantCode: sdk.CodeOK,
+		},
+		"normal case - turn off": {
+			msg:      NewSetAutoCompoundMsg("test", false),
+			wantCode: sdk.CodeOK,
+		},
+		"invalid auto compound - Username is too short": {
+			msg:      NewSetAutoCompoundMsg("te", true),
+			wantCode: types.CodeInvalidUsername,
+		},
+		"invalid auto compound - Username is too long": {
+			msg:      NewSetAutoCompoundMsg("testtesttesttesttesttest", true),
+			wantCode: types.CodeInvalidUsername,
+		},
+	}
+
+	for testName, tc := range testCases {
+		got := tc.msg.ValidateBasic()
+
+		if got == nil {
+			if tc.wantCode != sdk.CodeOK {
+				t.Errorf("%s: diff error: got %v, want %v", testName, tc.wantCode, tc.wantCode)
+			}
+			continue
+		}
+		if got.Code() != tc.wantCode {
+			t.Errorf("%s: diff error code: got %v, want %v", testName, got.Code(), tc.wantCode)
+		}
+	}
+}
+
+func TestSetDefaultVotePolicyMsg(t *testing.T) {
+	testCases := map[string]struct {
+		msg      SetDefaultVotePolicyMsg
+		wantCode sdk.CodeType
+	}{
+		"normal case - set yes": {
+			msg:      NewSetDefaultVotePolicyMsg("test", types.VoteOptionYes),
+			wantCode: sdk.CodeOK,
+		},
+		"normal case - clear policy": {
+			msg:      NewSetDefaultVotePolicyMsg("test", types.VoteOptionNone),
+			wantCode: sdk.CodeOK,
+		},
+		"invalid default vote - Username is too short": {
+			msg:      NewSetDefaultVotePolicyMsg("te", types.VoteOptionYes),
+			wantCode: types.CodeInvalidUsername,
+		},
+		"invalid default vote - Username is too long": {
+			msg:      NewSetDefaultVotePolicyMsg("testtesttesttesttesttest", types.VoteOptionYes),
+			wantCode: types.CodeInvalidUsername,
+		},
+		"invalid default vote - negative option": {
+			msg:      NewSetDefaultVotePolicyMsg("test", types.VoteOption(-1)),
+			wantCode: types.CodeInvalidDefaultVoteOption,
+		},
+		"invalid default vote - unknown option": {
+			msg:      NewSetDefaultVotePolicyMsg("test", types.VoteOption(5)),
+			wantCode: types.CodeInvalidDefaultVoteOption,
+		},
+	}
+
+	for testName, tc := range testCases {
+		got := tc.msg.ValidateBasic()
+
+		if got == nil {
+			if tc.wantCode != sdk.CodeOK {
+				t.Errorf("%s: diff error: got %v, want %v", testName, tc.wantCode, tc.wantCode)
+			}
+			continue
+		}
+		if got.Code() != tc.wantCode {
+			t.Errorf("%s: diff error code: got %v, want %v", testName, got.Code(), tc.wantCode)
+		}
+	}
+}
+
 func TestStakeOutMsg(t *testing.T) {
 	testCases := []struct {
 		testName      string
@@ -177,6 +261,47 @@ func TestDelegatorWithdrawMsg(t *testing.T) {
 	}
 }
 
+func TestRedelegateMsg(t *testing.T) {
+	testCases := []struct {
+		testName      string
+		redelegateMsg RedelegateMsg
+		expectedError sdk.Error
+	}{
+		{
+			testName:      "normal case",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user3", "1"),
+			expectedError: nil,
+		},
+		{
+			testName:      "invalid delegator",
+			redelegateMsg: NewRedelegateMsg("", "user2", "user3", "1"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "invalid destination voter",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "", "1"),
+			expectedError: ErrInvalidUsername(),
+		},
+		{
+			testName:      "redelegate to the same voter",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user2", "1"),
+			expectedError: ErrRedelegateToSameVoter(),
+		},
+		{
+			testName:      "invalid redelegate amount",
+			redelegateMsg: NewRedelegateMsg("user1", "user2", "user3", "-1"),
+			expectedError: types.ErrInvalidCoins("LNO can't be less than lower bound"),
+		},
+	}
+
+	for _, tc := range testCases {
+		result := tc.redelegateMsg.ValidateBasic()
+		if !assert.Equal(t, result, tc.expectedError) {
+			t.Errorf("%s: diff result, got %v, expect %v", tc.testName, result, tc.expectedError)
+		}
+	}
+}
+
 func TestMsgPermission(t *testing.T) {
 	testCases := []struct {
 		testName           string
@@ -203,6 +328,21 @@ func TestMsgPermission(t *testing.T) {
 			msg:                NewDelegatorWithdrawMsg("delegator", "voter", types.LNO("1")),
 			expectedPermission: types.TransactionPermission,
 		},
+		{
+			testName:           "redelegate",
+			msg:                NewRedelegateMsg("delegator", "voter1", "voter2", types.LNO("1")),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "set auto compound",
+			msg:                NewSetAutoCompoundMsg("test", true),
+			expectedPermission: types.TransactionPermission,
+		},
+		{
+			testName:           "set default vote policy",
+			msg:                NewSetDefaultVotePolicyMsg("test", types.VoteOptionYes),
+			expectedPermission: types.TransactionPermission,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -234,6 +374,10 @@ func TestGetSignBytes(t *testing.T) {
 			testName: "delegate withdraw",
 			msg:      NewDelegatorWithdrawMsg("delegator", "voter", types.LNO("1")),
 		},
+		{
+			testName: "set default vote policy",
+			msg:      NewSetDefaultVotePolicyMsg("test", types.VoteOptionYes),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -267,6 +411,11 @@ func TestGetSigners(t *testing.T) {
 			msg:           NewDelegatorWithdrawMsg("delegator", "voter", types.LNO("1")),
 			expectSigners: []types.AccountKey{"delegator"},
 		},
+		{
+			testName:      "set default vote policy",
+			msg:           NewSetDefaultVotePolicyMsg("test", types.VoteOptionYes),
+			expectSigners: []types.AccountKey{"test"},
+		},
 	}
 
 	for _, tc := range testCases {
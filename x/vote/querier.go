@@ -4,6 +4,7 @@ import (
 	wire "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lino-network/lino/types"
+	"github.com/lino-network/lino/x/global"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -22,10 +23,23 @@ const (
 	QueryVote          = "vote"
 	QueryReferenceList = "refList"
 	QueryDelegatee     = "delegatee"
+	QueryRedelegations = "redelegations"
+	QueryProjected     = "projectedStake"
 )
 
+// ProjectedStake - voter stake and interest if pending interest were settled now
+type ProjectedStake struct {
+	Username          types.AccountKey `json:"username"`
+	AutoCompound      bool             `json:"auto_compound"`
+	LinoStake         types.Coin       `json:"lino_stake"`
+	Interest          types.Coin       `json:"interest"`
+	PendingInterest   types.Coin       `json:"pending_interest"`
+	ProjectedStake    types.Coin       `json:"projected_stake"`
+	ProjectedInterest types.Coin       `json:"projected_interest"`
+}
+
 // creates a querier for vote REST endpoints
-func NewQuerier(vm VoteManager) sdk.Querier {
+func NewQuerier(vm VoteManager, gm *global.GlobalManager) sdk.Querier {
 	cdc := wire.New()
 	wire.RegisterCrypto(cdc)
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
@@ -40,6 +54,10 @@ func NewQuerier(vm VoteManager) sdk.Querier {
 			return queryReferenceList(ctx, cdc, path[1:], req, vm)
 		case QueryDelegatee:
 			return queryDelegatee(ctx, cdc, path[1:], req, vm)
+		case QueryRedelegations:
+			return queryRedelegations(ctx, cdc, path[1:], req, vm)
+		case QueryProjected:
+			return queryProjectedStake(ctx, cdc, path[1:], req, vm, gm)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown vote query endpoint")
 		}
@@ -117,3 +135,53 @@ func queryDelegatee(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.Re
 	}
 	return res, nil
 }
+
+func queryRedelegations(ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery, vm VoteManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	redelegations, err := vm.GetRedelegations(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	res, marshalErr := cdc.MarshalJSON(redelegations)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
+
+func queryProjectedStake(
+	ctx sdk.Context, cdc *wire.Codec, path []string, req abci.RequestQuery,
+	vm VoteManager, gm *global.GlobalManager) ([]byte, sdk.Error) {
+	if err := types.CheckPathContentAndMinLength(path, 1); err != nil {
+		return nil, err
+	}
+	voter, err := vm.storage.GetVoter(ctx, types.AccountKey(path[0]))
+	if err != nil {
+		return nil, err
+	}
+	pending, err := gm.GetPendingInterestSince(ctx, voter.LastPowerChangeAt, voter.LinoStake)
+	if err != nil {
+		return nil, err
+	}
+	projected := ProjectedStake{
+		Username:          voter.Username,
+		AutoCompound:      voter.AutoCompound,
+		LinoStake:         voter.LinoStake,
+		Interest:          voter.Interest,
+		PendingInterest:   pending,
+		ProjectedStake:    voter.LinoStake,
+		ProjectedInterest: voter.Interest,
+	}
+	if voter.AutoCompound {
+		projected.ProjectedStake = voter.LinoStake.Plus(pending)
+	} else {
+		projected.ProjectedInterest = voter.Interest.Plus(pending)
+	}
+	res, marshalErr := cdc.MarshalJSON(projected)
+	if marshalErr != nil {
+		return nil, ErrQueryFailed()
+	}
+	return res, nil
+}
@@ -11,6 +11,9 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(DelegateMsg{}, "lino/delegate", nil)
 	cdc.RegisterConcrete(DelegatorWithdrawMsg{}, "lino/delegateWithdraw", nil)
 	cdc.RegisterConcrete(ClaimInterestMsg{}, "lino/claimInterest", nil)
+	cdc.RegisterConcrete(RedelegateMsg{}, "lino/redelegate", nil)
+	cdc.RegisterConcrete(SetAutoCompoundMsg{}, "lino/setAutoCompound", nil)
+	cdc.RegisterConcrete(SetDefaultVotePolicyMsg{}, "lino/setDefaultVotePolicy", nil)
 }
 
 var msgCdc = wire.New()